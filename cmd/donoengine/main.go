@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
@@ -28,14 +29,40 @@ const (
 
 var StartTime = time.Now()
 
+const usage = `Usage: donoengine [command]
+
+Commands:
+  serve                                  Start the service (default).
+  migrate up|down|status|redo|version    Manage database migrations.
+  purge [duration]                       Purge trash older than duration (default 720h).
+`
+
 func main() {
 	godotenv.Load()
 
-	exitCode := mainRun()
+	command, args := "serve", []string{}
+	if len(os.Args) > 1 {
+		command, args = os.Args[1], os.Args[2:]
+	}
+
+	var exitCode exitCode
+	switch command {
+	case "serve":
+		exitCode = serveRun()
+	case "migrate":
+		exitCode = migrateRun(args)
+	case "purge":
+		exitCode = purgeRun(args)
+	case "help", "-h", "--help":
+		fmt.Fprint(os.Stdout, usage)
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		exitCode = exitError
+	}
 	os.Exit(int(exitCode))
 }
 
-func mainRun() exitCode {
+func serveRun() exitCode {
 	log := logger.New()
 
 	log.Message("Starting service.", "version", donoengine.Version)
@@ -58,7 +85,7 @@ func mainRun() exitCode {
 		return exitError
 	}
 
-	ds, err := datastore.New(ctx, cfg.Datastore)
+	ds, err := datastore.New(ctx, cfg.Datastore, log)
 	if err != nil {
 		log.ErrMessage(err, "Datastore initialization failed.")
 		return exitError
@@ -75,7 +102,7 @@ func mainRun() exitCode {
 		return exitError
 	}
 
-	svc := service.New(ds.Database, au.OAuth)
+	svc := service.New(ds.Database, au.OAuth, cfg.General.Service)
 
 	ctr := controller.New(svc, au.OAuth, cfg.General.Controller, log)
 
@@ -96,3 +123,81 @@ func mainRun() exitCode {
 
 	return exitOK
 }
+
+func migrateRun(args []string) exitCode {
+	log := logger.New()
+
+	if len(args) != 1 {
+		fmt.Fprint(os.Stderr, usage)
+		return exitError
+	}
+
+	cfg, err := config.New()
+	if err != nil {
+		log.ErrMessage(err, "Config initialization failed.")
+		return exitError
+	}
+	cfg.Datastore.Database.AutoMigrate = false
+
+	ctx := context.Background()
+
+	ds, err := datastore.New(ctx, cfg.Datastore, log)
+	if err != nil {
+		log.ErrMessage(err, "Datastore initialization failed.")
+		return exitError
+	}
+	defer func() {
+		if err := ds.Stop(); err != nil {
+			log.ErrMessage(err, "Datastore stop failed.")
+		}
+	}()
+
+	if err := ds.Database.Migrate(ctx, args[0]); err != nil {
+		log.ErrMessage(err, "Database migration failed.", "command", args[0])
+		return exitError
+	}
+
+	return exitOK
+}
+
+func purgeRun(args []string) exitCode {
+	log := logger.New()
+
+	olderThan := 30 * 24 * time.Hour
+	if len(args) > 0 {
+		d, err := time.ParseDuration(args[0])
+		if err != nil {
+			log.ErrMessage(err, "Parse purge duration failed.")
+			return exitError
+		}
+		olderThan = d
+	}
+
+	cfg, err := config.New()
+	if err != nil {
+		log.ErrMessage(err, "Config initialization failed.")
+		return exitError
+	}
+
+	ctx := context.Background()
+
+	ds, err := datastore.New(ctx, cfg.Datastore, log)
+	if err != nil {
+		log.ErrMessage(err, "Datastore initialization failed.")
+		return exitError
+	}
+	defer func() {
+		if err := ds.Stop(); err != nil {
+			log.ErrMessage(err, "Datastore stop failed.")
+		}
+	}()
+
+	count, err := ds.Database.PurgeTrash(ctx, time.Now().UTC().Add(-olderThan))
+	if err != nil {
+		log.ErrMessage(err, "Purge trash failed.")
+		return exitError
+	}
+
+	log.Message("Trash purged.", "count", count, "olderThan", olderThan)
+	return exitOK
+}
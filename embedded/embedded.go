@@ -10,4 +10,6 @@ var (
 	CRDBMigrations embed.FS
 	//go:embed migrations/*-pg.sql
 	PGMigrations embed.FS
+	//go:embed migrations/*-sqlite.sql
+	SQLiteMigrations embed.FS
 )
@@ -0,0 +1,640 @@
+-- +goose Up
+
+-- The database file is attached as the donoengine schema. SQLite reports a
+-- unique constraint by its table and columns, the indexes are named the same
+-- way. A foreign key is reported by the name its trigger raises.
+
+-- Language
+
+CREATE TABLE donoengine.language (
+    id              integer                     PRIMARY KEY AUTOINCREMENT,
+    created_at      timestamp                   NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at      timestamp,
+
+    ietf            text                        NOT NULL,
+    name            text                        NOT NULL,
+
+    CONSTRAINT language_ietf_check
+        CHECK (ietf <> '' AND length(ietf) <= 12),
+    CONSTRAINT language_name_check
+        CHECK (name <> '' AND length(name) <= 24)
+);
+
+CREATE UNIQUE INDEX donoengine.language_ietf_key ON language
+    (ietf);
+
+-- Website
+
+CREATE TABLE donoengine.website (
+    id              integer                     PRIMARY KEY AUTOINCREMENT,
+    created_at      timestamp                   NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at      timestamp,
+
+    domain          text                        NOT NULL,
+    name            text                        NOT NULL,
+
+    CONSTRAINT website_domain_check
+        CHECK (domain <> '' AND length(domain) <= 32),
+    CONSTRAINT website_name_check
+        CHECK (name <> '' AND length(name) <= 48)
+);
+
+CREATE UNIQUE INDEX donoengine.website_domain_key ON website
+    (domain);
+
+-- Category Type
+
+CREATE TABLE donoengine.category_type (
+    id              integer                     PRIMARY KEY AUTOINCREMENT,
+    created_at      timestamp                   NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at      timestamp,
+
+    code            text                        NOT NULL,
+    name            text                        NOT NULL,
+
+    CONSTRAINT category_type_code_check
+        CHECK (code <> '' AND length(code) <= 24),
+    CONSTRAINT category_type_name_check
+        CHECK (name <> '' AND length(name) <= 24)
+);
+
+CREATE UNIQUE INDEX donoengine.category_type_code_key ON category_type
+    (code);
+
+-- Category
+
+CREATE TABLE donoengine.category (
+    id              integer                     PRIMARY KEY AUTOINCREMENT,
+    created_at      timestamp                   NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at      timestamp,
+
+    type_id         integer                     NOT NULL,
+    code            text                        NOT NULL,
+    name            text                        NOT NULL,
+
+    CONSTRAINT category_type_id_fkey
+        FOREIGN KEY (type_id) REFERENCES category_type(id),
+    CONSTRAINT category_code_check
+        CHECK (code <> '' AND length(code) <= 32),
+    CONSTRAINT category_name_check
+        CHECK (name <> '' AND length(name) <= 32)
+);
+
+CREATE UNIQUE INDEX donoengine.category_type_id_code_key ON category
+    (type_id, code);
+
+-- +goose StatementBegin
+CREATE TRIGGER donoengine.category_fkey_insert BEFORE INSERT ON category
+BEGIN
+    SELECT RAISE(ABORT, 'category_type_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM category_type WHERE id = NEW.type_id);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER donoengine.category_fkey_update BEFORE UPDATE OF type_id ON category
+BEGIN
+    SELECT RAISE(ABORT, 'category_type_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM category_type WHERE id = NEW.type_id);
+END;
+-- +goose StatementEnd
+
+-- Category Relation
+
+CREATE TABLE donoengine.category_relation (
+    created_at      timestamp                   NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at      timestamp,
+
+    parent_id       integer                     NOT NULL,
+    child_id        integer                     NOT NULL,
+
+    CONSTRAINT category_relation_pkey
+        PRIMARY KEY (parent_id, child_id),
+    CONSTRAINT category_relation_parent_id_fkey
+        FOREIGN KEY (parent_id) REFERENCES category(id) ON DELETE CASCADE,
+    CONSTRAINT category_relation_child_id_fkey
+        FOREIGN KEY (child_id) REFERENCES category(id) ON DELETE CASCADE,
+    CONSTRAINT category_relation_parent_id_child_id_check
+        CHECK (parent_id <> child_id)
+);
+
+-- +goose StatementBegin
+CREATE TRIGGER donoengine.category_relation_fkey_insert BEFORE INSERT ON category_relation
+BEGIN
+    SELECT RAISE(ABORT, 'category_relation_parent_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM category WHERE id = NEW.parent_id);
+    SELECT RAISE(ABORT, 'category_relation_child_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM category WHERE id = NEW.child_id);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER donoengine.category_relation_fkey_update BEFORE UPDATE OF parent_id, child_id ON category_relation
+BEGIN
+    SELECT RAISE(ABORT, 'category_relation_parent_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM category WHERE id = NEW.parent_id);
+    SELECT RAISE(ABORT, 'category_relation_child_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM category WHERE id = NEW.child_id);
+END;
+-- +goose StatementEnd
+
+-- Tag Type
+
+CREATE TABLE donoengine.tag_type (
+    id              integer                     PRIMARY KEY AUTOINCREMENT,
+    created_at      timestamp                   NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at      timestamp,
+
+    code            text                        NOT NULL,
+    name            text                        NOT NULL,
+
+    CONSTRAINT tag_type_code_check
+        CHECK (code <> '' AND length(code) <= 24),
+    CONSTRAINT tag_type_name_check
+        CHECK (name <> '' AND length(name) <= 24)
+);
+
+CREATE UNIQUE INDEX donoengine.tag_type_code_key ON tag_type
+    (code);
+
+-- Tag
+
+CREATE TABLE donoengine.tag (
+    id              integer                     PRIMARY KEY AUTOINCREMENT,
+    created_at      timestamp                   NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at      timestamp,
+
+    type_id         integer                     NOT NULL,
+    code            text                        NOT NULL,
+    name            text                        NOT NULL,
+
+    CONSTRAINT tag_type_id_fkey
+        FOREIGN KEY (type_id) REFERENCES tag_type(id),
+    CONSTRAINT tag_code_check
+        CHECK (code <> '' AND length(code) <= 32),
+    CONSTRAINT tag_name_check
+        CHECK (name <> '' AND length(name) <= 32)
+);
+
+CREATE UNIQUE INDEX donoengine.tag_type_id_code_key ON tag
+    (type_id, code);
+
+-- +goose StatementBegin
+CREATE TRIGGER donoengine.tag_fkey_insert BEFORE INSERT ON tag
+BEGIN
+    SELECT RAISE(ABORT, 'tag_type_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM tag_type WHERE id = NEW.type_id);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER donoengine.tag_fkey_update BEFORE UPDATE OF type_id ON tag
+BEGIN
+    SELECT RAISE(ABORT, 'tag_type_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM tag_type WHERE id = NEW.type_id);
+END;
+-- +goose StatementEnd
+
+-- Comic
+
+CREATE TABLE donoengine.comic (
+    id              integer                     PRIMARY KEY AUTOINCREMENT,
+    created_at      timestamp                   NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at      timestamp,
+
+    code            text                        NOT NULL,
+    language_id     integer,
+    published_from  timestamp,
+    published_to    timestamp,
+    total_chapter   integer,
+    total_volume    integer,
+    nsfw            integer,
+    nsfl            integer,
+    additionals     text,
+
+    CONSTRAINT comic_language_id_fkey
+        FOREIGN KEY (language_id) REFERENCES language(id),
+    CONSTRAINT comic_code_check
+        CHECK (length(code) = 8),
+    CONSTRAINT comic_nsfw_check
+        CHECK (nsfw <= -1 AND nsfw >= 1),
+    CONSTRAINT comic_nsfl_check
+        CHECK (nsfl <= -1 AND nsfl >= 1)
+);
+
+CREATE UNIQUE INDEX donoengine.comic_code_key ON comic
+    (code);
+
+-- +goose StatementBegin
+CREATE TRIGGER donoengine.comic_fkey_insert BEFORE INSERT ON comic
+BEGIN
+    SELECT RAISE(ABORT, 'comic_language_id_fkey')
+        WHERE NEW.language_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM language WHERE id = NEW.language_id);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER donoengine.comic_fkey_update BEFORE UPDATE OF language_id ON comic
+BEGIN
+    SELECT RAISE(ABORT, 'comic_language_id_fkey')
+        WHERE NEW.language_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM language WHERE id = NEW.language_id);
+END;
+-- +goose StatementEnd
+
+-- Comic Title
+
+CREATE TABLE donoengine.comic_title (
+    id              integer                     PRIMARY KEY AUTOINCREMENT,
+    created_at      timestamp                   NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at      timestamp,
+
+    comic_id        integer                     NOT NULL,
+    rid             text                        NOT NULL,
+    language_id     integer                     NOT NULL,
+    title           text                        NOT NULL,
+    synonym         boolean,
+    romanized       boolean,
+
+    CONSTRAINT comic_title_comic_id_fkey
+        FOREIGN KEY (comic_id) REFERENCES comic(id) ON DELETE CASCADE,
+    CONSTRAINT comic_title_language_id_fkey
+        FOREIGN KEY (language_id) REFERENCES language(id),
+    CONSTRAINT comic_title_rid_check
+        CHECK (length(rid) = 4),
+    CONSTRAINT comic_title_title_check
+        CHECK (title <> '' AND length(title) < 256)
+);
+
+CREATE UNIQUE INDEX donoengine.comic_title_comic_id_rid_key ON comic_title
+    (comic_id, rid);
+CREATE UNIQUE INDEX donoengine.comic_title_comic_id_title_key ON comic_title
+    (comic_id, title);
+
+-- +goose StatementBegin
+CREATE TRIGGER donoengine.comic_title_fkey_insert BEFORE INSERT ON comic_title
+BEGIN
+    SELECT RAISE(ABORT, 'comic_title_comic_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM comic WHERE id = NEW.comic_id);
+    SELECT RAISE(ABORT, 'comic_title_language_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM language WHERE id = NEW.language_id);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER donoengine.comic_title_fkey_update BEFORE UPDATE OF comic_id, language_id ON comic_title
+BEGIN
+    SELECT RAISE(ABORT, 'comic_title_comic_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM comic WHERE id = NEW.comic_id);
+    SELECT RAISE(ABORT, 'comic_title_language_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM language WHERE id = NEW.language_id);
+END;
+-- +goose StatementEnd
+
+-- Comic Cover
+
+CREATE TABLE donoengine.comic_cover (
+    id              integer                     PRIMARY KEY AUTOINCREMENT,
+    created_at      timestamp                   NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at      timestamp,
+
+    comic_id        integer                     NOT NULL,
+    rid             text                        NOT NULL,
+    website_id      integer                     NOT NULL,
+    relative_url    text                        NOT NULL,
+    priority        integer,
+
+    CONSTRAINT comic_cover_comic_id_fkey
+        FOREIGN KEY (comic_id) REFERENCES comic(id) ON DELETE CASCADE,
+    CONSTRAINT comic_cover_website_id_fkey
+        FOREIGN KEY (website_id) REFERENCES website(id) ON DELETE CASCADE,
+    CONSTRAINT comic_cover_rid_check
+        CHECK (length(rid) = 4),
+    CONSTRAINT comic_cover_relative_url_check
+        CHECK (relative_url <> '' AND length(relative_url) <= 128)
+);
+
+CREATE UNIQUE INDEX donoengine.comic_cover_comic_id_rid_key ON comic_cover
+    (comic_id, rid);
+CREATE UNIQUE INDEX donoengine.comic_cover_comic_id_website_id_relative_url_key ON comic_cover
+    (comic_id, website_id, relative_url);
+
+-- +goose StatementBegin
+CREATE TRIGGER donoengine.comic_cover_fkey_insert BEFORE INSERT ON comic_cover
+BEGIN
+    SELECT RAISE(ABORT, 'comic_cover_comic_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM comic WHERE id = NEW.comic_id);
+    SELECT RAISE(ABORT, 'comic_cover_website_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM website WHERE id = NEW.website_id);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER donoengine.comic_cover_fkey_update BEFORE UPDATE OF comic_id, website_id ON comic_cover
+BEGIN
+    SELECT RAISE(ABORT, 'comic_cover_comic_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM comic WHERE id = NEW.comic_id);
+    SELECT RAISE(ABORT, 'comic_cover_website_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM website WHERE id = NEW.website_id);
+END;
+-- +goose StatementEnd
+
+-- Comic Synopsis
+
+CREATE TABLE donoengine.comic_synopsis (
+    id              integer                     PRIMARY KEY AUTOINCREMENT,
+    created_at      timestamp                   NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at      timestamp,
+
+    comic_id        integer                     NOT NULL,
+    rid             text                        NOT NULL,
+    language_id     integer                     NOT NULL,
+    synopsis        text                        NOT NULL,
+    version         text,
+    romanized       boolean,
+
+    CONSTRAINT comic_synopsis_comic_id_fkey
+        FOREIGN KEY (comic_id) REFERENCES comic(id) ON DELETE CASCADE,
+    CONSTRAINT comic_synopsis_language_id_fkey
+        FOREIGN KEY (language_id) REFERENCES language(id),
+    CONSTRAINT comic_synopsis_rid_check
+        CHECK (length(rid) = 4),
+    CONSTRAINT comic_synopsis_synopsis_check
+        CHECK (synopsis <> '' AND length(synopsis) <= 2048),
+    CONSTRAINT comic_synopsis_version_check
+        CHECK (version <> '' AND length(version) <= 12)
+);
+
+CREATE UNIQUE INDEX donoengine.comic_synopsis_comic_id_rid_key ON comic_synopsis
+    (comic_id, rid);
+CREATE UNIQUE INDEX donoengine.comic_synopsis_comic_id_synopsis_key ON comic_synopsis
+    (comic_id, synopsis);
+
+-- +goose StatementBegin
+CREATE TRIGGER donoengine.comic_synopsis_fkey_insert BEFORE INSERT ON comic_synopsis
+BEGIN
+    SELECT RAISE(ABORT, 'comic_synopsis_comic_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM comic WHERE id = NEW.comic_id);
+    SELECT RAISE(ABORT, 'comic_synopsis_language_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM language WHERE id = NEW.language_id);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER donoengine.comic_synopsis_fkey_update BEFORE UPDATE OF comic_id, language_id ON comic_synopsis
+BEGIN
+    SELECT RAISE(ABORT, 'comic_synopsis_comic_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM comic WHERE id = NEW.comic_id);
+    SELECT RAISE(ABORT, 'comic_synopsis_language_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM language WHERE id = NEW.language_id);
+END;
+-- +goose StatementEnd
+
+-- Comic External
+
+CREATE TABLE donoengine.comic_external (
+    id              integer                     PRIMARY KEY AUTOINCREMENT,
+    created_at      timestamp                   NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at      timestamp,
+
+    comic_id        integer                     NOT NULL,
+    rid             text                        NOT NULL,
+    website_id      integer                     NOT NULL,
+    relative_url    text,
+    official        boolean,
+
+    CONSTRAINT comic_external_comic_id_fkey
+        FOREIGN KEY (comic_id) REFERENCES comic(id) ON DELETE CASCADE,
+    CONSTRAINT comic_external_website_id_fkey
+        FOREIGN KEY (website_id) REFERENCES website(id) ON DELETE CASCADE,
+    CONSTRAINT comic_external_rid_check
+        CHECK (length(rid) = 4),
+    CONSTRAINT comic_external_relative_url_check
+        CHECK (relative_url <> '' AND length(relative_url) <= 128)
+);
+
+CREATE UNIQUE INDEX donoengine.comic_external_comic_id_rid_key ON comic_external
+    (comic_id, rid);
+CREATE UNIQUE INDEX donoengine.comic_external_comic_id_website_id_relative_url_key ON comic_external
+    (comic_id, website_id, relative_url);
+
+-- +goose StatementBegin
+CREATE TRIGGER donoengine.comic_external_fkey_insert BEFORE INSERT ON comic_external
+BEGIN
+    SELECT RAISE(ABORT, 'comic_external_comic_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM comic WHERE id = NEW.comic_id);
+    SELECT RAISE(ABORT, 'comic_external_website_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM website WHERE id = NEW.website_id);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER donoengine.comic_external_fkey_update BEFORE UPDATE OF comic_id, website_id ON comic_external
+BEGIN
+    SELECT RAISE(ABORT, 'comic_external_comic_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM comic WHERE id = NEW.comic_id);
+    SELECT RAISE(ABORT, 'comic_external_website_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM website WHERE id = NEW.website_id);
+END;
+-- +goose StatementEnd
+
+-- Comic Category
+
+CREATE TABLE donoengine.comic_category (
+    created_at      timestamp                   NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at      timestamp,
+
+    comic_id        integer                     NOT NULL,
+    category_id     integer                     NOT NULL,
+
+    CONSTRAINT comic_category_pkey
+        PRIMARY KEY (comic_id, category_id),
+    CONSTRAINT comic_category_comic_id_fkey
+        FOREIGN KEY (comic_id) REFERENCES comic(id) ON DELETE CASCADE,
+    CONSTRAINT comic_category_category_id_fkey
+        FOREIGN KEY (category_id) REFERENCES category(id) ON DELETE CASCADE
+);
+
+-- +goose StatementBegin
+CREATE TRIGGER donoengine.comic_category_fkey_insert BEFORE INSERT ON comic_category
+BEGIN
+    SELECT RAISE(ABORT, 'comic_category_comic_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM comic WHERE id = NEW.comic_id);
+    SELECT RAISE(ABORT, 'comic_category_category_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM category WHERE id = NEW.category_id);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER donoengine.comic_category_fkey_update BEFORE UPDATE OF comic_id, category_id ON comic_category
+BEGIN
+    SELECT RAISE(ABORT, 'comic_category_comic_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM comic WHERE id = NEW.comic_id);
+    SELECT RAISE(ABORT, 'comic_category_category_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM category WHERE id = NEW.category_id);
+END;
+-- +goose StatementEnd
+
+-- Comic Tag
+
+CREATE TABLE donoengine.comic_tag (
+    created_at      timestamp                   NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at      timestamp,
+
+    comic_id        integer                     NOT NULL,
+    tag_id          integer                     NOT NULL,
+
+    CONSTRAINT comic_tag_pkey
+        PRIMARY KEY (comic_id, tag_id),
+    CONSTRAINT comic_tag_comic_id_fkey
+        FOREIGN KEY (comic_id) REFERENCES comic(id) ON DELETE CASCADE,
+    CONSTRAINT comic_tag_tag_id_fkey
+        FOREIGN KEY (tag_id) REFERENCES tag(id) ON DELETE CASCADE
+);
+
+-- +goose StatementBegin
+CREATE TRIGGER donoengine.comic_tag_fkey_insert BEFORE INSERT ON comic_tag
+BEGIN
+    SELECT RAISE(ABORT, 'comic_tag_comic_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM comic WHERE id = NEW.comic_id);
+    SELECT RAISE(ABORT, 'comic_tag_tag_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM tag WHERE id = NEW.tag_id);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER donoengine.comic_tag_fkey_update BEFORE UPDATE OF comic_id, tag_id ON comic_tag
+BEGIN
+    SELECT RAISE(ABORT, 'comic_tag_comic_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM comic WHERE id = NEW.comic_id);
+    SELECT RAISE(ABORT, 'comic_tag_tag_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM tag WHERE id = NEW.tag_id);
+END;
+-- +goose StatementEnd
+
+-- Comic Relation Type
+
+CREATE TABLE donoengine.comic_relation_type (
+    id              integer                     PRIMARY KEY AUTOINCREMENT,
+    created_at      timestamp                   NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at      timestamp,
+
+    code            text                        NOT NULL,
+    name            text                        NOT NULL,
+
+    CONSTRAINT comic_relation_type_code_check
+        CHECK (code <> '' AND length(code) <= 24),
+    CONSTRAINT comic_relation_type_name_check
+        CHECK (name <> '' AND length(name) <= 24)
+);
+
+CREATE UNIQUE INDEX donoengine.comic_relation_type_code_key ON comic_relation_type
+    (code);
+
+-- Comic Relation
+
+CREATE TABLE donoengine.comic_relation (
+    created_at      timestamp                   NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at      timestamp,
+
+    type_id         integer                     NOT NULL,
+    parent_id       integer                     NOT NULL,
+    child_id        integer                     NOT NULL,
+
+    CONSTRAINT comic_relation_pkey
+        PRIMARY KEY (type_id, parent_id, child_id),
+    CONSTRAINT comic_relation_type_id_fkey
+        FOREIGN KEY (type_id) REFERENCES comic_relation_type(id),
+    CONSTRAINT comic_relation_parent_id_fkey
+        FOREIGN KEY (parent_id) REFERENCES comic(id) ON DELETE CASCADE,
+    CONSTRAINT comic_relation_child_id_fkey
+        FOREIGN KEY (child_id) REFERENCES comic(id) ON DELETE CASCADE,
+    CONSTRAINT comic_relation_parent_id_child_id_check
+        CHECK (parent_id <> child_id)
+);
+
+-- +goose StatementBegin
+CREATE TRIGGER donoengine.comic_relation_fkey_insert BEFORE INSERT ON comic_relation
+BEGIN
+    SELECT RAISE(ABORT, 'comic_relation_type_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM comic_relation_type WHERE id = NEW.type_id);
+    SELECT RAISE(ABORT, 'comic_relation_parent_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM comic WHERE id = NEW.parent_id);
+    SELECT RAISE(ABORT, 'comic_relation_child_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM comic WHERE id = NEW.child_id);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER donoengine.comic_relation_fkey_update BEFORE UPDATE OF type_id, parent_id, child_id ON comic_relation
+BEGIN
+    SELECT RAISE(ABORT, 'comic_relation_type_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM comic_relation_type WHERE id = NEW.type_id);
+    SELECT RAISE(ABORT, 'comic_relation_parent_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM comic WHERE id = NEW.parent_id);
+    SELECT RAISE(ABORT, 'comic_relation_child_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM comic WHERE id = NEW.child_id);
+END;
+-- +goose StatementEnd
+
+-- Comic Chapter
+
+CREATE TABLE donoengine.comic_chapter (
+    id              integer                     PRIMARY KEY AUTOINCREMENT,
+    created_at      timestamp                   NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at      timestamp,
+
+    comic_id        integer                     NOT NULL,
+    chapter         text                        NOT NULL,
+    version         text,
+    volume          text,
+    released_at     timestamp                   NOT NULL,
+
+    CONSTRAINT comic_chapter_comic_id_fkey
+        FOREIGN KEY (comic_id) REFERENCES comic(id) ON DELETE CASCADE,
+    CONSTRAINT comic_chapter_chapter_check
+        CHECK (chapter <> '' AND length(chapter) <= 64),
+    CONSTRAINT comic_chapter_version_check
+        CHECK (version <> '' AND length(version) <= 32),
+    CONSTRAINT comic_chapter_volume_check
+        CHECK (volume <> '' AND length(volume) <= 24)
+);
+
+-- NULLS NOT DISTINCT, an empty version fails the check.
+CREATE UNIQUE INDEX donoengine.comic_chapter_comic_id_chapter_version_key ON comic_chapter
+    (comic_id, chapter, COALESCE(version, ''));
+
+-- +goose StatementBegin
+CREATE TRIGGER donoengine.comic_chapter_fkey_insert BEFORE INSERT ON comic_chapter
+BEGIN
+    SELECT RAISE(ABORT, 'comic_chapter_comic_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM comic WHERE id = NEW.comic_id);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER donoengine.comic_chapter_fkey_update BEFORE UPDATE OF comic_id ON comic_chapter
+BEGIN
+    SELECT RAISE(ABORT, 'comic_chapter_comic_id_fkey')
+        WHERE NOT EXISTS (SELECT 1 FROM comic WHERE id = NEW.comic_id);
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+
+DROP TABLE donoengine.comic_chapter;
+DROP TABLE donoengine.comic_relation;
+DROP TABLE donoengine.comic_relation_type;
+DROP TABLE donoengine.comic_tag;
+DROP TABLE donoengine.comic_category;
+DROP TABLE donoengine.comic_external;
+DROP TABLE donoengine.comic_synopsis;
+DROP TABLE donoengine.comic_cover;
+DROP TABLE donoengine.comic_title;
+DROP TABLE donoengine.comic;
+DROP TABLE donoengine.tag;
+DROP TABLE donoengine.tag_type;
+DROP TABLE donoengine.category_relation;
+DROP TABLE donoengine.category;
+DROP TABLE donoengine.category_type;
+DROP TABLE donoengine.website;
+DROP TABLE donoengine.language;
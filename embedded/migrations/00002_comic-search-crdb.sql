@@ -0,0 +1,11 @@
+-- +goose Up
+
+CREATE INDEX comic_title_title_trgm_idx ON donoengine.comic_title
+    USING GIN (title gin_trgm_ops);
+CREATE INDEX comic_title_title_tsv_idx ON donoengine.comic_title
+    USING GIN (to_tsvector('simple', title));
+
+-- +goose Down
+
+DROP INDEX donoengine.comic_title@comic_title_title_tsv_idx;
+DROP INDEX donoengine.comic_title@comic_title_title_trgm_idx;
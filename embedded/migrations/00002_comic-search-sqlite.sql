@@ -0,0 +1,8 @@
+-- +goose Up
+
+CREATE INDEX donoengine.comic_title_title_idx ON comic_title
+    (title COLLATE NOCASE);
+
+-- +goose Down
+
+DROP INDEX donoengine.comic_title_title_idx;
@@ -0,0 +1,22 @@
+-- +goose Up
+
+CREATE TABLE donoengine.json_schema (
+    id              bigint                      PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    created_at      timestamp with time zone    NOT NULL DEFAULT timezone('UTC', now()),
+    updated_at      timestamp with time zone,
+
+    name            text                        NOT NULL,
+    schema          jsonb                       NOT NULL
+);
+
+ALTER TABLE ONLY donoengine.json_schema ADD CONSTRAINT json_schema_name_key
+    UNIQUE (name);
+
+CREATE INDEX comic_additionals_idx ON donoengine.comic
+    USING GIN (additionals);
+
+-- +goose Down
+
+DROP INDEX donoengine.comic@comic_additionals_idx;
+
+DROP TABLE donoengine.json_schema;
@@ -0,0 +1,17 @@
+-- +goose Up
+
+CREATE TABLE donoengine.json_schema (
+    id              integer                     PRIMARY KEY AUTOINCREMENT,
+    created_at      timestamp                   NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at      timestamp,
+
+    name            text                        NOT NULL,
+    schema          text                        NOT NULL
+);
+
+CREATE UNIQUE INDEX donoengine.json_schema_name_key ON json_schema
+    (name);
+
+-- +goose Down
+
+DROP TABLE donoengine.json_schema;
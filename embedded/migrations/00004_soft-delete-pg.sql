@@ -0,0 +1,11 @@
+-- +goose Up
+
+ALTER TABLE donoengine.comic ADD COLUMN deleted_at timestamp with time zone;
+ALTER TABLE donoengine.category ADD COLUMN deleted_at timestamp with time zone;
+ALTER TABLE donoengine.tag ADD COLUMN deleted_at timestamp with time zone;
+
+-- +goose Down
+
+ALTER TABLE donoengine.tag DROP COLUMN deleted_at;
+ALTER TABLE donoengine.category DROP COLUMN deleted_at;
+ALTER TABLE donoengine.comic DROP COLUMN deleted_at;
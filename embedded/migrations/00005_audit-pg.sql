@@ -0,0 +1,23 @@
+-- +goose Up
+
+CREATE TABLE donoengine.audit (
+    id              bigint                      PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    created_at      timestamp with time zone    NOT NULL DEFAULT timezone('UTC', now()),
+
+    actor           text                        NOT NULL,
+    action          text                        NOT NULL,
+    entity          text                        NOT NULL,
+    entity_key      text                        NOT NULL,
+    data_before     jsonb,
+    data_after      jsonb
+);
+
+CREATE INDEX audit_entity_idx ON donoengine.audit
+    USING btree (entity, entity_key);
+
+CREATE INDEX audit_actor_idx ON donoengine.audit
+    USING btree (actor);
+
+-- +goose Down
+
+DROP TABLE donoengine.audit;
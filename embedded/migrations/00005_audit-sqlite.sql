@@ -0,0 +1,23 @@
+-- +goose Up
+
+CREATE TABLE donoengine.audit (
+    id              integer                     PRIMARY KEY AUTOINCREMENT,
+    created_at      timestamp                   NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+
+    actor           text                        NOT NULL,
+    action          text                        NOT NULL,
+    entity          text                        NOT NULL,
+    entity_key      text                        NOT NULL,
+    data_before     text,
+    data_after      text
+);
+
+CREATE INDEX donoengine.audit_entity_idx ON audit
+    (entity, entity_key);
+
+CREATE INDEX donoengine.audit_actor_idx ON audit
+    (actor);
+
+-- +goose Down
+
+DROP TABLE donoengine.audit;
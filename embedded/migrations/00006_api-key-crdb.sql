@@ -0,0 +1,24 @@
+-- +goose Up
+
+CREATE TABLE donoengine.api_key (
+    id              bigint                      PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    created_at      timestamp with time zone    NOT NULL DEFAULT timezone('UTC', now()),
+    updated_at      timestamp with time zone,
+
+    name            text                        NOT NULL,
+    prefix          text                        NOT NULL,
+    hash            text                        NOT NULL,
+    permissions     jsonb                       NOT NULL,
+    expires_at      timestamp with time zone,
+    last_used_at    timestamp with time zone
+);
+
+ALTER TABLE ONLY donoengine.api_key ADD CONSTRAINT api_key_hash_key
+    UNIQUE (hash);
+
+ALTER TABLE ONLY donoengine.api_key ADD CONSTRAINT api_key_name_check
+    CHECK (name <> '' AND length(name) <= 48);
+
+-- +goose Down
+
+DROP TABLE donoengine.api_key;
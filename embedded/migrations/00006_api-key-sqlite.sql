@@ -0,0 +1,24 @@
+-- +goose Up
+
+CREATE TABLE donoengine.api_key (
+    id              integer                     PRIMARY KEY AUTOINCREMENT,
+    created_at      timestamp                   NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at      timestamp,
+
+    name            text                        NOT NULL,
+    prefix          text                        NOT NULL,
+    hash            text                        NOT NULL,
+    permissions     text                        NOT NULL,
+    expires_at      timestamp,
+    last_used_at    timestamp,
+
+    CONSTRAINT api_key_name_check
+        CHECK (name <> '' AND length(name) <= 48)
+);
+
+CREATE UNIQUE INDEX donoengine.api_key_hash_key ON api_key
+    (hash);
+
+-- +goose Down
+
+DROP TABLE donoengine.api_key;
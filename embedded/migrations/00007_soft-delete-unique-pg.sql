@@ -0,0 +1,27 @@
+-- +goose Up
+
+ALTER TABLE ONLY donoengine.comic DROP CONSTRAINT comic_code_key;
+CREATE UNIQUE INDEX comic_code_key ON donoengine.comic
+    (code) WHERE deleted_at IS NULL;
+
+ALTER TABLE ONLY donoengine.category DROP CONSTRAINT category_type_id_code_key;
+CREATE UNIQUE INDEX category_type_id_code_key ON donoengine.category
+    (type_id, code) WHERE deleted_at IS NULL;
+
+ALTER TABLE ONLY donoengine.tag DROP CONSTRAINT tag_type_id_code_key;
+CREATE UNIQUE INDEX tag_type_id_code_key ON donoengine.tag
+    (type_id, code) WHERE deleted_at IS NULL;
+
+-- +goose Down
+
+DROP INDEX donoengine.tag_type_id_code_key;
+ALTER TABLE ONLY donoengine.tag ADD CONSTRAINT tag_type_id_code_key
+    UNIQUE (type_id, code);
+
+DROP INDEX donoengine.category_type_id_code_key;
+ALTER TABLE ONLY donoengine.category ADD CONSTRAINT category_type_id_code_key
+    UNIQUE (type_id, code);
+
+DROP INDEX donoengine.comic_code_key;
+ALTER TABLE ONLY donoengine.comic ADD CONSTRAINT comic_code_key
+    UNIQUE (code);
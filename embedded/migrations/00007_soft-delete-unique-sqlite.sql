@@ -0,0 +1,27 @@
+-- +goose Up
+
+DROP INDEX donoengine.comic_code_key;
+CREATE UNIQUE INDEX donoengine.comic_code_key ON comic
+    (code) WHERE deleted_at IS NULL;
+
+DROP INDEX donoengine.category_type_id_code_key;
+CREATE UNIQUE INDEX donoengine.category_type_id_code_key ON category
+    (type_id, code) WHERE deleted_at IS NULL;
+
+DROP INDEX donoengine.tag_type_id_code_key;
+CREATE UNIQUE INDEX donoengine.tag_type_id_code_key ON tag
+    (type_id, code) WHERE deleted_at IS NULL;
+
+-- +goose Down
+
+DROP INDEX donoengine.tag_type_id_code_key;
+CREATE UNIQUE INDEX donoengine.tag_type_id_code_key ON tag
+    (type_id, code);
+
+DROP INDEX donoengine.category_type_id_code_key;
+CREATE UNIQUE INDEX donoengine.category_type_id_code_key ON category
+    (type_id, code);
+
+DROP INDEX donoengine.comic_code_key;
+CREATE UNIQUE INDEX donoengine.comic_code_key ON comic
+    (code);
@@ -2,6 +2,7 @@ package oauth
 
 import (
 	"context"
+	"encoding/gob"
 	"errors"
 	"reflect"
 	"sync"
@@ -20,15 +21,22 @@ func newCTokenCache(rdb Redis) cTokenCacheStore {
 	if rdb != nil && !reflect.ValueOf(rdb).IsNil() {
 		return cTokenCacheRedis{rdb}
 	}
-	memory := &cTokenCacheMemory{tokens: make(map[string]accessToken)}
+	memory := &cTokenCacheMemory{tokens: make(map[string]cTokenCacheEntry)}
 	go memory.BackgroundPurger()
 	return memory
 }
 
-type cTokenCacheMemory struct {
-	tokens map[string]accessToken
-	mu     sync.Mutex
-}
+type (
+	cTokenCacheMemory struct {
+		tokens map[string]cTokenCacheEntry
+		mu     sync.Mutex
+	}
+
+	cTokenCacheEntry struct {
+		token  accessToken
+		expiry time.Time
+	}
+)
 
 const cTokenCachePurge = 1 * time.Hour
 
@@ -37,32 +45,35 @@ func (ctcm *cTokenCacheMemory) GetToken(ctx context.Context, id string) (*access
 	defer ctcm.mu.Unlock()
 
 	val, ok := ctcm.tokens[id]
-	if !ok || time.Until(val.Expiration)/2 <= 0 {
+	if !ok || !time.Now().Before(val.expiry) {
 		delete(ctcm.tokens, id)
 		return nil, nil
 	}
-	return &val, nil
+	return &val.token, nil
 }
 
 func (ctcm *cTokenCacheMemory) SetToken(ctx context.Context, id string, token *accessToken) error {
 	ctcm.mu.Lock()
 	defer ctcm.mu.Unlock()
 
-	if token != nil {
-		ctcm.tokens[id] = *token
+	if token == nil {
+		return nil
+	}
+	if expiry := token.cacheExpiry(); expiry > 0 {
+		ctcm.tokens[id] = cTokenCacheEntry{token: *token, expiry: time.Now().Add(expiry)}
 	}
 	return nil
 }
 
 func (ctcm *cTokenCacheMemory) BackgroundPurger() {
 	for {
+		ctcm.mu.Lock()
 		for key, val := range ctcm.tokens {
-			if time.Until(val.Expiration)/2 <= 0 {
-				ctcm.mu.Lock()
+			if !time.Now().Before(val.expiry) {
 				delete(ctcm.tokens, key)
-				ctcm.mu.Unlock()
 			}
 		}
+		ctcm.mu.Unlock()
 		time.Sleep(cTokenCachePurge)
 	}
 }
@@ -73,8 +84,14 @@ type cTokenCacheRedis struct {
 
 var cTokenCacheRedisPrefix = donoengine.ID + ":oauth:token:"
 
+func init() {
+	// Claim values decoded from JSON.
+	gob.Register([]any{})
+	gob.Register(map[string]any{})
+}
+
 func (ctcr cTokenCacheRedis) GetToken(ctx context.Context, id string) (*accessToken, error) {
-	var token *accessToken
+	token := new(accessToken)
 	if err := ctcr.rdb.GobGet(ctx, cTokenCacheRedisPrefix+id, token); err != nil {
 		if errors.As(err, &model.ErrNotFound) {
 			return nil, nil
@@ -85,7 +102,7 @@ func (ctcr cTokenCacheRedis) GetToken(ctx context.Context, id string) (*accessTo
 }
 
 func (ctcr cTokenCacheRedis) SetToken(ctx context.Context, id string, token *accessToken) error {
-	expiry := time.Until(token.Expiration) / 2
+	expiry := token.cacheExpiry()
 	if expiry <= 0 {
 		return nil
 	}
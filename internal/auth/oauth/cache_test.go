@@ -0,0 +1,127 @@
+package oauth
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+// gobRedis stores values gob encoded like the real store does.
+type gobRedis struct {
+	values map[string][]byte
+	exps   map[string]time.Duration
+}
+
+func newGobRedis() *gobRedis {
+	return &gobRedis{values: make(map[string][]byte), exps: make(map[string]time.Duration)}
+}
+
+func (r *gobRedis) GobGet(ctx context.Context, key string, v any) error {
+	buf, ok := r.values[key]
+	if !ok {
+		return model.NotFoundError(errors.New("redis: nil"))
+	}
+	return gob.NewDecoder(bytes.NewBuffer(buf)).Decode(v)
+}
+
+func (r *gobRedis) GobSet(ctx context.Context, key string, v any, exp time.Duration) error {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return err
+	}
+	r.values[key], r.exps[key] = buf.Bytes(), exp
+	return nil
+}
+
+func TestCTokenCacheRedis(t *testing.T) {
+	ctx := context.Background()
+	rdb := newGobRedis()
+	cache := newCTokenCache(rdb)
+
+	token, err := cache.GetToken(ctx, "missing")
+	if err != nil || token != nil {
+		t.Fatalf("GetToken(missing) = %v, %v, want nil, nil", token, err)
+	}
+
+	want := &accessToken{
+		Subject:     "user",
+		Expiration:  time.Now().Add(time.Hour).UTC().Truncate(time.Second),
+		Permissions: []string{"orenocomic.website.write"},
+		Others: map[string]any{
+			"scope": "openid",
+			"roles": []any{"admin"},
+			"extra": map[string]any{"tenant": "example"},
+		},
+		CacheTTL: time.Minute,
+	}
+	if err := cache.SetToken(ctx, "token", want); err != nil {
+		t.Fatalf("SetToken: %v", err)
+	}
+	if exp := rdb.exps[cTokenCacheRedisPrefix+"token"]; exp != time.Minute {
+		t.Errorf("cache expiry = %v, want %v", exp, time.Minute)
+	}
+
+	got, err := cache.GetToken(ctx, "token")
+	if err != nil {
+		t.Fatalf("GetToken: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetToken = %+v, want %+v", got, want)
+	}
+}
+
+func TestCTokenCacheMemory(t *testing.T) {
+	ctx := context.Background()
+	cache := &cTokenCacheMemory{tokens: make(map[string]cTokenCacheEntry)}
+
+	exp := time.Now().Add(time.Hour)
+	if err := cache.SetToken(ctx, "jwt", &accessToken{Subject: "a", Expiration: exp}); err != nil {
+		t.Fatalf("SetToken: %v", err)
+	}
+	if err := cache.SetToken(ctx, "opaque", &accessToken{Subject: "b", Expiration: exp, CacheTTL: time.Nanosecond}); err != nil {
+		t.Fatalf("SetToken: %v", err)
+	}
+	if err := cache.SetToken(ctx, "expired", &accessToken{Subject: "c", Expiration: time.Now().Add(-time.Hour)}); err != nil {
+		t.Fatalf("SetToken: %v", err)
+	}
+	time.Sleep(time.Millisecond)
+
+	if token, _ := cache.GetToken(ctx, "jwt"); token == nil || token.Subject != "a" {
+		t.Errorf("GetToken(jwt) = %v, want subject a", token)
+	}
+	if token, _ := cache.GetToken(ctx, "opaque"); token != nil {
+		t.Errorf("GetToken(opaque) = %v, want evicted after its cache ttl", token)
+	}
+	if token, _ := cache.GetToken(ctx, "expired"); token != nil {
+		t.Errorf("GetToken(expired) = %v, want not cached", token)
+	}
+}
+
+func TestAccessTokenCacheExpiry(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name  string
+		token accessToken
+		min   time.Duration
+		max   time.Duration
+	}{
+		{"half lifetime", accessToken{Expiration: now.Add(time.Hour)}, 29 * time.Minute, 30 * time.Minute},
+		{"capped", accessToken{Expiration: now.Add(time.Hour), CacheTTL: time.Minute}, time.Minute, time.Minute},
+		{"below cap", accessToken{Expiration: now.Add(time.Minute), CacheTTL: time.Hour}, 29 * time.Second, 30 * time.Second},
+		{"no expiration", accessToken{CacheTTL: time.Minute}, time.Minute, time.Minute},
+		{"no expiration nor cap", accessToken{}, -1 << 63, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.token.cacheExpiry(); got < tt.min || got > tt.max {
+				t.Errorf("cacheExpiry = %v, want within [%v, %v]", got, tt.min, tt.max)
+			}
+		})
+	}
+}
@@ -73,3 +73,27 @@ func (oa OAuth) HasPermissionContext(ctx context.Context, permission string) boo
 	}
 	return token.HasPermission(permission)
 }
+
+func (oa OAuth) HasScopeContext(ctx context.Context, scope string) bool {
+	token, err := oa.getAccessTokenContext(ctx)
+	if err != nil {
+		return false
+	}
+	return token.HasScope(scope)
+}
+
+func (oa OAuth) IssuerContext(ctx context.Context) string {
+	token, err := oa.getAccessTokenContext(ctx)
+	if err != nil {
+		return ""
+	}
+	return token.Issuer
+}
+
+func (oa OAuth) SubjectContext(ctx context.Context) string {
+	token, err := oa.getAccessTokenContext(ctx)
+	if err != nil {
+		return ""
+	}
+	return token.Subject
+}
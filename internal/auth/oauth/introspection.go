@@ -0,0 +1,128 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwt"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+// introspector asks the issuer about opaque access tokens (RFC 7662),
+// authenticating as a confidential client.
+type introspector struct {
+	endpoint     string
+	clientID     string
+	clientSecret string
+	client       *http.Client
+}
+
+func newIntrospector(ctx context.Context, client *http.Client, cfg Config) (*introspector, error) {
+	if cfg.ClientID == "" {
+		return nil, errors.New("oauth introspection mode requires client id")
+	}
+
+	endpoint := cfg.IntrospectionEndpoint
+	if endpoint == "" {
+		metadata, err := discoveryMetadata(ctx, client, cfg)
+		if err != nil {
+			return nil, err
+		}
+		if metadata.IntrospectionEndpoint == "" {
+			return nil, errors.New("oauth issuer has no introspection endpoint")
+		}
+		endpoint = metadata.IntrospectionEndpoint
+	}
+
+	return &introspector{
+		endpoint:     endpoint,
+		clientID:     cfg.ClientID,
+		clientSecret: cfg.ClientSecret,
+		client:       client,
+	}, nil
+}
+
+func (in introspector) introspect(ctx context.Context, token string) (map[string]any, error) {
+	form := url.Values{"token": {token}, "token_type_hint": {"access_token"}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, in.endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(in.clientID), url.QueryEscape(in.clientSecret))
+
+	res, err := in.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("introspection endpoint responded with status %d", res.StatusCode)
+	}
+
+	var data map[string]any
+	if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (ti trustedIssuer) introspectAccessToken(ctx context.Context, token string) (*accessToken, error) {
+	claims, err := ti.introspection.introspect(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if active, _ := claims["active"].(bool); !active {
+		return nil, model.GenericError("inactive access token")
+	}
+	delete(claims, "active")
+
+	if iss, ok := claims[jwt.IssuerKey].(string); ok && ti.issuer != "" && iss != ti.issuer {
+		return nil, model.GenericError("invalid access token issuer")
+	}
+	if ti.audience != "" && !introspectionAudience(claims[jwt.AudienceKey], ti.audience) {
+		return nil, model.GenericError("invalid access token audience")
+	}
+
+	result := &accessToken{Issuer: ti.issuer, Others: claims, CacheTTL: ti.introspectionCacheTTL}
+	if iss, ok := claims[jwt.IssuerKey].(string); ok {
+		result.Issuer = iss
+		delete(claims, jwt.IssuerKey)
+	}
+	if sub, ok := claims[jwt.SubjectKey].(string); ok {
+		result.Subject = sub
+		delete(claims, jwt.SubjectKey)
+	}
+	if exp, ok := claims[jwt.ExpirationKey].(float64); ok {
+		result.Expiration = time.Unix(int64(exp), 0).UTC()
+		delete(claims, jwt.ExpirationKey)
+		if !result.Expiration.After(time.Now()) {
+			return nil, model.WrappedError(jwt.ErrTokenExpired(), "expired access token")
+		}
+	}
+	return result, nil
+}
+
+// The audience is optional in the response, only a present one is checked.
+func introspectionAudience(aud any, audience string) bool {
+	switch aud := aud.(type) {
+	case nil:
+		return true
+	case string:
+		return aud == audience
+	case []any:
+		return slices.Contains(aud, any(audience))
+	}
+	return false
+}
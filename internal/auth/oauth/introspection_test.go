@@ -0,0 +1,64 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/logger"
+)
+
+func TestIntrospectAccessToken(t *testing.T) {
+	exp := time.Now().Add(time.Hour).Unix()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if id, secret, _ := r.BasicAuth(); id != "client" || secret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		data := map[string]any{"active": false}
+		switch r.PostFormValue("token") {
+		case "active":
+			data = map[string]any{
+				"active": true, "iss": "https://issuer.example.com/", "sub": "user", "exp": exp,
+				"aud": []string{"donoengine"},
+			}
+		case "other-audience":
+			data = map[string]any{"active": true, "sub": "user", "exp": exp, "aud": "other"}
+		case "expired":
+			data = map[string]any{"active": true, "sub": "user", "exp": time.Now().Add(-time.Hour).Unix()}
+		}
+		json.NewEncoder(w).Encode(data)
+	}))
+	defer srv.Close()
+
+	ti, err := newTrustedIssuer(context.Background(), srv.Client(), Config{
+		Audience:              "donoengine",
+		Mode:                  "introspection",
+		IntrospectionEndpoint: srv.URL,
+		ClientID:              "client",
+		ClientSecret:          "secret",
+	}, logger.New())
+	if err != nil {
+		t.Fatalf("newTrustedIssuer: %v", err)
+	}
+
+	token, err := ti.parseAccessToken(context.Background(), "active")
+	if err != nil {
+		t.Fatalf("parseAccessToken(active): %v", err)
+	}
+	if token.Issuer != "https://issuer.example.com/" || token.Subject != "user" || token.Expiration.Unix() != exp {
+		t.Errorf("parseAccessToken(active) = %+v", token)
+	}
+	if token.CacheTTL != introspectionCacheTTLDef {
+		t.Errorf("CacheTTL = %v, want %v", token.CacheTTL, introspectionCacheTTLDef)
+	}
+
+	for _, raw := range []string{"inactive", "other-audience", "expired"} {
+		if _, err := ti.parseAccessToken(context.Background(), raw); err == nil {
+			t.Errorf("parseAccessToken(%s) succeeded, want error", raw)
+		}
+	}
+}
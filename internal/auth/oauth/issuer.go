@@ -0,0 +1,150 @@
+package oauth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/logger"
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+const (
+	permissionClaimDef       = "permissions"
+	introspectionCacheTTLDef = 1 * time.Minute
+)
+
+type trustedIssuer struct {
+	issuer           string
+	audience         string
+	jwks             jwk.Set
+	jwksOptions      []any
+	jwksRemote       *remoteKeySet
+	introspection    *introspector
+	permissionPrefix string
+	permissionClaim  string
+
+	introspectionCacheTTL time.Duration
+}
+
+func newTrustedIssuer(ctx context.Context, client *http.Client, cfg Config, log logger.Logger) (*trustedIssuer, error) {
+	ti := &trustedIssuer{
+		issuer:           cfg.Issuer,
+		audience:         cfg.Audience,
+		permissionPrefix: cfg.PermissionPrefix,
+		permissionClaim:  cfg.PermissionClaim,
+	}
+	if ti.permissionClaim == "" {
+		ti.permissionClaim = permissionClaimDef
+	}
+
+	var err error
+	switch mode := strings.ToLower(cfg.Mode); mode {
+	case "", "discovery":
+		var metadata *authServerMetadata
+		if metadata, err = discoveryMetadata(ctx, client, cfg); err != nil {
+			break
+		}
+		if ti.jwksRemote, err = newRemoteKeySet(ctx, client, metadata.JWKSURI, cfg, log); err != nil {
+			break
+		}
+		ti.jwks = ti.jwksRemote.Set()
+	case "static":
+		ti.jwks, err = staticKeySet(cfg)
+		// Local keys rarely carry a kid or alg.
+		ti.jwksOptions = append(ti.jwksOptions, jws.WithRequireKid(false), jws.WithInferAlgorithmFromKey(true))
+	case "introspection":
+		ti.introspection, err = newIntrospector(ctx, client, cfg)
+		if ti.introspectionCacheTTL = cfg.IntrospectionCacheTTL; ti.introspectionCacheTTL <= 0 {
+			ti.introspectionCacheTTL = introspectionCacheTTLDef
+		}
+	default:
+		err = errors.New("oauth mode " + mode + " not supported")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return ti, nil
+}
+
+// trustedIssuer picks the issuer by the unverified iss claim. A JWT from an
+// unknown issuer falls back to a key based issuer without one configured, an
+// opaque token to the first introspection issuer.
+func (oa OAuth) trustedIssuer(token string) (*trustedIssuer, error) {
+	iss, opaque := "", true
+	if result, err := jwt.ParseInsecure([]byte(token)); err == nil {
+		iss, opaque = result.Issuer(), false
+	}
+
+	if !opaque && iss != "" {
+		for _, ti := range oa.issuers {
+			if ti.issuer == iss {
+				return ti, nil
+			}
+		}
+	}
+	for _, ti := range oa.issuers {
+		switch {
+		case opaque && ti.introspection != nil:
+			return ti, nil
+		case !opaque && ti.introspection == nil && ti.issuer == "":
+			return ti, nil
+		}
+	}
+	return nil, model.GenericError("untrusted access token issuer")
+}
+
+func (ti trustedIssuer) parseAccessToken(ctx context.Context, token string) (*accessToken, error) {
+	if ti.introspection != nil {
+		return ti.introspectAccessToken(ctx, token)
+	}
+	if ti.jwksRemote != nil {
+		ti.jwksRemote.EnsureKeyID(ctx, token)
+	}
+	jwtParseOpts := []jwt.ParseOption{
+		jwt.WithContext(ctx),
+		jwt.WithKeySet(ti.jwks, ti.jwksOptions...),
+	}
+	if ti.issuer != "" {
+		jwtParseOpts = append(jwtParseOpts, jwt.WithIssuer(ti.issuer))
+	}
+	if ti.audience != "" {
+		jwtParseOpts = append(jwtParseOpts, jwt.WithAudience(ti.audience))
+	}
+	result, err := jwt.ParseString(token, jwtParseOpts...)
+	if err != nil {
+		switch {
+		case errors.Is(err, jwt.ErrTokenExpired()):
+			return nil, model.WrappedError(err, "expired access token")
+		case jwt.IsValidationError(err):
+			return nil, model.WrappedError(err, "invalid access token")
+		}
+		return nil, err
+	}
+	return &accessToken{
+		Issuer:     result.Issuer(),
+		Subject:    result.Subject(),
+		Expiration: result.Expiration(),
+		Others:     result.PrivateClaims(),
+	}, nil
+}
+
+// permissions returns the token permissions under the primary issuer prefix,
+// those without this issuer prefix are dropped.
+func (ti trustedIssuer) permissions(oa OAuth, at accessToken) []string {
+	p0, _ := at.Claim(ti.permissionClaim)
+	var result []string
+	for _, p1 := range claimStrings(p0) {
+		if key, ok := strings.CutPrefix(p1, ti.permissionPrefix+"."); ok {
+			result = append(result, oa.TokenPermissionKey(key))
+		}
+	}
+	return result
+}
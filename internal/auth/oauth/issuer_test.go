@@ -0,0 +1,93 @@
+package oauth
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/logger"
+)
+
+func signedToken(t *testing.T, secret, iss string, claims map[string]any) string {
+	t.Helper()
+	builder := jwt.NewBuilder().Subject("user").Expiration(time.Now().Add(time.Hour))
+	if iss != "" {
+		builder = builder.Issuer(iss)
+	}
+	for k, v := range claims {
+		builder = builder.Claim(k, v)
+	}
+	token, err := builder.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, err := jwt.Sign(token, jwt.WithKey(jwa.HS256, []byte(secret)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(signed)
+}
+
+func TestTrustedIssuerPermissions(t *testing.T) {
+	oa, err := New(context.Background(), nil, Config{
+		Issuer:           "https://primary.example.com/",
+		PermissionPrefix: "orenocomic",
+		PermissionClaim:  "perms",
+		Mode:             "static",
+		HMACSecret:       "primary-secret",
+		Issuers: []Config{
+			{
+				Issuer:     "https://inherit.example.com/",
+				Mode:       "static",
+				HMACSecret: "inherit-secret",
+			},
+			{
+				Issuer:           "https://own.example.com/",
+				PermissionPrefix: "other",
+				Mode:             "static",
+				HMACSecret:       "own-secret",
+			},
+			{
+				Mode:       "static",
+				HMACSecret: "fallback-secret",
+			},
+		},
+	}, logger.New())
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	perms := map[string]any{"perms": []string{"orenocomic.website.write", "other.comic.write"}}
+	tests := []struct {
+		name  string
+		token string
+		want  []string
+	}{
+		{"primary", signedToken(t, "primary-secret", "https://primary.example.com/", perms), []string{"orenocomic.website.write"}},
+		{"inherited prefix and claim", signedToken(t, "inherit-secret", "https://inherit.example.com/", perms), []string{"orenocomic.website.write"}},
+		{"own prefix", signedToken(t, "own-secret", "https://own.example.com/", perms), []string{"orenocomic.comic.write"}},
+		{"fallback any iss", signedToken(t, "fallback-secret", "https://unknown.example.com/", perms), []string{"orenocomic.website.write"}},
+		{"fallback no iss", signedToken(t, "fallback-secret", "", perms), []string{"orenocomic.website.write"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := oa.parseAccessToken(context.Background(), tt.token)
+			if err != nil {
+				t.Fatalf("parseAccessToken: %v", err)
+			}
+			if !slices.Equal(token.Permissions, tt.want) {
+				t.Errorf("Permissions = %v, want %v", token.Permissions, tt.want)
+			}
+		})
+	}
+
+	// A known issuer is never served by the fallback keys.
+	forged := signedToken(t, "fallback-secret", "https://primary.example.com/", perms)
+	if _, err := oa.parseAccessToken(context.Background(), forged); err == nil {
+		t.Error("parseAccessToken accepted a known issuer signed by other keys")
+	}
+}
@@ -0,0 +1,118 @@
+package oauth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/logger"
+)
+
+const jwksRefetchIntervalDef = 1 * time.Minute
+
+// remoteKeySet keeps the issuer JWKS fresh in the background and refetches it
+// when a token names a key it does not know yet, at most once per interval.
+type remoteKeySet struct {
+	cache           *jwk.Cache
+	url             string
+	refetchInterval time.Duration
+	logger          logger.Logger
+
+	mu          sync.Mutex
+	lastRefetch time.Time
+	lastErr     error
+}
+
+func newRemoteKeySet(ctx context.Context, client *http.Client, url string, cfg Config, log logger.Logger) (*remoteKeySet, error) {
+	rks := &remoteKeySet{
+		url:             url,
+		refetchInterval: cfg.JWKSRefetchInterval,
+		logger:          log,
+	}
+	if rks.refetchInterval <= 0 {
+		rks.refetchInterval = jwksRefetchIntervalDef
+	}
+
+	cacheOpts := []jwk.CacheOption{jwk.WithErrSink(rks)}
+	registerOpts := []jwk.RegisterOption{
+		jwk.WithHTTPClient(client),
+		jwk.WithPostFetcher(jwk.PostFetchFunc(func(_ string, set jwk.Set) (jwk.Set, error) {
+			rks.setErr(nil)
+			return set, nil
+		})),
+	}
+	if cfg.JWKSRefreshInterval > 0 {
+		cacheOpts = append(cacheOpts, jwk.WithRefreshWindow(cfg.JWKSRefreshInterval))
+		registerOpts = append(registerOpts, jwk.WithRefreshInterval(cfg.JWKSRefreshInterval))
+	}
+
+	rks.cache = jwk.NewCache(ctx, cacheOpts...)
+	if err := rks.cache.Register(url, registerOpts...); err != nil {
+		return nil, err
+	}
+	if _, err := rks.cache.Refresh(ctx, url); err != nil {
+		return nil, err
+	}
+
+	return rks, nil
+}
+
+func (rks *remoteKeySet) Set() jwk.Set {
+	return jwk.NewCachedSet(rks.cache, rks.url)
+}
+
+// Error receives background refresh failures from the cache.
+func (rks *remoteKeySet) Error(err error) {
+	rks.logger.ErrMessage(err, "Refresh JWKS failed.", "url", rks.url)
+	rks.setErr(err)
+}
+
+func (rks *remoteKeySet) setErr(err error) {
+	rks.mu.Lock()
+	defer rks.mu.Unlock()
+
+	rks.lastErr = err
+}
+
+func (rks *remoteKeySet) Health() error {
+	rks.mu.Lock()
+	defer rks.mu.Unlock()
+
+	return rks.lastErr
+}
+
+func (rks *remoteKeySet) EnsureKeyID(ctx context.Context, token string) {
+	msg, err := jws.ParseString(token)
+	if err != nil || len(msg.Signatures()) < 1 {
+		return
+	}
+	kid := msg.Signatures()[0].ProtectedHeaders().KeyID()
+	if kid == "" {
+		return
+	}
+	if set, err := rks.cache.Get(ctx, rks.url); err == nil {
+		if _, ok := set.LookupKeyID(kid); ok {
+			return
+		}
+	}
+
+	rks.mu.Lock()
+	if time.Since(rks.lastRefetch) < rks.refetchInterval {
+		rks.mu.Unlock()
+		return
+	}
+	rks.lastRefetch = time.Now()
+	rks.mu.Unlock()
+
+	if _, err := rks.cache.Refresh(ctx, rks.url); err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+		rks.Error(err)
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"net/http"
 	"time"
 
-	"github.com/lestrrat-go/jwx/v2/jwk"
 	"github.com/lestrrat-go/jwx/v2/jwt"
 
 	"github.com/mahmudindes/orenocomic-donoengine/internal/logger"
@@ -16,9 +15,7 @@ import (
 
 type (
 	OAuth struct {
-		issuer           string
-		audience         string
-		jwks             jwk.Set
+		issuers          []*trustedIssuer
 		permissionPrefix string
 		httpClient       *http.Client
 		cTokenCache      cTokenCacheStore
@@ -29,6 +26,33 @@ type (
 		Issuer           string `conf:"issuer"`
 		Audience         string `conf:"audience"`
 		PermissionPrefix string `conf:"permission_prefix"`
+		PermissionClaim  string `conf:"permission_claim"`
+
+		Mode           string   `conf:"mode"`
+		JWKSFile       string   `conf:"jwks_file"`
+		PublicKeyFiles []string `conf:"public_key_files"`
+		HMACSecret     string   `conf:"hmac_secret"`
+
+		JWKSRefreshInterval time.Duration `conf:"jwks_refresh_interval"`
+		JWKSRefetchInterval time.Duration `conf:"jwks_refetch_interval"`
+
+		IntrospectionEndpoint string `conf:"introspection_endpoint"`
+		ClientID              string `conf:"client_id"`
+		ClientSecret          string `conf:"client_secret"`
+		// IntrospectionCacheTTL bounds how long a revoked opaque token is
+		// still accepted from the cache.
+		IntrospectionCacheTTL time.Duration `conf:"introspection_cache_ttl"`
+
+		// Issuers are trusted in addition to the one above, their own issuers
+		// list is ignored and an empty permission prefix or claim is taken
+		// from the one above.
+		Issuers []Config `conf:"issuers"`
+	}
+
+	authServerMetadata struct {
+		Issuer                string `json:"issuer"`
+		JWKSURI               string `json:"jwks_uri"`
+		IntrospectionEndpoint string `json:"introspection_endpoint"`
 	}
 
 	Redis interface {
@@ -38,13 +62,40 @@ type (
 )
 
 func New(ctx context.Context, rdb Redis, cfg Config, log logger.Logger) (*OAuth, error) {
-	data := struct {
-		Issuer  string `json:"issuer"`
-		JWKSURI string `json:"jwks_uri"`
-	}{}
-
 	client := &http.Client{Timeout: 15 * time.Second}
 
+	var issuers []*trustedIssuer
+	for i, icfg := range append([]Config{cfg}, cfg.Issuers...) {
+		if i > 0 {
+			if icfg.PermissionPrefix == "" {
+				icfg.PermissionPrefix = cfg.PermissionPrefix
+			}
+			if icfg.PermissionClaim == "" {
+				icfg.PermissionClaim = cfg.PermissionClaim
+			}
+		}
+		ti, err := newTrustedIssuer(ctx, client, icfg, log)
+		if err != nil {
+			if icfg.Issuer != "" {
+				return nil, fmt.Errorf("issuer %s: %w", icfg.Issuer, err)
+			}
+			return nil, err
+		}
+		issuers = append(issuers, ti)
+	}
+
+	return &OAuth{
+		issuers:          issuers,
+		permissionPrefix: cfg.PermissionPrefix,
+		httpClient:       client,
+		cTokenCache:      newCTokenCache(rdb),
+		logger:           log,
+	}, nil
+}
+
+func discoveryMetadata(ctx context.Context, client *http.Client, cfg Config) (*authServerMetadata, error) {
+	data := new(authServerMetadata)
+
 	oauthMetadata := cfg.Issuer + ".well-known/oauth-authorization-server"
 	reqOM, err := http.NewRequestWithContext(ctx, http.MethodGet, oauthMetadata, nil)
 	if err != nil {
@@ -57,7 +108,7 @@ func New(ctx context.Context, rdb Redis, cfg Config, log logger.Logger) (*OAuth,
 	defer resOM.Body.Close()
 
 	if resOM.StatusCode < 400 {
-		if err := json.NewDecoder(resOM.Body).Decode(&data); err != nil {
+		if err := json.NewDecoder(resOM.Body).Decode(data); err != nil {
 			return nil, err
 		}
 	}
@@ -74,7 +125,7 @@ func New(ctx context.Context, rdb Redis, cfg Config, log logger.Logger) (*OAuth,
 		}
 		defer resOD.Body.Close()
 
-		if err := json.NewDecoder(resOD.Body).Decode(&data); err != nil {
+		if err := json.NewDecoder(resOD.Body).Decode(data); err != nil {
 			return nil, err
 		}
 	}
@@ -83,25 +134,7 @@ func New(ctx context.Context, rdb Redis, cfg Config, log logger.Logger) (*OAuth,
 		return nil, fmt.Errorf("issuer did not match, expected %q got %q", cfg.Issuer, data.Issuer)
 	}
 
-	jwkc := jwk.NewCache(ctx)
-	if err := jwkc.Register(data.JWKSURI, jwk.WithHTTPClient(client)); err != nil {
-		return nil, err
-	}
-
-	jwks, err := jwkc.Refresh(ctx, data.JWKSURI)
-	if err != nil {
-		return nil, err
-	}
-
-	return &OAuth{
-		issuer:           cfg.Issuer,
-		audience:         cfg.Audience,
-		jwks:             jwks,
-		permissionPrefix: cfg.PermissionPrefix,
-		httpClient:       client,
-		cTokenCache:      newCTokenCache(rdb),
-		logger:           log,
-	}, nil
+	return data, nil
 }
 
 func (oa OAuth) TokenPermissionKey(s ...string) string {
@@ -112,6 +145,21 @@ func (oa OAuth) TokenPermissionKey(s ...string) string {
 	return permission
 }
 
+// Health reports the last JWKS refresh failure of every issuer, static keys
+// and introspection are always healthy.
+func (oa OAuth) Health() error {
+	var errs []error
+	for _, ti := range oa.issuers {
+		if ti.jwksRemote == nil {
+			continue
+		}
+		if err := ti.jwksRemote.Health(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
 func (oa OAuth) IsTokenExpiredError(err error) bool {
 	return errors.Is(err, jwt.ErrTokenExpired())
 }
@@ -0,0 +1,64 @@
+package oauth
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lestrrat-go/jwx/v2/jwk"
+)
+
+// Static mode verifies tokens against keys from config instead of asking the
+// issuer, for environments without access to one.
+func staticKeySet(cfg Config) (jwk.Set, error) {
+	keys := jwk.NewSet()
+
+	if cfg.JWKSFile != "" {
+		set, err := jwk.ReadFile(cfg.JWKSFile)
+		if err != nil {
+			return nil, fmt.Errorf("read jwks file failed: %w", err)
+		}
+		if err := addPublicKeys(keys, set); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, name := range cfg.PublicKeyFiles {
+		set, err := jwk.ReadFile(name, jwk.WithPEM(true))
+		if err != nil {
+			return nil, fmt.Errorf("read public key file %s failed: %w", name, err)
+		}
+		if err := addPublicKeys(keys, set); err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.HMACSecret != "" {
+		key, err := jwk.FromRaw([]byte(cfg.HMACSecret))
+		if err != nil {
+			return nil, err
+		}
+		if err := keys.AddKey(key); err != nil {
+			return nil, err
+		}
+	}
+
+	if keys.Len() < 1 {
+		return nil, errors.New("oauth static mode has no keys configured")
+	}
+
+	return keys, nil
+}
+
+func addPublicKeys(dst, src jwk.Set) error {
+	public, err := jwk.PublicSetOf(src)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < public.Len(); i++ {
+		key, _ := public.Key(i)
+		if err := dst.AddKey(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
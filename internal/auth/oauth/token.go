@@ -7,18 +7,23 @@ import (
 	"time"
 
 	"github.com/lestrrat-go/jwx/v2/jwt"
-
-	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
 )
 
 type accessToken struct {
-	Subject    string
-	Expiration time.Time
-	Others     map[string]any
+	Issuer      string
+	Subject     string
+	Expiration  time.Time
+	Permissions []string
+	Others      map[string]any
+	// CacheTTL caps how long the token stays cached, zero leaves it to the
+	// expiration alone.
+	CacheTTL time.Duration
 }
 
 func (at accessToken) Claim(name string) (any, bool) {
 	switch name {
+	case jwt.IssuerKey:
+		return at.Issuer, true
 	case jwt.SubjectKey:
 		return at.Subject, true
 	case jwt.ExpirationKey:
@@ -29,6 +34,16 @@ func (at accessToken) Claim(name string) (any, bool) {
 	}
 }
 
+// cacheExpiry is half the remaining lifetime, capped by CacheTTL. A token
+// without expiration is only cached with a cap.
+func (at accessToken) cacheExpiry() time.Duration {
+	expiry := time.Until(at.Expiration) / 2
+	if at.CacheTTL > 0 && (at.Expiration.IsZero() || expiry > at.CacheTTL) {
+		expiry = at.CacheTTL
+	}
+	return expiry
+}
+
 func (at accessToken) HasScope(scope string) bool {
 	if s0, ok := at.Claim("scope"); ok {
 		s1, _ := s0.(string)
@@ -38,43 +53,34 @@ func (at accessToken) HasScope(scope string) bool {
 }
 
 func (at accessToken) HasPermission(permission string) bool {
-	if p0, ok := at.Claim("permissions"); ok {
-		switch p1 := p0.(type) {
-		case []any: // Auth0 RBAC
-			for _, p2 := range p1 {
-				if p3, _ := p2.(string); p3 == permission {
-					return true
-				}
+	return slices.Contains(at.Permissions, permission)
+}
+
+func claimStrings(v any) []string {
+	switch v := v.(type) {
+	case []any: // Auth0 RBAC
+		var result []string
+		for _, s := range v {
+			if s, ok := s.(string); ok {
+				result = append(result, s)
 			}
-		case string:
-			return slices.Contains(strings.Split(p1, " "), permission)
 		}
+		return result
+	case string:
+		return strings.Split(v, " ")
 	}
-	return false
+	return nil
 }
 
 func (oa OAuth) parseAccessToken(ctx context.Context, token string) (*accessToken, error) {
-	jwtParseOpts := []jwt.ParseOption{
-		jwt.WithContext(ctx),
-		jwt.WithKeySet(oa.jwks),
-		jwt.WithIssuer(oa.issuer),
-	}
-	if oa.audience != "" {
-		jwtParseOpts = append(jwtParseOpts, jwt.WithAudience(oa.audience))
+	ti, err := oa.trustedIssuer(token)
+	if err != nil {
+		return nil, err
 	}
-	result, err := jwt.ParseString(token, jwtParseOpts...)
+	result, err := ti.parseAccessToken(ctx, token)
 	if err != nil {
-		switch {
-		case oa.IsTokenExpiredError(err):
-			return nil, model.WrappedError(err, "expired access token")
-		case oa.IsTokenValidationError(err):
-			return nil, model.WrappedError(err, "invalid access token")
-		}
 		return nil, err
 	}
-	return &accessToken{
-		Subject:    result.Subject(),
-		Expiration: result.Expiration(),
-		Others:     result.PrivateClaims(),
-	}, nil
+	result.Permissions = ti.permissions(oa, *result)
+	return result, nil
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/mahmudindes/orenocomic-donoengine/internal/controller"
 	"github.com/mahmudindes/orenocomic-donoengine/internal/datastore"
 	"github.com/mahmudindes/orenocomic-donoengine/internal/server"
+	"github.com/mahmudindes/orenocomic-donoengine/internal/service"
 )
 
 type Config struct {
@@ -27,6 +28,7 @@ type Config struct {
 
 	General struct {
 		Controller controller.Config `conf:",squash"`
+		Service    service.Config    `conf:",squash"`
 	} `conf:"general"`
 }
 
@@ -1,6 +1,7 @@
 package chttp
 
 import (
+	"crypto/rand"
 	"fmt"
 	"net/http"
 
@@ -20,23 +21,43 @@ type (
 	}
 
 	Config struct {
-		CORSOrigins []string `conf:"cors_origins"`
+		CORSOrigins  []string          `conf:"cors_origins"`
+		CacheControl map[string]string `conf:"cache_control"`
+		TemplateDir  string            `conf:"template_dir"`
+		StaticDir    string            `conf:"static_dir"`
+		CursorSecret string            `conf:"cursor_secret"`
 	}
 
 	Service interface {
+		middleware.AuthAPIKey
 		rapi.Service
 	}
 
 	OAuth interface {
 		middleware.AuthOAuth
 		rapi.OAuth
+		Health() error
 	}
 )
 
 func New(svc Service, oa OAuth, cfg Config, log logger.Logger) (*HTTP, error) {
+	if cfg.TemplateDir == "" {
+		cfg.TemplateDir = "./web/template"
+	}
+	if cfg.StaticDir == "" {
+		cfg.StaticDir = "./web/static"
+	}
+	cursorSecret := []byte(cfg.CursorSecret)
+	if len(cursorSecret) < 1 {
+		cursorSecret = make([]byte, 32)
+		if _, err := rand.Read(cursorSecret); err != nil {
+			return nil, fmt.Errorf("generate cursor secret failed: %w", err)
+		}
+	}
+
 	mux0 := router.NewMux()
 
-	hpmd, err := hhypermedia.New("./web/template", log.WithName("Hypermedia"))
+	hpmd, err := hhypermedia.New(cfg.TemplateDir, log.WithName("Hypermedia"))
 	if err != nil {
 		return nil, fmt.Errorf("initialize hypermedia failed: %w", err)
 	}
@@ -71,6 +92,14 @@ func New(svc Service, oa OAuth, cfg Config, log logger.Logger) (*HTTP, error) {
 		mux.Pre(middleware.CORSProcess)
 
 		mux.MethodGet("/", hpmd.IndexHandler)
+		mux.MethodGet("/health", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Cache-Control", "no-store")
+			if err := oa.Health(); err != nil {
+				utilb.ResponseJSONErr(w, "Signing keys refresh failed.", http.StatusServiceUnavailable)
+				return
+			}
+			utilb.ResponseJSON(w, map[string]string{"status": "ok"}, http.StatusOK)
+		})
 	})
 
 	mux0.Group(func(mux router.Mux) {
@@ -85,7 +114,7 @@ func New(svc Service, oa OAuth, cfg Config, log logger.Logger) (*HTTP, error) {
 			"robots.txt",
 		}
 		stacMtd := []string{http.MethodGet, http.MethodHead}
-		stacDir := http.Dir("./web/static")
+		stacDir := http.Dir(cfg.StaticDir)
 		for _, val := range stacVal {
 			mux.MultiMethod(stacMtd, "/"+val, stac.File(stacDir, val))
 		}
@@ -104,13 +133,21 @@ func New(svc Service, oa OAuth, cfg Config, log logger.Logger) (*HTTP, error) {
 			opt.AllowedOrigin = cfg.CORSOrigins
 			opt.AllowedMethod = append(opt.AllowedMethod, http.MethodPatch, http.MethodPost)
 			opt.AllowedMethod = append(opt.AllowedMethod, http.MethodDelete)
-			opt.ExposedHeader = append(opt.ExposedHeader, "X-Total-Count", "X-Pagination-Limit")
+			opt.AllowedHeader = append(opt.AllowedHeader, "If-Match", "If-Unmodified-Since")
+			opt.AllowedHeader = append(opt.AllowedHeader, "If-None-Match", "If-Modified-Since")
+			opt.AllowedHeader = append(opt.AllowedHeader, "Prefer", "X-Api-Key")
+			opt.ExposedHeader = append(opt.ExposedHeader, "X-Total-Count", "X-Pagination-Limit", "X-Pagination-Next-Cursor", "Link")
+			opt.ExposedHeader = append(opt.ExposedHeader, "ETag", "Preference-Applied")
 			opt.AllowCredentials = true
 			opt.SkipOrigin = false
-		}), middleware.CORSProcess, middleware.Auth(oa))
-
-		iapi := rapi.NewAPI(svc, oa, log)
-		mapi := mux1.Underlying(rapi.Middleware(sapi, iapi.Authentication))
+		}), middleware.CORSProcess, middleware.Auth(oa, svc))
+
+		iapi := rapi.NewAPI(svc, oa, rapi.Config{
+			CacheControl: cfg.CacheControl,
+			BatchHandler: mux0,
+			CursorSecret: cursorSecret,
+		}, log)
+		mapi := mux1.Underlying(rapi.Middleware(sapi, iapi.Authentication), iapi.DryRun)
 		rapi.HandlerFromMuxWithBaseURL(iapi, mapi, "/v0")
 	})
 
@@ -6,13 +6,19 @@ import (
 	"strings"
 )
 
-type AuthOAuth interface {
-	ContextAccessToken(ctx context.Context, token string) context.Context
-}
+type (
+	AuthOAuth interface {
+		ContextAccessToken(ctx context.Context, token string) context.Context
+	}
+
+	AuthAPIKey interface {
+		ContextAPIKey(ctx context.Context, key string) context.Context
+	}
+)
 
-func Auth(oa AuthOAuth) func(http.Handler) http.Handler {
+func Auth(oa AuthOAuth, ak AuthAPIKey) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
-		return authBearer(oa)(next)
+		return authBearer(oa)(authAPIKey(ak)(next))
 	}
 }
 
@@ -30,3 +36,17 @@ func authBearer(oa AuthOAuth) func(http.Handler) http.Handler {
 		})
 	}
 }
+
+func authAPIKey(ak AuthAPIKey) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			if key := r.Header.Get("X-API-Key"); key != "" {
+				r = r.WithContext(ak.ContextAPIKey(ctx, key))
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/controller/chttp/utilb"
 )
 
 type (
@@ -53,6 +55,10 @@ func CORSProcess(next http.Handler) http.Handler {
 			return
 		}
 
+		if !opt.SkipOrigin {
+			utilb.HeaderAddVary(wHeader, "Origin")
+		}
+
 		origin := rHeader.Get("Origin")
 		if origin == "" {
 			next.ServeHTTP(w, r)
@@ -100,19 +106,16 @@ func CORSProcess(next http.Handler) http.Handler {
 		}
 
 		wHeader.Set("Access-Control-Allow-Origin", acao)
-		if acao != "*" {
-			wHeader.Add("Vary", "Origin")
-		}
 
 		if preflight {
 			if len(opt.AllowedMethod) > 0 {
 				wHeader.Set("Access-Control-Allow-Methods", strings.Join(opt.AllowedMethod, ", "))
-				wHeader.Add("Vary", "Access-Control-Request-Method")
+				utilb.HeaderAddVary(wHeader, "Access-Control-Request-Method")
 			}
 
 			if len(opt.AllowedHeader) > 0 {
 				wHeader.Set("Access-Control-Allow-Headers", strings.Join(opt.AllowedHeader, ", "))
-				wHeader.Add("Vary", "Access-Control-Request-Headers")
+				utilb.HeaderAddVary(wHeader, "Access-Control-Request-Headers")
 			}
 
 			if opt.MaxAge > 0 {
@@ -23,9 +23,68 @@ import (
 type contextKey string
 
 const (
+	ApiKeyScopes     contextKey = "ApiKey.Scopes"
 	BearerAuthScopes contextKey = "BearerAuth.Scopes"
 )
 
+// APIKey defines model for APIKey.
+type APIKey struct {
+	CreatedAt   time.Time  `json:"createdAt"`
+	ExpiresAt   *time.Time `json:"expiresAt"`
+	ID          uint       `json:"id"`
+	LastUsedAt  *time.Time `json:"lastUsedAt"`
+	Name        string     `json:"name"`
+	Permissions []string   `json:"permissions"`
+
+	// Prefix The first characters of the secret after the service prefix.
+	Prefix    string     `json:"prefix"`
+	UpdatedAt *time.Time `json:"updatedAt"`
+}
+
+// APIKeySecret defines model for APIKeySecret.
+type APIKeySecret struct {
+	APIKey APIKey `json:"apiKey"`
+
+	// Secret The secret to send in the X-API-Key header, it is not shown again.
+	Secret string `json:"secret"`
+}
+
+// Audit defines model for Audit.
+type Audit struct {
+	Action    string                 `json:"action"`
+	Actor     string                 `json:"actor"`
+	After     map[string]interface{} `json:"after"`
+	Before    map[string]interface{} `json:"before"`
+	CreatedAt time.Time              `json:"createdAt"`
+	Entity    string                 `json:"entity"`
+	ID        uint                   `json:"id"`
+	Key       string                 `json:"key"`
+}
+
+// Batch defines model for Batch.
+type Batch struct {
+	Committed bool          `json:"committed"`
+	Mode      string        `json:"mode"`
+	Results   []BatchResult `json:"results"`
+}
+
+// BatchOperation defines model for BatchOperation.
+type BatchOperation struct {
+	Body    interface{}       `json:"body,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Method  string            `json:"method"`
+
+	// Path Path relative to the API base, for example /comics.
+	Path string `json:"path"`
+}
+
+// BatchResult defines model for BatchResult.
+type BatchResult struct {
+	Body    interface{}       `json:"body,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Status  int               `json:"status"`
+}
+
 // Category defines model for Category.
 type Category struct {
 	Code      string              `json:"code"`
@@ -177,6 +236,15 @@ type GenericType struct {
 	UpdatedAt *time.Time `json:"updatedAt"`
 }
 
+// JSONSchema defines model for JSONSchema.
+type JSONSchema struct {
+	CreatedAt time.Time              `json:"createdAt"`
+	ID        uint                   `json:"id"`
+	Name      string                 `json:"name"`
+	Schema    map[string]interface{} `json:"schema"`
+	UpdatedAt *time.Time             `json:"updatedAt"`
+}
+
 // Language defines model for Language.
 type Language struct {
 	CreatedAt time.Time  `json:"createdAt"`
@@ -186,6 +254,20 @@ type Language struct {
 	UpdatedAt *time.Time `json:"updatedAt"`
 }
 
+// NewAPIKey defines model for NewAPIKey.
+type NewAPIKey struct {
+	ExpiresAt   *time.Time `json:"expiresAt,omitempty"`
+	Name        string     `json:"name"`
+	Permissions []string   `json:"permissions"`
+}
+
+// NewBatch defines model for NewBatch.
+type NewBatch struct {
+	// Mode Either all-or-nothing (default) or best-effort.
+	Mode       *string          `json:"mode"`
+	Operations []BatchOperation `json:"operations"`
+}
+
 // NewCategory defines model for NewCategory.
 type NewCategory struct {
 	Code     string  `form:"code" json:"code"`
@@ -202,15 +284,22 @@ type NewCategoryRelation struct {
 
 // NewComic defines model for NewComic.
 type NewComic struct {
-	Code          *string    `form:"code" json:"code"`
-	LanguageID    *uint      `form:"languageID" json:"languageID"`
-	LanguageIETF  *string    `form:"languageIETF" json:"languageIETF"`
-	NSFL          *int       `form:"nsfl" json:"nsfl"`
-	NSFW          *int       `form:"nsfw" json:"nsfw"`
-	PublishedFrom *time.Time `form:"publishedFrom" json:"publishedFrom"`
-	PublishedTo   *time.Time `form:"publishedTo" json:"publishedTo"`
-	TotalChapter  *int       `form:"totalChapter" json:"totalChapter"`
-	TotalVolume   *int       `form:"totalVolume" json:"totalVolume"`
+	Additionals   map[string]interface{} `form:"-" json:"additionals"`
+	Categories    []NewComicCategory     `form:"-" json:"categories,omitempty"`
+	Code          *string                `form:"code" json:"code"`
+	Covers        []NewComicCover        `form:"-" json:"covers,omitempty"`
+	Externals     []NewComicExternal     `form:"-" json:"externals,omitempty"`
+	LanguageID    *uint                  `form:"languageID" json:"languageID"`
+	LanguageIETF  *string                `form:"languageIETF" json:"languageIETF"`
+	NSFL          *int                   `form:"nsfl" json:"nsfl"`
+	NSFW          *int                   `form:"nsfw" json:"nsfw"`
+	PublishedFrom *time.Time             `form:"publishedFrom" json:"publishedFrom"`
+	PublishedTo   *time.Time             `form:"publishedTo" json:"publishedTo"`
+	Synopses      []NewComicSynopsis     `form:"-" json:"synopses,omitempty"`
+	Tags          []NewComicTag          `form:"-" json:"tags,omitempty"`
+	Titles        []NewComicTitle        `form:"-" json:"titles,omitempty"`
+	TotalChapter  *int                   `form:"totalChapter" json:"totalChapter"`
+	TotalVolume   *int                   `form:"totalVolume" json:"totalVolume"`
 }
 
 // NewComicCategory defines model for NewComicCategory.
@@ -332,16 +421,17 @@ type SetCategoryRelation struct {
 
 // SetComic defines model for SetComic.
 type SetComic struct {
-	Code          *string    `form:"code" json:"code"`
-	LanguageID    *uint      `form:"languageID" json:"languageID"`
-	LanguageIETF  *string    `form:"languageIETF" json:"languageIETF"`
-	NSFL          *int       `form:"nsfl" json:"nsfl"`
-	NSFW          *int       `form:"nsfw" json:"nsfw"`
-	PublishedFrom *time.Time `form:"publishedFrom" json:"publishedFrom"`
-	PublishedTo   *time.Time `form:"publishedTo" json:"publishedTo"`
-	SetNull       []string   `form:"setNull,omitempty" json:"setNull,omitempty"`
-	TotalChapter  *int       `form:"totalChapter" json:"totalChapter"`
-	TotalVolume   *int       `form:"totalVolume" json:"totalVolume"`
+	Additionals   map[string]interface{} `form:"-" json:"additionals"`
+	Code          *string                `form:"code" json:"code"`
+	LanguageID    *uint                  `form:"languageID" json:"languageID"`
+	LanguageIETF  *string                `form:"languageIETF" json:"languageIETF"`
+	NSFL          *int                   `form:"nsfl" json:"nsfl"`
+	NSFW          *int                   `form:"nsfw" json:"nsfw"`
+	PublishedFrom *time.Time             `form:"publishedFrom" json:"publishedFrom"`
+	PublishedTo   *time.Time             `form:"publishedTo" json:"publishedTo"`
+	SetNull       []string               `form:"setNull,omitempty" json:"setNull,omitempty"`
+	TotalChapter  *int                   `form:"totalChapter" json:"totalChapter"`
+	TotalVolume   *int                   `form:"totalVolume" json:"totalVolume"`
 }
 
 // SetComicCategory defines model for SetComicCategory.
@@ -425,6 +515,11 @@ type SetGenericType struct {
 	Name *string `form:"name" json:"name"`
 }
 
+// SetJSONSchema defines model for SetJSONSchema.
+type SetJSONSchema struct {
+	Schema map[string]interface{} `json:"schema"`
+}
+
 // SetLanguage defines model for SetLanguage.
 type SetLanguage struct {
 	IETF *string `form:"ietf" json:"ietf"`
@@ -456,6 +551,17 @@ type Tag struct {
 	UpdatedAt *time.Time `json:"updatedAt"`
 }
 
+// Trash defines model for Trash.
+type Trash struct {
+	Code      string     `json:"code"`
+	CreatedAt time.Time  `json:"createdAt"`
+	DeletedAt time.Time  `json:"deletedAt"`
+	ID        uint       `json:"id"`
+	Type      string     `json:"type"`
+	TypeID    *uint      `json:"typeID"`
+	UpdatedAt *time.Time `json:"updatedAt"`
+}
+
 // Website defines model for Website.
 type Website struct {
 	CreatedAt time.Time  `json:"createdAt"`
@@ -468,6 +574,42 @@ type Website struct {
 // Default defines model for Default.
 type Default = Error
 
+// ListAPIKeyParams defines parameters for ListAPIKey.
+type ListAPIKeyParams struct {
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListAuditParams defines parameters for ListAudit.
+type ListAuditParams struct {
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Actor Filter by actor, the token issuer and subject joined by "#" or api_key:<name> for an API key.
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// Action Filter by action (add, update, delete or restore).
+	Action *string `form:"action,omitempty" json:"action,omitempty"`
+
+	// Entity Filter by entity.
+	Entity *string `form:"entity,omitempty" json:"entity,omitempty"`
+
+	// Key Filter by entity key.
+	Key *string `form:"key,omitempty" json:"key,omitempty"`
+
+	// Since Filter by created at or after.
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Filter by created at or before.
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+}
+
 // ListCategoryParams defines parameters for ListCategory.
 type ListCategoryParams struct {
 	// Page Page number of results.
@@ -478,6 +620,9 @@ type ListCategoryParams struct {
 
 	// OrderBy Sort results returned.
 	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
+
+	// Cursor Pagination cursor from a previous result, used instead of page.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
 // ListComicParams defines parameters for ListComic.
@@ -491,8 +636,62 @@ type ListComicParams struct {
 	// OrderBy Sort results returned.
 	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
 
+	// Cursor Pagination cursor from a previous result, used instead of page.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
 	// ComicExternal Filter by comic external values.
 	ComicExternal *[]string `form:"comic_external,omitempty" json:"comic_external,omitempty"`
+
+	// Q Search comic by any of its titles, synonym and romanized ones included. Results are ranked by relevance and cannot be paginated by cursor.
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// Tag Filter by comic tag values (typeID-code).
+	Tag *[]string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// TagExclude Exclude by comic tag values (typeID-code).
+	TagExclude *[]string `form:"tag_exclude,omitempty" json:"tag_exclude,omitempty"`
+
+	// TagMatch Comic tag filter match mode, any or all.
+	TagMatch *string `form:"tag_match,omitempty" json:"tag_match,omitempty"`
+
+	// Category Filter by comic category values (typeID-code).
+	Category *[]string `form:"category,omitempty" json:"category,omitempty"`
+
+	// CategoryExclude Exclude by comic category values (typeID-code).
+	CategoryExclude *[]string `form:"category_exclude,omitempty" json:"category_exclude,omitempty"`
+
+	// CategoryMatch Comic category filter match mode, any or all.
+	CategoryMatch *string `form:"category_match,omitempty" json:"category_match,omitempty"`
+
+	// Language Filter by comic language IETF values.
+	Language *[]string `form:"language,omitempty" json:"language,omitempty"`
+
+	// NSFW Filter by comic NSFW value.
+	NSFW *int `form:"nsfw,omitempty" json:"nsfw,omitempty"`
+
+	// NSFL Filter by comic NSFL value.
+	NSFL *int `form:"nsfl,omitempty" json:"nsfl,omitempty"`
+
+	// PublishedFrom Filter by comic publication overlapping the range that starts at the time, a comic without published to is still being published.
+	PublishedFrom *time.Time `form:"published_from,omitempty" json:"published_from,omitempty"`
+
+	// PublishedTo Filter by comic publication overlapping the range that ends at the time, that is published from on or before it.
+	PublishedTo *time.Time `form:"published_to,omitempty" json:"published_to,omitempty"`
+
+	// Additionals Filter by comic additionals values (path=value), path segments separated by dot.
+	Additionals *[]string `form:"additionals,omitempty" json:"additionals,omitempty"`
+}
+
+// ListComicCategoryParams defines parameters for ListComicCategory.
+type ListComicCategoryParams struct {
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// OrderBy Sort results returned.
+	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
 }
 
 // ListComicChapterParams defines parameters for ListComicChapter.
@@ -505,6 +704,81 @@ type ListComicChapterParams struct {
 
 	// OrderBy Sort results returned.
 	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
+
+	// Cursor Pagination cursor from a previous result, used instead of page.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListComicCoverParams defines parameters for ListComicCover.
+type ListComicCoverParams struct {
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// OrderBy Sort results returned.
+	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
+}
+
+// ListComicExternalParams defines parameters for ListComicExternal.
+type ListComicExternalParams struct {
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// OrderBy Sort results returned.
+	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
+}
+
+// ListComicRelationParams defines parameters for ListComicRelation.
+type ListComicRelationParams struct {
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// OrderBy Sort results returned.
+	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
+}
+
+// ListComicSynopsisParams defines parameters for ListComicSynopsis.
+type ListComicSynopsisParams struct {
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// OrderBy Sort results returned.
+	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
+}
+
+// ListComicTagParams defines parameters for ListComicTag.
+type ListComicTagParams struct {
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// OrderBy Sort results returned.
+	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
+}
+
+// ListComicTitleParams defines parameters for ListComicTitle.
+type ListComicTitleParams struct {
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// OrderBy Sort results returned.
+	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
 }
 
 // ListLanguageParams defines parameters for ListLanguage.
@@ -517,6 +791,9 @@ type ListLanguageParams struct {
 
 	// OrderBy Sort results returned.
 	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
+
+	// Cursor Pagination cursor from a previous result, used instead of page.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
 // ListTagParams defines parameters for ListTag.
@@ -529,6 +806,21 @@ type ListTagParams struct {
 
 	// OrderBy Sort results returned.
 	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
+
+	// Cursor Pagination cursor from a previous result, used instead of page.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListTrashParams defines parameters for ListTrash.
+type ListTrashParams struct {
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Type Filter by trash type (comic, category or tag).
+	Type *string `form:"type,omitempty" json:"type,omitempty"`
 }
 
 // ListCategoryTypeParams defines parameters for ListCategoryType.
@@ -577,8 +869,17 @@ type ListWebsiteParams struct {
 
 	// OrderBy Sort results returned.
 	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
+
+	// Cursor Pagination cursor from a previous result, used instead of page.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
+// AddAPIKeyJSONRequestBody defines body for AddAPIKey for application/json ContentType.
+type AddAPIKeyJSONRequestBody = NewAPIKey
+
+// BatchJSONRequestBody defines body for Batch for application/json ContentType.
+type BatchJSONRequestBody = NewBatch
+
 // AddCategoryJSONRequestBody defines body for AddCategory for application/json ContentType.
 type AddCategoryJSONRequestBody = NewCategory
 
@@ -711,6 +1012,9 @@ type UpdateComicTitleJSONRequestBody = SetComicTitle
 // UpdateComicTitleFormdataRequestBody defines body for UpdateComicTitle for application/x-www-form-urlencoded ContentType.
 type UpdateComicTitleFormdataRequestBody = SetComicTitle
 
+// SetJSONSchemaJSONRequestBody defines body for SetJSONSchema for application/json ContentType.
+type SetJSONSchemaJSONRequestBody = SetJSONSchema
+
 // AddLanguageJSONRequestBody defines body for AddLanguage for application/json ContentType.
 type AddLanguageJSONRequestBody = NewLanguage
 
@@ -785,6 +1089,21 @@ type UpdateWebsiteFormdataRequestBody = SetWebsite
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List API key.
+	// (GET /api-keys)
+	ListAPIKey(w http.ResponseWriter, r *http.Request, params ListAPIKeyParams)
+	// Add API key.
+	// (POST /api-keys)
+	AddAPIKey(w http.ResponseWriter, r *http.Request)
+	// Revoke API key.
+	// (DELETE /api-keys/{id})
+	DeleteAPIKey(w http.ResponseWriter, r *http.Request, id uint)
+	// List audit.
+	// (GET /audit)
+	ListAudit(w http.ResponseWriter, r *http.Request, params ListAuditParams)
+	// Execute batch operations.
+	// (POST /batch)
+	Batch(w http.ResponseWriter, r *http.Request)
 	// List category.
 	// (GET /categories)
 	ListCategory(w http.ResponseWriter, r *http.Request, params ListCategoryParams)
@@ -812,6 +1131,9 @@ type ServerInterface interface {
 	// Update category relation.
 	// (PATCH /categories/{typeID}-{code}/relations/{categoryCode})
 	UpdateCategoryRelation(w http.ResponseWriter, r *http.Request, typeID uint, code string, categoryCode string)
+	// Restore category.
+	// (POST /categories/{typeID}-{code}/restore)
+	RestoreCategory(w http.ResponseWriter, r *http.Request, typeID uint, code string)
 	// List comic.
 	// (GET /comics)
 	ListComic(w http.ResponseWriter, r *http.Request, params ListComicParams)
@@ -827,6 +1149,9 @@ type ServerInterface interface {
 	// Update comic.
 	// (PATCH /comics/{code})
 	UpdateComic(w http.ResponseWriter, r *http.Request, code string)
+	// List comic category.
+	// (GET /comics/{code}/categories)
+	ListComicCategory(w http.ResponseWriter, r *http.Request, code string, params ListComicCategoryParams)
 	// Add comic category.
 	// (POST /comics/{code}/categories)
 	AddComicCategory(w http.ResponseWriter, r *http.Request, code string)
@@ -854,6 +1179,9 @@ type ServerInterface interface {
 	// Update comic chapter.
 	// (PATCH /comics/{code}/chapters/{cv})
 	UpdateComicChapter(w http.ResponseWriter, r *http.Request, code string, cv string)
+	// List comic cover.
+	// (GET /comics/{code}/covers)
+	ListComicCover(w http.ResponseWriter, r *http.Request, code string, params ListComicCoverParams)
 	// Add comic cover.
 	// (POST /comics/{code}/covers)
 	AddComicCover(w http.ResponseWriter, r *http.Request, code string)
@@ -866,6 +1194,9 @@ type ServerInterface interface {
 	// Update comic cover.
 	// (PATCH /comics/{code}/covers/{rid})
 	UpdateComicCover(w http.ResponseWriter, r *http.Request, code string, rid string)
+	// List comic external.
+	// (GET /comics/{code}/externals)
+	ListComicExternal(w http.ResponseWriter, r *http.Request, code string, params ListComicExternalParams)
 	// Add comic external.
 	// (POST /comics/{code}/externals)
 	AddComicExternal(w http.ResponseWriter, r *http.Request, code string)
@@ -878,6 +1209,9 @@ type ServerInterface interface {
 	// Update comic external.
 	// (PATCH /comics/{code}/externals/{rid})
 	UpdateComicExternal(w http.ResponseWriter, r *http.Request, code string, rid string)
+	// List comic relation.
+	// (GET /comics/{code}/relations)
+	ListComicRelation(w http.ResponseWriter, r *http.Request, code string, params ListComicRelationParams)
 	// Add comic relation.
 	// (POST /comics/{code}/relations)
 	AddComicRelation(w http.ResponseWriter, r *http.Request, code string)
@@ -890,6 +1224,12 @@ type ServerInterface interface {
 	// Update comic relation.
 	// (PATCH /comics/{code}/relations/{typeID}-{comicCode})
 	UpdateComicRelation(w http.ResponseWriter, r *http.Request, code string, typeID uint, comicCode string)
+	// Restore comic.
+	// (POST /comics/{code}/restore)
+	RestoreComic(w http.ResponseWriter, r *http.Request, code string)
+	// List comic synopsis.
+	// (GET /comics/{code}/synopses)
+	ListComicSynopsis(w http.ResponseWriter, r *http.Request, code string, params ListComicSynopsisParams)
 	// Add comic synopsis.
 	// (POST /comics/{code}/synopses)
 	AddComicSynopsis(w http.ResponseWriter, r *http.Request, code string)
@@ -902,6 +1242,9 @@ type ServerInterface interface {
 	// Update comic synopsis.
 	// (PATCH /comics/{code}/synopses/{rid})
 	UpdateComicSynopsis(w http.ResponseWriter, r *http.Request, code string, rid string)
+	// List comic tag.
+	// (GET /comics/{code}/tags)
+	ListComicTag(w http.ResponseWriter, r *http.Request, code string, params ListComicTagParams)
 	// Add comic tag.
 	// (POST /comics/{code}/tags)
 	AddComicTag(w http.ResponseWriter, r *http.Request, code string)
@@ -914,6 +1257,9 @@ type ServerInterface interface {
 	// Update comic tag.
 	// (PATCH /comics/{code}/tags/{typeID}-{tagCode})
 	UpdateComicTag(w http.ResponseWriter, r *http.Request, code string, typeID uint, tagCode string)
+	// List comic title.
+	// (GET /comics/{code}/titles)
+	ListComicTitle(w http.ResponseWriter, r *http.Request, code string, params ListComicTitleParams)
 	// Add comic title.
 	// (POST /comics/{code}/titles)
 	AddComicTitle(w http.ResponseWriter, r *http.Request, code string)
@@ -926,6 +1272,15 @@ type ServerInterface interface {
 	// Update comic title.
 	// (PATCH /comics/{code}/titles/{rid})
 	UpdateComicTitle(w http.ResponseWriter, r *http.Request, code string, rid string)
+	// Delete JSON schema.
+	// (DELETE /json-schemas/{name})
+	DeleteJSONSchema(w http.ResponseWriter, r *http.Request, name string)
+	// Get JSON schema.
+	// (GET /json-schemas/{name})
+	GetJSONSchema(w http.ResponseWriter, r *http.Request, name string)
+	// Set JSON schema.
+	// (PUT /json-schemas/{name})
+	SetJSONSchema(w http.ResponseWriter, r *http.Request, name string)
 	// List language.
 	// (GET /languages)
 	ListLanguage(w http.ResponseWriter, r *http.Request, params ListLanguageParams)
@@ -956,6 +1311,12 @@ type ServerInterface interface {
 	// Update tag.
 	// (PATCH /tags/{typeID}-{code})
 	UpdateTag(w http.ResponseWriter, r *http.Request, typeID uint, code string)
+	// Restore tag.
+	// (POST /tags/{typeID}-{code}/restore)
+	RestoreTag(w http.ResponseWriter, r *http.Request, typeID uint, code string)
+	// List trash.
+	// (GET /trash)
+	ListTrash(w http.ResponseWriter, r *http.Request, params ListTrashParams)
 	// List category type.
 	// (GET /types/categories)
 	ListCategoryType(w http.ResponseWriter, r *http.Request, params ListCategoryTypeParams)
@@ -1022,6 +1383,36 @@ type ServerInterface interface {
 
 type Unimplemented struct{}
 
+// List API key.
+// (GET /api-keys)
+func (_ Unimplemented) ListAPIKey(w http.ResponseWriter, r *http.Request, params ListAPIKeyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add API key.
+// (POST /api-keys)
+func (_ Unimplemented) AddAPIKey(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke API key.
+// (DELETE /api-keys/{id})
+func (_ Unimplemented) DeleteAPIKey(w http.ResponseWriter, r *http.Request, id uint) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List audit.
+// (GET /audit)
+func (_ Unimplemented) ListAudit(w http.ResponseWriter, r *http.Request, params ListAuditParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Execute batch operations.
+// (POST /batch)
+func (_ Unimplemented) Batch(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List category.
 // (GET /categories)
 func (_ Unimplemented) ListCategory(w http.ResponseWriter, r *http.Request, params ListCategoryParams) {
@@ -1076,6 +1467,12 @@ func (_ Unimplemented) UpdateCategoryRelation(w http.ResponseWriter, r *http.Req
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Restore category.
+// (POST /categories/{typeID}-{code}/restore)
+func (_ Unimplemented) RestoreCategory(w http.ResponseWriter, r *http.Request, typeID uint, code string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List comic.
 // (GET /comics)
 func (_ Unimplemented) ListComic(w http.ResponseWriter, r *http.Request, params ListComicParams) {
@@ -1106,6 +1503,12 @@ func (_ Unimplemented) UpdateComic(w http.ResponseWriter, r *http.Request, code
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List comic category.
+// (GET /comics/{code}/categories)
+func (_ Unimplemented) ListComicCategory(w http.ResponseWriter, r *http.Request, code string, params ListComicCategoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Add comic category.
 // (POST /comics/{code}/categories)
 func (_ Unimplemented) AddComicCategory(w http.ResponseWriter, r *http.Request, code string) {
@@ -1160,6 +1563,12 @@ func (_ Unimplemented) UpdateComicChapter(w http.ResponseWriter, r *http.Request
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List comic cover.
+// (GET /comics/{code}/covers)
+func (_ Unimplemented) ListComicCover(w http.ResponseWriter, r *http.Request, code string, params ListComicCoverParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Add comic cover.
 // (POST /comics/{code}/covers)
 func (_ Unimplemented) AddComicCover(w http.ResponseWriter, r *http.Request, code string) {
@@ -1184,6 +1593,12 @@ func (_ Unimplemented) UpdateComicCover(w http.ResponseWriter, r *http.Request,
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List comic external.
+// (GET /comics/{code}/externals)
+func (_ Unimplemented) ListComicExternal(w http.ResponseWriter, r *http.Request, code string, params ListComicExternalParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Add comic external.
 // (POST /comics/{code}/externals)
 func (_ Unimplemented) AddComicExternal(w http.ResponseWriter, r *http.Request, code string) {
@@ -1208,6 +1623,12 @@ func (_ Unimplemented) UpdateComicExternal(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List comic relation.
+// (GET /comics/{code}/relations)
+func (_ Unimplemented) ListComicRelation(w http.ResponseWriter, r *http.Request, code string, params ListComicRelationParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Add comic relation.
 // (POST /comics/{code}/relations)
 func (_ Unimplemented) AddComicRelation(w http.ResponseWriter, r *http.Request, code string) {
@@ -1232,6 +1653,18 @@ func (_ Unimplemented) UpdateComicRelation(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Restore comic.
+// (POST /comics/{code}/restore)
+func (_ Unimplemented) RestoreComic(w http.ResponseWriter, r *http.Request, code string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List comic synopsis.
+// (GET /comics/{code}/synopses)
+func (_ Unimplemented) ListComicSynopsis(w http.ResponseWriter, r *http.Request, code string, params ListComicSynopsisParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Add comic synopsis.
 // (POST /comics/{code}/synopses)
 func (_ Unimplemented) AddComicSynopsis(w http.ResponseWriter, r *http.Request, code string) {
@@ -1256,6 +1689,12 @@ func (_ Unimplemented) UpdateComicSynopsis(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List comic tag.
+// (GET /comics/{code}/tags)
+func (_ Unimplemented) ListComicTag(w http.ResponseWriter, r *http.Request, code string, params ListComicTagParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Add comic tag.
 // (POST /comics/{code}/tags)
 func (_ Unimplemented) AddComicTag(w http.ResponseWriter, r *http.Request, code string) {
@@ -1280,6 +1719,12 @@ func (_ Unimplemented) UpdateComicTag(w http.ResponseWriter, r *http.Request, co
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List comic title.
+// (GET /comics/{code}/titles)
+func (_ Unimplemented) ListComicTitle(w http.ResponseWriter, r *http.Request, code string, params ListComicTitleParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Add comic title.
 // (POST /comics/{code}/titles)
 func (_ Unimplemented) AddComicTitle(w http.ResponseWriter, r *http.Request, code string) {
@@ -1304,6 +1749,24 @@ func (_ Unimplemented) UpdateComicTitle(w http.ResponseWriter, r *http.Request,
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Delete JSON schema.
+// (DELETE /json-schemas/{name})
+func (_ Unimplemented) DeleteJSONSchema(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get JSON schema.
+// (GET /json-schemas/{name})
+func (_ Unimplemented) GetJSONSchema(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set JSON schema.
+// (PUT /json-schemas/{name})
+func (_ Unimplemented) SetJSONSchema(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List language.
 // (GET /languages)
 func (_ Unimplemented) ListLanguage(w http.ResponseWriter, r *http.Request, params ListLanguageParams) {
@@ -1364,6 +1827,18 @@ func (_ Unimplemented) UpdateTag(w http.ResponseWriter, r *http.Request, typeID
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Restore tag.
+// (POST /tags/{typeID}-{code}/restore)
+func (_ Unimplemented) RestoreTag(w http.ResponseWriter, r *http.Request, typeID uint, code string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List trash.
+// (GET /trash)
+func (_ Unimplemented) ListTrash(w http.ResponseWriter, r *http.Request, params ListTrashParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List category type.
 // (GET /types/categories)
 func (_ Unimplemented) ListCategoryType(w http.ResponseWriter, r *http.Request, params ListCategoryTypeParams) {
@@ -1493,14 +1968,18 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
-// ListCategory operation middleware
-func (siw *ServerInterfaceWrapper) ListCategory(w http.ResponseWriter, r *http.Request) {
+// ListAPIKey operation middleware
+func (siw *ServerInterfaceWrapper) ListAPIKey(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListCategoryParams
+	var params ListAPIKeyParams
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -1518,16 +1997,8 @@ func (siw *ServerInterfaceWrapper) ListCategory(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	// ------------- Optional query parameter "order_by" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListCategory(w, r, params)
+		siw.Handler.ListAPIKey(w, r, params)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1537,14 +2008,16 @@ func (siw *ServerInterfaceWrapper) ListCategory(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// AddCategory operation middleware
-func (siw *ServerInterfaceWrapper) AddCategory(w http.ResponseWriter, r *http.Request) {
+// AddAPIKey operation middleware
+func (siw *ServerInterfaceWrapper) AddAPIKey(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddCategory(w, r)
+		siw.Handler.AddAPIKey(w, r)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1554,34 +2027,27 @@ func (siw *ServerInterfaceWrapper) AddCategory(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteCategory operation middleware
-func (siw *ServerInterfaceWrapper) DeleteCategory(w http.ResponseWriter, r *http.Request) {
+// DeleteAPIKey operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAPIKey(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "typeID" -------------
-	var typeID uint
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "typeID", runtime.ParamLocationPath, chi.URLParam(r, "typeID"), &typeID)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "typeID", Err: err})
-		return
-	}
-
-	// ------------- Path parameter "code" -------------
-	var code string
+	// ------------- Path parameter "id" -------------
+	var id uint
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteCategory(w, r, typeID, code)
+		siw.Handler.DeleteAPIKey(w, r, id)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1591,18 +2057,235 @@ func (siw *ServerInterfaceWrapper) DeleteCategory(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetCategory operation middleware
-func (siw *ServerInterfaceWrapper) GetCategory(w http.ResponseWriter, r *http.Request) {
+// ListAudit operation middleware
+func (siw *ServerInterfaceWrapper) ListAudit(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "typeID" -------------
-	var typeID uint
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "typeID", runtime.ParamLocationPath, chi.URLParam(r, "typeID"), &typeID)
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAuditParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "typeID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", r.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", r.URL.Query(), &params.Action)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "action", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "entity" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entity", r.URL.Query(), &params.Entity)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "key" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "key", r.URL.Query(), &params.Key)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAudit(w, r, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// Batch operation middleware
+func (siw *ServerInterfaceWrapper) Batch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Batch(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListCategory operation middleware
+func (siw *ServerInterfaceWrapper) ListCategory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCategoryParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCategory(w, r, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddCategory operation middleware
+func (siw *ServerInterfaceWrapper) AddCategory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddCategory(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteCategory operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCategory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "typeID" -------------
+	var typeID uint
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "typeID", runtime.ParamLocationPath, chi.URLParam(r, "typeID"), &typeID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "typeID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCategory(w, r, typeID, code)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCategory operation middleware
+func (siw *ServerInterfaceWrapper) GetCategory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "typeID" -------------
+	var typeID uint
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "typeID", runtime.ParamLocationPath, chi.URLParam(r, "typeID"), &typeID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "typeID", Err: err})
 		return
 	}
 
@@ -1652,6 +2335,8 @@ func (siw *ServerInterfaceWrapper) UpdateCategory(w http.ResponseWriter, r *http
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.UpdateCategory(w, r, typeID, code)
 	}))
@@ -1689,6 +2374,8 @@ func (siw *ServerInterfaceWrapper) AddCategoryRelation(w http.ResponseWriter, r
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.AddCategoryRelation(w, r, typeID, code)
 	}))
@@ -1735,6 +2422,8 @@ func (siw *ServerInterfaceWrapper) DeleteCategoryRelation(w http.ResponseWriter,
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.DeleteCategoryRelation(w, r, typeID, code, categoryCode)
 	}))
@@ -1825,6 +2514,8 @@ func (siw *ServerInterfaceWrapper) UpdateCategoryRelation(w http.ResponseWriter,
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.UpdateCategoryRelation(w, r, typeID, code, categoryCode)
 	}))
@@ -1836,6 +2527,45 @@ func (siw *ServerInterfaceWrapper) UpdateCategoryRelation(w http.ResponseWriter,
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// RestoreCategory operation middleware
+func (siw *ServerInterfaceWrapper) RestoreCategory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "typeID" -------------
+	var typeID uint
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "typeID", runtime.ParamLocationPath, chi.URLParam(r, "typeID"), &typeID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "typeID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreCategory(w, r, typeID, code)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // ListComic operation middleware
 func (siw *ServerInterfaceWrapper) ListComic(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -1869,6 +2599,14 @@ func (siw *ServerInterfaceWrapper) ListComic(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
 	// ------------- Optional query parameter "comic_external" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "comic_external", r.URL.Query(), &params.ComicExternal)
@@ -1877,6 +2615,110 @@ func (siw *ServerInterfaceWrapper) ListComic(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tag_exclude" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag_exclude", r.URL.Query(), &params.TagExclude)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag_exclude", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tag_match" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag_match", r.URL.Query(), &params.TagMatch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag_match", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "category" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category", r.URL.Query(), &params.Category)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "category", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "category_exclude" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category_exclude", r.URL.Query(), &params.CategoryExclude)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "category_exclude", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "category_match" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category_match", r.URL.Query(), &params.CategoryMatch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "category_match", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "language" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "language", r.URL.Query(), &params.Language)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "language", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "nsfw" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "nsfw", r.URL.Query(), &params.NSFW)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nsfw", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "nsfl" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "nsfl", r.URL.Query(), &params.NSFL)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nsfl", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "published_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "published_from", r.URL.Query(), &params.PublishedFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "published_from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "published_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "published_to", r.URL.Query(), &params.PublishedTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "published_to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "additionals" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "additionals", r.URL.Query(), &params.Additionals)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "additionals", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ListComic(w, r, params)
 	}))
@@ -1894,6 +2736,8 @@ func (siw *ServerInterfaceWrapper) AddComic(w http.ResponseWriter, r *http.Reque
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.AddComic(w, r)
 	}))
@@ -1922,6 +2766,8 @@ func (siw *ServerInterfaceWrapper) DeleteComic(w http.ResponseWriter, r *http.Re
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.DeleteComic(w, r, code)
 	}))
@@ -1976,6 +2822,8 @@ func (siw *ServerInterfaceWrapper) UpdateComic(w http.ResponseWriter, r *http.Re
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.UpdateComic(w, r, code)
 	}))
@@ -1987,6 +2835,59 @@ func (siw *ServerInterfaceWrapper) UpdateComic(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// ListComicCategory operation middleware
+func (siw *ServerInterfaceWrapper) ListComicCategory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListComicCategoryParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListComicCategory(w, r, code, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // AddComicCategory operation middleware
 func (siw *ServerInterfaceWrapper) AddComicCategory(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -2004,6 +2905,8 @@ func (siw *ServerInterfaceWrapper) AddComicCategory(w http.ResponseWriter, r *ht
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.AddComicCategory(w, r, code)
 	}))
@@ -2050,6 +2953,8 @@ func (siw *ServerInterfaceWrapper) DeleteComicCategory(w http.ResponseWriter, r
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.DeleteComicCategory(w, r, code, typeID, categoryCode)
 	}))
@@ -2140,6 +3045,8 @@ func (siw *ServerInterfaceWrapper) UpdateComicCategory(w http.ResponseWriter, r
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.UpdateComicCategory(w, r, code, typeID, categoryCode)
 	}))
@@ -2193,6 +3100,14 @@ func (siw *ServerInterfaceWrapper) ListComicChapter(w http.ResponseWriter, r *ht
 		return
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ListComicChapter(w, r, code, params)
 	}))
@@ -2221,6 +3136,8 @@ func (siw *ServerInterfaceWrapper) AddComicChapter(w http.ResponseWriter, r *htt
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.AddComicChapter(w, r, code)
 	}))
@@ -2258,6 +3175,8 @@ func (siw *ServerInterfaceWrapper) DeleteComicChapter(w http.ResponseWriter, r *
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.DeleteComicChapter(w, r, code, cv)
 	}))
@@ -2330,6 +3249,8 @@ func (siw *ServerInterfaceWrapper) UpdateComicChapter(w http.ResponseWriter, r *
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.UpdateComicChapter(w, r, code, cv)
 	}))
@@ -2341,6 +3262,59 @@ func (siw *ServerInterfaceWrapper) UpdateComicChapter(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// ListComicCover operation middleware
+func (siw *ServerInterfaceWrapper) ListComicCover(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListComicCoverParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListComicCover(w, r, code, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // AddComicCover operation middleware
 func (siw *ServerInterfaceWrapper) AddComicCover(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -2358,6 +3332,8 @@ func (siw *ServerInterfaceWrapper) AddComicCover(w http.ResponseWriter, r *http.
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.AddComicCover(w, r, code)
 	}))
@@ -2395,6 +3371,8 @@ func (siw *ServerInterfaceWrapper) DeleteComicCover(w http.ResponseWriter, r *ht
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.DeleteComicCover(w, r, code, rid)
 	}))
@@ -2456,19 +3434,74 @@ func (siw *ServerInterfaceWrapper) UpdateComicCover(w http.ResponseWriter, r *ht
 		return
 	}
 
-	// ------------- Path parameter "rid" -------------
-	var rid string
+	// ------------- Path parameter "rid" -------------
+	var rid string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "rid", runtime.ParamLocationPath, chi.URLParam(r, "rid"), &rid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateComicCover(w, r, code, rid)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListComicExternal operation middleware
+func (siw *ServerInterfaceWrapper) ListComicExternal(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListComicExternalParams
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "rid", runtime.ParamLocationPath, chi.URLParam(r, "rid"), &rid)
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rid", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateComicCover(w, r, code, rid)
+		siw.Handler.ListComicExternal(w, r, code, params)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -2495,6 +3528,8 @@ func (siw *ServerInterfaceWrapper) AddComicExternal(w http.ResponseWriter, r *ht
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.AddComicExternal(w, r, code)
 	}))
@@ -2532,6 +3567,8 @@ func (siw *ServerInterfaceWrapper) DeleteComicExternal(w http.ResponseWriter, r
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.DeleteComicExternal(w, r, code, rid)
 	}))
@@ -2604,6 +3641,8 @@ func (siw *ServerInterfaceWrapper) UpdateComicExternal(w http.ResponseWriter, r
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.UpdateComicExternal(w, r, code, rid)
 	}))
@@ -2615,6 +3654,59 @@ func (siw *ServerInterfaceWrapper) UpdateComicExternal(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// ListComicRelation operation middleware
+func (siw *ServerInterfaceWrapper) ListComicRelation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListComicRelationParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListComicRelation(w, r, code, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // AddComicRelation operation middleware
 func (siw *ServerInterfaceWrapper) AddComicRelation(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -2632,6 +3724,8 @@ func (siw *ServerInterfaceWrapper) AddComicRelation(w http.ResponseWriter, r *ht
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.AddComicRelation(w, r, code)
 	}))
@@ -2678,6 +3772,8 @@ func (siw *ServerInterfaceWrapper) DeleteComicRelation(w http.ResponseWriter, r
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.DeleteComicRelation(w, r, code, typeID, comicCode)
 	}))
@@ -2768,6 +3864,8 @@ func (siw *ServerInterfaceWrapper) UpdateComicRelation(w http.ResponseWriter, r
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.UpdateComicRelation(w, r, code, typeID, comicCode)
 	}))
@@ -2779,6 +3877,89 @@ func (siw *ServerInterfaceWrapper) UpdateComicRelation(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// RestoreComic operation middleware
+func (siw *ServerInterfaceWrapper) RestoreComic(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreComic(w, r, code)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListComicSynopsis operation middleware
+func (siw *ServerInterfaceWrapper) ListComicSynopsis(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListComicSynopsisParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListComicSynopsis(w, r, code, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // AddComicSynopsis operation middleware
 func (siw *ServerInterfaceWrapper) AddComicSynopsis(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -2796,6 +3977,8 @@ func (siw *ServerInterfaceWrapper) AddComicSynopsis(w http.ResponseWriter, r *ht
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.AddComicSynopsis(w, r, code)
 	}))
@@ -2833,6 +4016,8 @@ func (siw *ServerInterfaceWrapper) DeleteComicSynopsis(w http.ResponseWriter, r
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.DeleteComicSynopsis(w, r, code, rid)
 	}))
@@ -2905,6 +4090,8 @@ func (siw *ServerInterfaceWrapper) UpdateComicSynopsis(w http.ResponseWriter, r
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.UpdateComicSynopsis(w, r, code, rid)
 	}))
@@ -2916,6 +4103,59 @@ func (siw *ServerInterfaceWrapper) UpdateComicSynopsis(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// ListComicTag operation middleware
+func (siw *ServerInterfaceWrapper) ListComicTag(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListComicTagParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListComicTag(w, r, code, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // AddComicTag operation middleware
 func (siw *ServerInterfaceWrapper) AddComicTag(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -2933,6 +4173,8 @@ func (siw *ServerInterfaceWrapper) AddComicTag(w http.ResponseWriter, r *http.Re
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.AddComicTag(w, r, code)
 	}))
@@ -2944,8 +4186,56 @@ func (siw *ServerInterfaceWrapper) AddComicTag(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteComicTag operation middleware
-func (siw *ServerInterfaceWrapper) DeleteComicTag(w http.ResponseWriter, r *http.Request) {
+// DeleteComicTag operation middleware
+func (siw *ServerInterfaceWrapper) DeleteComicTag(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "typeID" -------------
+	var typeID uint
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "typeID", runtime.ParamLocationPath, chi.URLParam(r, "typeID"), &typeID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "typeID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "tagCode" -------------
+	var tagCode string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tagCode", runtime.ParamLocationPath, chi.URLParam(r, "tagCode"), &tagCode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagCode", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteComicTag(w, r, code, typeID, tagCode)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetComicTag operation middleware
+func (siw *ServerInterfaceWrapper) GetComicTag(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2977,10 +4267,8 @@ func (siw *ServerInterfaceWrapper) DeleteComicTag(w http.ResponseWriter, r *http
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteComicTag(w, r, code, typeID, tagCode)
+		siw.Handler.GetComicTag(w, r, code, typeID, tagCode)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -2990,8 +4278,8 @@ func (siw *ServerInterfaceWrapper) DeleteComicTag(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetComicTag operation middleware
-func (siw *ServerInterfaceWrapper) GetComicTag(w http.ResponseWriter, r *http.Request) {
+// UpdateComicTag operation middleware
+func (siw *ServerInterfaceWrapper) UpdateComicTag(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -3023,8 +4311,12 @@ func (siw *ServerInterfaceWrapper) GetComicTag(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetComicTag(w, r, code, typeID, tagCode)
+		siw.Handler.UpdateComicTag(w, r, code, typeID, tagCode)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -3034,8 +4326,8 @@ func (siw *ServerInterfaceWrapper) GetComicTag(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// UpdateComicTag operation middleware
-func (siw *ServerInterfaceWrapper) UpdateComicTag(w http.ResponseWriter, r *http.Request) {
+// ListComicTitle operation middleware
+func (siw *ServerInterfaceWrapper) ListComicTitle(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -3049,28 +4341,35 @@ func (siw *ServerInterfaceWrapper) UpdateComicTag(w http.ResponseWriter, r *http
 		return
 	}
 
-	// ------------- Path parameter "typeID" -------------
-	var typeID uint
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListComicTitleParams
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "typeID", runtime.ParamLocationPath, chi.URLParam(r, "typeID"), &typeID)
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "typeID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "tagCode" -------------
-	var tagCode string
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tagCode", runtime.ParamLocationPath, chi.URLParam(r, "tagCode"), &tagCode)
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagCode", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "order_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateComicTag(w, r, code, typeID, tagCode)
+		siw.Handler.ListComicTitle(w, r, code, params)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -3097,6 +4396,8 @@ func (siw *ServerInterfaceWrapper) AddComicTitle(w http.ResponseWriter, r *http.
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.AddComicTitle(w, r, code)
 	}))
@@ -3134,6 +4435,8 @@ func (siw *ServerInterfaceWrapper) DeleteComicTitle(w http.ResponseWriter, r *ht
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.DeleteComicTitle(w, r, code, rid)
 	}))
@@ -3206,6 +4509,8 @@ func (siw *ServerInterfaceWrapper) UpdateComicTitle(w http.ResponseWriter, r *ht
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.UpdateComicTitle(w, r, code, rid)
 	}))
@@ -3217,6 +4522,92 @@ func (siw *ServerInterfaceWrapper) UpdateComicTitle(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// DeleteJSONSchema operation middleware
+func (siw *ServerInterfaceWrapper) DeleteJSONSchema(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, chi.URLParam(r, "name"), &name)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteJSONSchema(w, r, name)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetJSONSchema operation middleware
+func (siw *ServerInterfaceWrapper) GetJSONSchema(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, chi.URLParam(r, "name"), &name)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetJSONSchema(w, r, name)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// SetJSONSchema operation middleware
+func (siw *ServerInterfaceWrapper) SetJSONSchema(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, chi.URLParam(r, "name"), &name)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetJSONSchema(w, r, name)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // ListLanguage operation middleware
 func (siw *ServerInterfaceWrapper) ListLanguage(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -3250,6 +4641,14 @@ func (siw *ServerInterfaceWrapper) ListLanguage(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ListLanguage(w, r, params)
 	}))
@@ -3267,6 +4666,8 @@ func (siw *ServerInterfaceWrapper) AddLanguage(w http.ResponseWriter, r *http.Re
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.AddLanguage(w, r)
 	}))
@@ -3295,6 +4696,8 @@ func (siw *ServerInterfaceWrapper) DeleteLanguage(w http.ResponseWriter, r *http
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.DeleteLanguage(w, r, ietf)
 	}))
@@ -3349,6 +4752,8 @@ func (siw *ServerInterfaceWrapper) UpdateLanguage(w http.ResponseWriter, r *http
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.UpdateLanguage(w, r, ietf)
 	}))
@@ -3393,6 +4798,14 @@ func (siw *ServerInterfaceWrapper) ListTag(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ListTag(w, r, params)
 	}))
@@ -3410,6 +4823,8 @@ func (siw *ServerInterfaceWrapper) AddTag(w http.ResponseWriter, r *http.Request
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.AddTag(w, r)
 	}))
@@ -3447,6 +4862,8 @@ func (siw *ServerInterfaceWrapper) DeleteTag(w http.ResponseWriter, r *http.Requ
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.DeleteTag(w, r, typeID, code)
 	}))
@@ -3519,6 +4936,8 @@ func (siw *ServerInterfaceWrapper) UpdateTag(w http.ResponseWriter, r *http.Requ
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.UpdateTag(w, r, typeID, code)
 	}))
@@ -3530,6 +4949,93 @@ func (siw *ServerInterfaceWrapper) UpdateTag(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// RestoreTag operation middleware
+func (siw *ServerInterfaceWrapper) RestoreTag(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "typeID" -------------
+	var typeID uint
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "typeID", runtime.ParamLocationPath, chi.URLParam(r, "typeID"), &typeID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "typeID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreTag(w, r, typeID, code)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListTrash operation middleware
+func (siw *ServerInterfaceWrapper) ListTrash(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTrashParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTrash(w, r, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // ListCategoryType operation middleware
 func (siw *ServerInterfaceWrapper) ListCategoryType(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -3580,6 +5086,8 @@ func (siw *ServerInterfaceWrapper) AddCategoryType(w http.ResponseWriter, r *htt
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.AddCategoryType(w, r)
 	}))
@@ -3608,6 +5116,8 @@ func (siw *ServerInterfaceWrapper) DeleteCategoryType(w http.ResponseWriter, r *
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.DeleteCategoryType(w, r, code)
 	}))
@@ -3662,6 +5172,8 @@ func (siw *ServerInterfaceWrapper) UpdateCategoryType(w http.ResponseWriter, r *
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.UpdateCategoryType(w, r, code)
 	}))
@@ -3723,6 +5235,8 @@ func (siw *ServerInterfaceWrapper) AddComicRelationType(w http.ResponseWriter, r
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.AddComicRelationType(w, r)
 	}))
@@ -3751,6 +5265,8 @@ func (siw *ServerInterfaceWrapper) DeleteComicRelationType(w http.ResponseWriter
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.DeleteComicRelationType(w, r, code)
 	}))
@@ -3805,6 +5321,8 @@ func (siw *ServerInterfaceWrapper) UpdateComicRelationType(w http.ResponseWriter
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.UpdateComicRelationType(w, r, code)
 	}))
@@ -3866,6 +5384,8 @@ func (siw *ServerInterfaceWrapper) AddTagType(w http.ResponseWriter, r *http.Req
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.AddTagType(w, r)
 	}))
@@ -3894,6 +5414,8 @@ func (siw *ServerInterfaceWrapper) DeleteTagType(w http.ResponseWriter, r *http.
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.DeleteTagType(w, r, code)
 	}))
@@ -3948,6 +5470,8 @@ func (siw *ServerInterfaceWrapper) UpdateTagType(w http.ResponseWriter, r *http.
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.UpdateTagType(w, r, code)
 	}))
@@ -3992,6 +5516,14 @@ func (siw *ServerInterfaceWrapper) ListWebsite(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ListWebsite(w, r, params)
 	}))
@@ -4009,6 +5541,8 @@ func (siw *ServerInterfaceWrapper) AddWebsite(w http.ResponseWriter, r *http.Req
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.AddWebsite(w, r)
 	}))
@@ -4037,6 +5571,8 @@ func (siw *ServerInterfaceWrapper) DeleteWebsite(w http.ResponseWriter, r *http.
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.DeleteWebsite(w, r, domain)
 	}))
@@ -4091,6 +5627,8 @@ func (siw *ServerInterfaceWrapper) UpdateWebsite(w http.ResponseWriter, r *http.
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.UpdateWebsite(w, r, domain)
 	}))
@@ -4215,6 +5753,21 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api-keys", wrapper.ListAPIKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api-keys", wrapper.AddAPIKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api-keys/{id}", wrapper.DeleteAPIKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/audit", wrapper.ListAudit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/batch", wrapper.Batch)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/categories", wrapper.ListCategory)
 	})
@@ -4242,6 +5795,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/categories/{typeID}-{code}/relations/{categoryCode}", wrapper.UpdateCategoryRelation)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/categories/{typeID}-{code}/restore", wrapper.RestoreCategory)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/comics", wrapper.ListComic)
 	})
@@ -4257,6 +5813,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/comics/{code}", wrapper.UpdateComic)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/comics/{code}/categories", wrapper.ListComicCategory)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/comics/{code}/categories", wrapper.AddComicCategory)
 	})
@@ -4284,6 +5843,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/comics/{code}/chapters/{cv}", wrapper.UpdateComicChapter)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/comics/{code}/covers", wrapper.ListComicCover)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/comics/{code}/covers", wrapper.AddComicCover)
 	})
@@ -4296,6 +5858,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/comics/{code}/covers/{rid}", wrapper.UpdateComicCover)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/comics/{code}/externals", wrapper.ListComicExternal)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/comics/{code}/externals", wrapper.AddComicExternal)
 	})
@@ -4308,6 +5873,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/comics/{code}/externals/{rid}", wrapper.UpdateComicExternal)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/comics/{code}/relations", wrapper.ListComicRelation)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/comics/{code}/relations", wrapper.AddComicRelation)
 	})
@@ -4320,6 +5888,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/comics/{code}/relations/{typeID}-{comicCode}", wrapper.UpdateComicRelation)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/comics/{code}/restore", wrapper.RestoreComic)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/comics/{code}/synopses", wrapper.ListComicSynopsis)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/comics/{code}/synopses", wrapper.AddComicSynopsis)
 	})
@@ -4332,6 +5906,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/comics/{code}/synopses/{rid}", wrapper.UpdateComicSynopsis)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/comics/{code}/tags", wrapper.ListComicTag)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/comics/{code}/tags", wrapper.AddComicTag)
 	})
@@ -4344,6 +5921,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/comics/{code}/tags/{typeID}-{tagCode}", wrapper.UpdateComicTag)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/comics/{code}/titles", wrapper.ListComicTitle)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/comics/{code}/titles", wrapper.AddComicTitle)
 	})
@@ -4356,6 +5936,15 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/comics/{code}/titles/{rid}", wrapper.UpdateComicTitle)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/json-schemas/{name}", wrapper.DeleteJSONSchema)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/json-schemas/{name}", wrapper.GetJSONSchema)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/json-schemas/{name}", wrapper.SetJSONSchema)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/languages", wrapper.ListLanguage)
 	})
@@ -4386,6 +5975,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/tags/{typeID}-{code}", wrapper.UpdateTag)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/tags/{typeID}-{code}/restore", wrapper.RestoreTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trash", wrapper.ListTrash)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/types/categories", wrapper.ListCategoryType)
 	})
@@ -4453,85 +6048,135 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xdW3PbNhb+Kxruvq1kqZfZB72lsZPJjjftJGrTmUymA4uwzJYiVRKy4/Xwv+8ABElA",
-	"JHERAYJU+NRGFs8BcL5z+XAg8MXbxvtDHMEIpd76xUtgeoijFJJ/XMN7cAwR/t9tHCEYkf8Fh0MYbAEK",
-	"4mj5ZxpH+LN0+wD3AP/fPxN47629fywrucv8r+nyJknixMuybO75MN0mwQEL8dberxH8eoBbBP0ZxN+5",
-	"8vB36GNY6muA4C5Onon+MPz53lt/Fuv6+e5PuEVeNn/xDkl8gAkK8kltYx/i/6LnA/TWXoqSINp52dyL",
-	"wL75DwkMyWzJ4wGC+1Q20WK4H+iTWAoVC5IEPBf/ft02FvzBu2vmT0GE4A4m3tz7utjFC/rpMYgQWaoE",
-	"/n0MEuh768/Fs4yGeT5pOsUv5Vhiukb1T+ZebQrr2kLSb7ROoviC8kTm3jaBAEH/FQHafZzsAfLWng8Q",
-	"XKBgD715Xcvx4EseiY5hCO5C6K1RcoQ1ESfLVw2Bm8Gcn2/jksX7YGsAocD3A7zkICT/bBk+1cus4yL9",
-	"Kzgs4kP+7OIQ47VO8scqc1AlWjhuwu/2ARwQTDSE4eV5nT/VKLAVR/Gjvh78TJMW+BXBpFhadXk39LEm",
-	"kSGIdkewow7bYi4x7ksRN5s3AiFMsErvQ3VteWjz3n98c0sffjrn4U/44cPxLgzSB+i/SeL9uT7HiNnE",
-	"5ws5IzJjY4rCcvocxYcUakr8SJ4K0sZAD3bq0jZg1ygjQKHumDb4mUZhMQJh4YhyGBRP/BaHxzxDyh44",
-	"jajNAbM1hLLJvoeUQx/Z6KTccWSqTVEJqKUuBhJda6xKUpPPQpDqrRyO/7QGkcaERxlM21aTjpkboQ5q",
-	"ScbpvnaHJIiTAD2reWYeAR/hrx9um1c78Ouf84H9w7tr/M0neJcGCF7HexBEjbLoN86tS/FQWCGnKvnJ",
-	"aKx8mZu7L358fx9sAyBKrXdxHEIQNSy+PF2Nxxgayy9gCLlftJZ1+2BrN+Ka41h2o3dJ2Yo1mTNr17rw",
-	"ZdHRHfd8CXtWydoB7Um8B1HwP+iruV3KzLtDqmj0CGYhTqbI6NVwDlzP1f3iDCSDXTuQwU7DbgjsNgNC",
-	"Phk7O6pqru2LSirbbxD10fNe7cuoWKGumM8FqQE+396roR02f7yHaQp2zYhOEUDHVD5++r15Kaw+rJMn",
-	"8sE0jf4tjGASbDfk8953GZsok9Z+3S21m4GxBxDdyzBM4KE8HSJRazrv4ZOABjYuLh5gDA7BAv95B6MF",
-	"/IoSsCjoNw5c3jp/NmsduZoQ8mx2UmCIg6Ca4FJexhUo+ntK6upwMNKDH2cd9f1hE+vDycxqhN/WOjFa",
-	"sixrWZBi97cZq0ZmX8y6646jmjpGS6a9R6mpgoSTTvuaip6L5Wed9kCVFT0RRSb2S9VU8poyI5usmpo3",
-	"cR659HYYFYMVKzTT3pXUUEJlil1dfZfyUgIfv7FoY3YbNv3VN2Ztz3LDpMN2u1ewVtz0VBwEg2ztPVI1",
-	"FYzYTIcqq0kvxGUam7CKkhl/PGPLlrFdsUd79parYjwsBGayLVplw5UisopkyteWJ52KygKfKKntQZow",
-	"JS80O93ItOXhlZIaiMSbzhV22F3mszeN1UZbCsx0N5knOLmAUytmFLfGzXCDQmDG761bS5pUxYXR4FZb",
-	"srvtom3ES2JjvQQGnX1QRcmlxEzYLlCTVj5vtWY63dls7zVUkGxsLjCdAiOuSMVlbKPBmiMSBVnVpTA8",
-	"E45fcI0QizNSYhVlS2MKLSMLLWo9GfVQg+Vl7S0cRdjlZ79qR4NbujkEhycNkGFuuetvVLONkTP7HGoj",
-	"JOLsTFPcPyHTbG40T40S542STzlXqBvHbzncozYiv6I85vHmFyeyWidGm4cmjjbkiaD8bhChf//oSTbi",
-	"aSawf1aBNMirSTUtxUeI5K1Ko+2fwuAmhF6ih4pMNPUriwWZ+pVTv3LqV+Z1PETvj2HI/b6i8bxo+TMK",
-	"xZ9dKZb9ufp5vMfaD7RvcMlN1CL+TE3Ub6uJWtpdoYlqZE30mqqGmitsk3WQoWWUnd92MA2gqzvattwg",
-	"8fkt9QoLFE/95QnIFwHkqel9GRsmU9N7VJ0ptggbZCxk+/AmDNdbX77VO6b+++j776Upp/77GPvvQ41z",
-	"1g8FGHHI6pBAk18onQcYel+rZW7yQwE6jtHlkID9uQpPBkx9ScdltsLxABOzbjguYBl5FHa939Y48HsV",
-	"GXt3XBq//XIYtV9ly055NP6YPoXbYxKg5494ePlAfoIggcmrI3rA/7oj/3pTbK//59PGo1d5ktRH/lod",
-	"73hA6JADKIjuSdOPvxn0bby4Ayn0Z4R5z7YAgTDeze7A9i8Y+Vfe3AuDLYzSHNF5OH51ANsHOPv+auXN",
-	"vWMSUjXr5fLp6ekKkL9excluSR9Nl7fvXt+8/3iz+P5qdfWA9iFzhYJ3HUfxTbQLImzokmZ4q6vV1Xf4",
-	"e/EBRuAQeGvvh6vV1Q/e3DsA9EAWZslfubiDpPOAbUg2SN753tq7DdLq+Ah+NgF7mN+r+Pl0MX4BOziL",
-	"jvs7mMzi+1kC02OIUrwIGAfe30dIZNBlOOD8NmeuZq1f03aq4L/ga7A/7tV1hME+QJpKPsYJKuTOEoiO",
-	"SQT9NgVx4sPkj7tnTodirZdhBHMX2n6/WmldZtvxksz6RbfFd2dhkCI85wcIfHq55e+LX8AuiMhYFrdk",
-	"YWvusHmAsxCkaHbgoVA032ZPAXqYbY9JAiM0uw9CBJMZiPwZMdSVxFLe74tNjEC4eB0foxbtpLc72+Iv",
-	"yBRL1OWrU94w3LTEpfGWxVXEJAQd93uQPFPnKYdw5RV3LX6u7gv+ks29Q5w2ON4r32f8DsdFmKKfYv/Z",
-	"2HXH7B0WeNyspK+Lp6enBc6ii2MSwggnEv9c0Vxcp7e9nuD+O2Oz4vW2wBv4fu7TDL5v4225L1zHFQ6a",
-	"GFARfOIsWgNQmczOxw9NYSTCssnr8xccMSp4vfJ9GbqyORvlly95kZAtXrBBs3yqIczzPY+/a/K5aujH",
-	"LGz27ppzOayrjJt4/aqwWdYqPC4E/thcAJ0OA5c9/BjiWT7BloHQAkk6jNKq9ZD9Yx0wJc5y3f6VZx0L",
-	"ubnkwaYxyb+F6AIMnedqe4Ze9RujdhDXNd2T0FuokoMA2j7UgfErOa58AdjIz10bxYb5hMye1DackHnR",
-	"Cgm5Z7DTc/FnpWT6rEZaFgftY7SP/eA+6CVu5x7WLY0vuXvGpeVk2QAfpzsP3Imb7h6zV10zKpxU2bz+",
-	"Focq4Gmo7C7lDan+5gbVwYOXL+wpYI0KffLqfAuxTRFZ4ZMs0aSQPYNtjxiULuGMIcgAK6cKE+KGhriV",
-	"4/hug7LIcarEXSawDgCsVimTrWqrWYUTCqXnjSY5lUbNpZj4XLIspWIt3gdbSV+M/ER1aoqZbYrVdL/J",
-	"20R3z7TJWrwibfYIwiNsnSb58h/Fl4fcoCMwUunOkembbs0Rob335dq09tWUw/o57ydGELfjqLvb2jXI",
-	"YWB+q4DK7Xd/oFLahOGOGwGF8ZyT/zYUVQlkqdxpU0kmZVlIVtFRc4vo7p+3tntsK1E9a0lH1EYSO5lR",
-	"NiaIl0L6dZYJRtOtsRGyGbn9kgwxmoywCbXALQg7TliDcpQ/OVAnriRUu6qcd4yg+cHNzVI54+xwUV15",
-	"E0q3hs4ZccIGU/A0NysVfILtemg2OZw7zHzkG4V22xo86t3UhkJYSorECVqDgNbKWaA2XitL0CgvmidI",
-	"uoKkPapg83SXflnkztuMcYnOZ75OxuWKXeiWVPm1YQrdidflK4gGEESmVshg2w/llYuqXQgKQTvdiEK4",
-	"m66EQHuf3YliHOd0KVy6ve2tBebKRBs7C6V4BxsLrG6R0xnZVqjQNZBdhXa4t2fA5cv2UXn/YEi5kA7m",
-	"879+y3+y+6Uef6TtnUdrTJ6OwBWRFwU+MY8/w8Tmez5nGls8iMeBcWvVWGWBWQvzopxYjy8MiPthj4Oi",
-	"uHbSc128A4KrCnmD9FY1SSsEc4fkViuzx490wSQlLrlV+RILXDIxW+UtFe6guK00N6IU/9lMYYslDais",
-	"LYajCv3lSxL4yjWtMy+opbIPdHuYMaesgk3I64vslLBkAK4K2Daby8rXoVtTWKJ2t+bKQcixUJ+2W1+h",
-	"Oh06BIS16FkQsFiM2kilp8IdFKIquDZYhKolVGkwdliAqufg4vcCChXoTfXTgosrQsu5WapDGfn9l6K8",
-	"8ibAlj8xMVGQFsKGU5OyI9JwCa3K1Kl3iHNZaV2H9Wk5BkclqggCkip1JJYdV62qEZPMV6xiMMiL1pEg",
-	"Ykylq60E3CC//wJWA+zmylj1NKwStt0Vs3rJW/FqKO7FSBdYz9q8iulEfv/1rPBaAIIZUzcwccKGU882",
-	"/pxf7hLcLUz0TV2q5a1TZxEez+UN7vCQbnmliHDqxRvSLNXZDi9akkJTUmdPEBsUxFbOgrb5gl+MSnnB",
-	"P0HTNTTtMQ+b9yjpl0ruvM4c8+h6d9LJuJwxD70yK3//ocovocu3eF4g8fjIvgXSAvFg5PdPPHjlTagt",
-	"XoJphHgUwoZDPNgRqXuE1j66U+cQ762VxnW4j16OwVF9L0KApL4fiWXHtY+uEZLMl9ViMMjL6pEgYkz7",
-	"6Lbyb4P8/qtZDbCbq2bVs7BK2HZXzerl7uLFkuJKdgN2l1jE4mlZql9z0f2XrqXeJnQisDNSsCKwG06t",
-	"SgejBnVma5y+4V21XnXlAqJdIWxPh1tBdOWbNNO351sqjvG8HdXFLWiTlMQTeHoHz6rnuGq+6m6Fmrzg",
-	"nvDWB97sVfcWChNedP81vdSBzFXySuWJJL24q9/V65kAhSrb0BvyRu1LLN/JxGwV8FS4gxK+0tyITvxn",
-	"M2U8ljSgQr4Yjir0tfab3XmBeAMqN6fDneZ8AK7K6TabywrqoVtzXLvLSiHHQoXbbn2FGnfoEBjTdrKV",
-	"VHoq3EHRqYJrg4WnWkKVBmOHxacoB4cg2h3BDorvZ7yl35peIDXGWxNL6yncmFh81/RliQXOer8nUaS4",
-	"pysSiyGwPljaRHhBIuN3lkhXhQ3jlIsR3Svh4vW2wLsb12It6ppoSdDFBfnlSwDRvQK9Ug347242b2Zb",
-	"WpCVriZjPngQ5qlPadveeY/Uwdt4T+dlFlISA8u86tcrTdIRedQV0ZHOlhEyhfMsY4Uq2EoAvOheaYIS",
-	"1EwwBPU0IA5YLriBQtoozpG00gKFltTECIbICEjjRk4GNmBnmgcgsOudArTo7Kn6P+n94KUX1vy5U1kq",
-	"9+2cjnJwMKql9bjpfBpqIOegGlFTBGXux8BKx50UIvWg2vqO3he7cXEIqSVAtHGGMZpyPO+pFcQVk+Sk",
-	"LSmIKMkYDT+Wt+PaOZzj4FyOAL8mGM/5x3A2jg7gtCfS5wNMT96C2/6CKvrOK+xgE+UZI+V5CyOYBFti",
-	"QJU3R7FvDjT+5ihOeO9vjpJp7+vNUacvZyx9FGsUvziKd0dLZImDjHHSxEvvlTzVVIuw3/FU2qmRnZ9L",
-	"k6CuKTUsVWmWTpo4fT9ovtpueA9v8f7PjUkjQevBMRMLPh52ouW4Rs92yWO18HCXCTONhUtYTBs16b1y",
-	"Cy30GTmBpZc8FEKbk1NY6hkn3gfbBXcNpviVucUVQRMt+VZoSf3OMDuvteVVuHm5rXQMvb7itnZRmwZd",
-	"afDVibOYTT4NiDF5gexw+IsSGFtzijqV0c0v/ItKeUs4YjUNI3F8teoZDMewHS6H7DTMz97to+cSH8PW",
-	"mzjQwDlQg9nMX87ZiQ81jdD5NZ3yPKZyGG2iQd8IDdrQ5raN02lOCI9IcX/n1M6gNpXXTYTGaCYpId75",
-	"ONtgqIsAYXycX2oca9Mpqkovc3e6zA0JEfq24KDZuYt7MSSjNJnhw19n0okOJpmYw7CZQ4k0Q8e0unCE",
-	"aixuDmyJssQTvEsDJDmu9Sn/0sQFxsgFCuMp8AD6VdM0gGKsdxYg0NsTCaAjYH2vMIeQBlQOZ4kGlKAw",
-	"TgEqyb2W/5zaZlR3q/wZU7ou/IWoYoP68sWP9yCIFEp/xRB/TeTNcPRjPUxGAPJhmKcAhWl7ZwASx27j",
-	"AJ1XWcgEjKzyqk+XNEkDZLFWRAQ620VIB861ixVCYCnyc5J7JQIKMDPBAZTjvzBQuWAA4nRBBCaPBeCP",
-	"SeitvSU4BMvHlYfl0WdeCjTn113hurn4gB7IYj/LfxFd/pO5Mqn8rKpLq6cImfuS/T8AAP//AMK2p/sl",
-	"AQA=",
+	"H4sIAAAAAAAC/+w9XXPbuLV/BcPeh2SuJLu7nTsdz/QhTZy9btNsJtZ2O5NmNjAJSagpQgtCtnU9+u93",
+	"8EESlEgQFMEPOXzajQyecwCc73MAPHs+WW9IhCIWe1fPHkXxhkQxEv94hxZwGzL+vz6JGIrE/8LNJsQ+",
+	"ZJhEF/+JScR/i/0VWkP+f/9F0cK78v5wkcG9kH+NL64pJdTb7/cTL0CxT/GGA/GuvF8i9LRBPkMBQHzM",
+	"zONj1Gcc6ptPN39HO4E9DH9eeFdfzJh+vvsP8pm3nzx7G0o2iDIsp4SeNpii+I2YyYLQNWTelRdAhqYM",
+	"r5E38aJtGMK7EHlXjG7RxGO7DfKuvJhRHC29/cQLYcx+iVHQBEYE14h/ffSHDaJrHMeYRIJczNA6Lhyo",
+	"foCUwp34kKIFfuJD80s7XyGwwDRmwF9BCn2GaAzIArAVAjHyKWIALhii6gf6gH0EJLCZd0T5fuJR9PsW",
+	"UxR4V1/kNFLceeon2lrnFu1rCpWoXTr+ZaJ2/FZQ6F0dbiPcYMUPJi6QMDi0OIVzvDpqFRgBMYoCgCOx",
+	"FP+avvl0M/072oEVggGiE4AZwDGICAPxijxGAC4hjqrXSJGa0lA42W2Ai2bpSzILth/6jNDiv/Dd5H8p",
+	"YUKFdeI9TZdkyn+cxvd4MyViTWA43RAcCRD8s/3Eu0MLQpFLiD5FkBkF6GhWKGKY7QonjIMcGByx//lT",
+	"BoJjXiKaUCcFz7t5p5PrXXlbHImduEdFSA62FAdesgOTZJNSEiUMfZJFO/5XyPzV8Y77ZL3GjKFAI+KO",
+	"kBDBiH+1JkGx2qAo3oYsrzJMkiHQfxYfHSuTg9kKpBONtAxd6cx+3iAKE+bNT/GOBHyJ9xNPCpb4EQYB",
+	"lszyKTe4TO3VZrk1YisSFKtcyFbHmuETZCtAUQgZfkBcOXCl8ObTDbiDMZqABaEAPcH1JkSALzD242pV",
+	"oIhQKEsXT+3LQFYuZpBtdZCJRB3OTg0smtZbyNCSUBcG3C8TgVKTKjfx0KCakCfkflZfFtlb/u+3ZbTw",
+	"H27eFaxZkdI5WEb1rYZhIietpmhnPo+mcKxq1IjSSSQDrCdykmLfboKKTyqcqYPly0jIzWCSn2/hknEp",
+	"dsChmUTGTo2mpB+j+nxcxL/+Cm4YojWA8eV5K78qBFjKR+ShPh7+TREW9MQQTZbWHt61+qwIZAij5RYu",
+	"lcCWbJeZ71MQ1/P3BiCasooXoT025bR8vH3/QX38eMrHv/KPN9u7EMcrFLynZH16AJOCmZPTgZygmflm",
+	"mtRyvIvIJkY1Id6Kr3BcBJHBpT20OSwMzhhmYV2a5vybQmCEwTARxGo2SL74Jwm3a2TzwaFGLVaYpSpU",
+	"N/YdmBz1ybyOyT0PSzVPPAE706WxRFMfK4NUJLMIxvVWjut/5YNU6oSHKjYtW01Fc47COlxLHpys3YZi",
+	"QlWoWi2ZSYDxy+cPxauNC2KWvGL/fPOOj3xEdzFm6B1ZQ1ycMlAjTvVLqYh6MyCHKPOTqbHyqW1uvvhk",
+	"scA+hibTqkXTB4tfba7OZzNqLL8hQpByUerWrbHfrsZ1F2O1q73TkC1Zk4m2dqULnzodzfk+78Ke5LI2",
+	"4HZK1jDC/yeTVtViF2vzbmAqCiVCW4iDKWp4awgH9+eO5eIETobLckaGyxr7xuByPiDOF7TrVGVzLV9U",
+	"4dl+h1wf7dZ2g1myQk15XgKyY3hZnTvidlT88xrFMVwWc/RRyrCEfjVukgI7JuvgC0lMEfU/oQhR7M93",
+	"GxesVTfLWBQy1crX/e3254+3aRG1IfWlqdCsTFucNi7KUJXU/BQou9l9UFzpYG4YsUWVhArmt94sAbHW",
+	"Zn1Ej1lBum59ufVacEmNVgNWMqeSmlRSdMrXR64xWyEKYBhOCZ1GhK1wtASvAtky8BoQCu5QzKZosSCU",
+	"zWzSQSQpGtWsY2XFpqq10DCUrIEheVGoEjjjEbjBU/7nJYqm6IlROE2SRpwDvCv57b50r+2AiG/3B26x",
+	"eVHtAKfw9jm3un4m1B4dN6H1lGZud+yrGi7WJwdzf5SmamudNCz7/b5kQZKaRfslCDuip/oC1alVJJMp",
+	"rVm0QaZLJkmZo165I513YcWjhUnXr6AkJJYWUVqgsmlRxg6XhmVfu4xTE4XwSRqVfizNBIe/b1Qmskb0",
+	"6O31WlCDkpIdyjymvZM6VE3Mc+LtVThXq86UCFFpqakFIapVukoILCphtUFbvZJYSl1hVawN+upV2exA",
+	"54Dua1fmaiBRMM2Og32l7qW4UfniWhuzm+vO9HFxsu1ZzjXnunzfM7a2LPxZEqFxdu06oR0KDey+TrrY",
+	"DnoCbl+jEGkJWZPHE8qWEy/vJ141KDtaGrwE4L6qTGm9cSmIfZZorV7bfOLVEhkOBJKjOpyLrcwD3R8W",
+	"89qS8AzJEROZC68T78iBv2pQOLWjNgW4r1toHdmpD3Yq5RnL8rCbEDoBuM/Xl1szmgrFC0uqle6lXnE2",
+	"ldJeUrjdiWKoUwu0hJxC3BtL5nbQ0u9b9ZkOq3vl9faJp0ebR9yoVcudiKICt9eL7a0JokCwzyr1jmeS",
+	"iy9yzQAtzsgqqkjL+qNqOTPVYteXYK9qOLx9eRuDJduJj4+0SllHg+DDgyaAYRbw6pe99PL5idVwOwoF",
+	"uHamaa6yi2kWN1uNZdfey66/yljheHOCkgZXO4qCLORxz29B0pVcOjHVYuKivc/pEWTX/XqiScx8GvkW",
+	"serGB6dV0mTDXQB9iRJq2qKx+yFZkIF2P7QhMGNBfCyID7ogjtjHbRjWaFR0KnkK/YSsOfaNqlu85CJu",
+	"ov/GIu73VcRN992iiOtkTeoVdR0Vd/Qi7yBVy1lWnsuZaQBV5bMtCw6SP7+nWmXCxWN9e2TkF8HIY9H9",
+	"ZSRsxqL7WVXGdCdskLpQ7wNwsXGd9QWUSsdY/z/7+n+6lWP9/xzr/0PVc603JTgRyKxJoUgurPoRhl5X",
+	"K5lb/saC/NRc3DNQesGAwF7dElFHLJu0SLS/0sa+iLEq27OTb9Ec4WLWBc0SLXOeYrvO72se+M3Kcwrj",
+	"lW2f0mn3ngUoRH12oDBlrwyrf9I1ua47W8RfJ6rBRe2ndjlVtoxF+6jJbUMWD8qv+bO7gaaqV6nwWqQY",
+	"+VueShc2WBLyJn0VI39XC7+2/h7tgFocwFaUbJcrcMHVwz3axeJxiyWFEYsBicKduOxeuzGG//kRximA",
+	"R8xWM77yHLq8jD6h/spLX87I+E+9gcEvuEeQIvpmK6/cvxP/ep9wwt9+nXuHT8Okl7vEIMQx45RhCpLV",
+	"04jkD3d8e5pqVH+bgWvor3JjYjlNFIA7OUtG7lGkD0neRoFrBPiMwDYK1PMoPokWeLkVeMWTJxNxzU0x",
+	"oBjAKACxTzYoBmu42fCFJ3wlcaQgzQB/f+QbDNY4+qbToLYCPSC6A0RctJP9VX31SDFD3wrpR5CGGFGg",
+	"QvA4D08be7dlKfptFKI41ubJiRY7Lwh4xDGaAXHfDsiu0Uk24oBhJBH+CkQo5mudfjBLbo4SPrzY/YxL",
+	"VoxtpC3C0YIcs/FPZHrHy4JApBCBDxkMyRLcQf8eRcEMvIl24NPPt/MJ+PRm/vZ/+d68u/5wPb8WVKKY",
+	"Aej7aMNiAMG3TxQtEL0CAd1N6Tb6pp554R9B8C2gu8/b6C9cCX0Dv2/5sjEC6Fa+DbPYhiEQ6w/4Sw4A",
+	"RzEOEICAURjF8kEQwFZQPBgDw0e4iwElYci5Dvr3fBFC7KMoRtltXd6bDfRXCPwwu/Qm3paGakGuLi4e",
+	"Hx9nUPx1RujyQn0aX3y4eXv98fZ6+sPscrZi61C7Oc57RyJyHS1xhDwts+Ndzi5nf1R3LUVwg70r78fZ",
+	"5exH9SaF0CKpVuD/WMpXc9INvAm8K+8Djpm6AIt/R+Eayavkvxy/orFEINqu7/jKLoB6NyRVHWJlM82x",
+	"gUvtarHCFycOEfwDPuH1dm2PI8RrzMxIvk7yz2D9cHlZ6wksqwsNsreJDm6s2k9KtDdXf3xW2uMfH3B0",
+	"X/yo0ef3b8Gff/jzn8EGLnEkKAUhju5j8CpCT2zCFdjDRL4MNQEhjNnrWdGqaBbrX9NPKazpB7GMhag5",
+	"MLDJb3wyB244gL+lFEUMiK2YVWy496/pnDAYTt+SbVSCUTTLAJ8P0JBVQJYLnT5wVrRXKRdcJC+h6YZX",
+	"sLtuz7583U8yI/zlK2ekeLteQ7pTQqOTJh3xL2J7+Qdfuc+Uu/LtiydUs/d1P/E2JDY/X4WV5aaIbWmE",
+	"1DtWOAbJJCa5F77uERLKPQYrGAtbnhfxN0GQSrjSnn+Vz8+4eQouu0LvoHNbvXZxIIB/dIY4966YQdpg",
+	"EKBg5nXMJG+C4FQe2U8yzX3xjIO9ZJcQSR83v73vxO92OvzmnS7B3AyiB3KPUgXLDUemX3HgHW6nLoaW",
+	"EUo+jDtWyH8qd3Ildd1v3WeBt9HuJa/AlRtdMeIl2twjJO9xyJBwrsVDaxPNx8ZxvEVUutdbERCB/xAc",
+	"SZ/+394f/u0JL26Df7tHu6t/by8vf/Q5JeL/kHi6C0b6RhXRnDzvZrCIRpK5wX0Fg2ACZNQ7AVIWOWkU",
+	"xYxQ9NqAm0M8Ebl8h64MdvpKXQPYpnW7RycDT6JLyMT+LRiiZVhiHPnIK1QshjSJPXL55mEZ9m3EcFgf",
+	"ezd+pVARNm4lH3jWTqXQl3mXciH3k6uGtrzLUqxD9DcFsTlbxH+otER36U3AhW7n9RPytwypEFjLBrwq",
+	"DL9fc1+URAgEkEEewetx8gzcRIe3CK9JIDMK8uHaBcShnkQQsXQsQmkx6nFFQgQEzROOSrt3WIJKU1qH",
+	"kGIAKToMzfNmV16K3JoTLMFb+cCXzvBqSPMbK/4AkNze7l2ohK/uDrJMOgNL2iWb5q+ZLfWa0hMS34Xj",
+	"dMvZXsFNo8EyBIQGiP52lzfZ9heNF6xgYhf8LY0JBQtK1gAKw4DJNlaETcA2FiFqzBAM+ELw1SwjUoIy",
+	"GpJOTGv5c4rHkpSMPWsDm5xHaWxjNRI+oic2fSt3tJCQzREPqYw8X4GMpIRhzHOva9xNM27NvudNdkKC",
+	"rvRSztNTQUcJG03PtWStMgnYT3KQnqaPj49T7gVPtzREEa/GBaeC7jQflMdbIsQqGZSXYuKnPdpFXMxW",
+	"nKEi9Jjb0XJ23feQajLzWoGTyOATich6NxOu35EZvniWFeL99JlzgEUKytY28xYqIDNRqYxyXCVZqLTU",
+	"X56JsuofOCTjrXAndRqIiutLCFFl6UoyDJasINeVMqbELT21P/3xB2dSId8CKhCJTxT5JJI9XYk7/epm",
+	"Mf0Hd8ted+4xSiZywMeTYvfxJ8ReAIdKL7A9Dr3sVhsvEYsPlPFbXh2dviURoyQs1sha8d7no8GGhNjf",
+	"Se9C1knIlvqVTsW16os6xhAzSqJlkiNjcCn2QfkQFG0oilHE8nXwUlPwY5HcfyQiosULrMTuI4mQlD0e",
+	"bnM5VH+d3vIMWROBTGXsJ2TjlCQZg7z8/CJyny9AhGQS16kIuffQ9Gt8HHtoedCdZiusdIJqLTtQCx3I",
+	"6sTSEVQE1nAGzZZ/GyWK4Ls1/lK5tO7EXuSeqa+MvtKzm+ep7Aau4ooeAWsvGNVQ9BKU5vGXaIKEPR1F",
+	"qSm84YarORLbFfmLZ/3GmxoB7agGoqUJkVhhFFQi1Fa/xTg6lSE9oO4xkHXA4dUR7ciiQ2PRy54tiIys",
+	"3YaMFaxsGzuOzDoAZm01ZG3LnytG0UsIW08ai2Pa08LNGl6dpaU8CEB7DPy6cAdFw155R8xnOUAen1mT",
+	"mAGKfBSxME3RZ9SuubrjbS5M7Zc4fcXD6Rg8rlAEYDY2OUQSEZYCgiFFMNjxxu3spBKn87h5RZH1IlLY",
+	"YiYvJoet5tNHm7JA7Cprwk9iVXTf8CFj682Lbb0x9dSKc3rJg9PgAYZbVLrgYvBvyWBHq3KLIPVXihDe",
+	"mB2Jgg8/9yIfoJ0AdTeI6GdJr0YBJEIxwJEfbnlOBXxWmyraBWF0L3vNKQrRA4x8JD72YcTV9F3awiIH",
+	"ySUsm/XvzdaWJ67lsoJXUh2L4/6lneUMLh2t7PWTWJtGpPyGJBBHJL1N6VAtSsLSihbQidx5yjtNTQSt",
+	"Vbdngy1JrVadxfAzC93K5jQiqpVtSik6aa9S0hxsWHKvE+C3wFQoqWSso6U4JIVfNi8pKCNA3DFvskoF",
+	"t9db4f1QiTesh/eDBV5xibx0sgB5QDSEm03iGVMYLZF0gGMGKVe+4vQ/YHjNeUSB4I16ZMtAeh+9OGQf",
+	"g5jhMAR3iINL/1bqUCQDfuNG0+25kjoTRVFwMM0kAMimJ8w6ibJTKgCz6nkx0sKstIc2UsXCHfO/iH+8",
+	"nshoNEbLNYpYDGLEfUBlFgNSSrUG9jQ566YbmC+BVSuw1DLn3AecStpAmoClTLXTAVw2167afzn+XFDG",
+	"f6ho/FUhVlsFV8np7qusCm63pdUMaZGYNqyhJps3sLppCU8VRPly6HGIf2Hd0GsT7qcZHumx99NDK3CP",
+	"DbSy7liXQxQTGMuMJ3HCGfWqmjXJ2KXafZeqwXYaa4wncerZtIS2Yb41uN1W0sxCdz5toHaegsFyjQ2g",
+	"aR3QmXtjfaI49/BaHcXRWh/AWEEZbJKg1rnhfHL0/LMGzs4QnxbID+FAb46QU0L7XjXN13bzCm0eKT6A",
+	"332ewdgKkOdPB4mHIR80rpKBRrZa79ep2a09PEN+Zg2M7fZn52Wk7+bsxkxckSwZGXEQjHjZmxFw2n9t",
+	"43ZUZkVGluyLJdvLBbV5Rri+y9WftDnpr67peNmYuQF0Vrfhrsl3xG0SK3LkmFcZO1PP4FI4nWPtEzzy",
+	"g5eQ31EzGVp3SEJXm10ihrl3m1uSdJyUWupR17adWUqEsqXEUgq+h7ySjtukX5xklTLuGmRSqZT5i5wU",
+	"NbjaTbl49h+sE0hDclgUMV/++5/y1Z+vx/qqsuHmobVUjqJgWG03qivhl2jtrC/BRaKpCWNXZZpO4Fj3",
+	"3Tkn8q6ZiIeBZX9sVfWL7xeaeB9gzNLeH4OjKcVQtcszvEY1pzPQzqQKP606F3d+ZsbcI/UwqKxYO+7i",
+	"MfgecmK2Oui82qfsPVMLj2Rw7VSDcUryOUHH3jZ5UExWkRIkD0NRfGNCcLiNVoJL7JNw5OFlpODIQ3ES",
+	"qov+qjLc3SbAyIPEWDv91ZteaTv5RR7c+zKHwHtIfGWYyyXaSdIr4ahBprzIg7UJrqrKCQN88UytHgwd",
+	"mi3+fPMuRaI2vyq3RXFQC2ed5JYgYBhNSqdySFXeaOh7b8wNNd/7yx7UWQtNQQZzWZ2FGDoLGHMOJ7FA",
+	"i0mHNsz0IfAeEg42fO2w/cbOWFeq7sE03riz78l9WhYx9nV29dYYZo9hdrl0p4xiHWmnV8Cdf7CdTqWf",
+	"eNuEvtOQOyHklKi7V03TcuCdCUc7sbcGv/vwO4/cKOkugnCdx4YYhxtkoImprhWND8tq5zzylBd6jMlT",
+	"GgYRljdhmIrI/Ez44Lzi8xr6zn2UXmFhKwP1M+GIcwrX2zLuBfC7D9prMLu70N3exNso+aEE8G4dg9z7",
+	"c+YY3vaVlDGG/85j+OwlEtsYPn344/xj+HQq/cTwJvSdxvCF76ZYxvC9apqWY/g2X5Y8gN99DG98gyjP",
+	"ny5i+AG/JlklA01Mde4JIVERsb+PZFhGXD9zn2ePHk/ep6+dGaeu1r2t3MJgHo1szMgVuYWRIQfFkJe9",
+	"GQT3SY4KF6QyyTGyZt+s2V62pc0HIeu7Yf1JnbtsS9NHIA/oGki2xbUL5+CtR0GXzUOPYqCjVx5PvG/9",
+	"fB5WNN/93P+Tiidfon/MhfEuIpvY5hriWzESx2PKb0z5GSUnZRTrlF+svngBKb90Kv2k/EzoO035JYSc",
+	"kvLrVdO0nPLLhKOdlJ8Gv/uUXx65UdJdpPx0Hhtiys8gAyf4i4mlrtW1MyyjnavNp6zQY9dOSsMgMmtN",
+	"+KUis3YmfHBeXTs11J37hFaFga1MaJ0JR5xT105btr0Afvd5pBrM7i6PZG/hbZT8UPJIbv0CCaEqep/D",
+	"5TAkfQzcBxu4z2ER/hJpZ3D5AsJ1Pot+IvUSzJ0G6QwuT4nP+9IlLYfmgv3bicol6O4D8hRvmQS7CMMV",
+	"Fw0xAi9m8BONrNZqw+CyTqPNYIyvVjfmu99jsVjtTBFmubZthfx83oOI9k/kzYpAf2S1zlntsmOd7T6X",
+	"UO4HVKYRRn7rgt/ay1m04PTkQXefqagUIHf5CSvXp8IYDSUr4c5Xwiy0aSiY83FjUmJMSpilWXCJfVqC",
+	"D38JiQkxj55SE2W4u01OcCpOSk/0plfaTlBIUWgpRaGA95CkyDCXS7STREXCUYNMVZSw+8kGuFafwIBs",
+	"ca4UKDe/xw4BScAwEganckhVymDoe39eXQFW6qyFGN5gLquj+KGzwDm1AbRipg+B9xBW2/C1w9DazlhX",
+	"qu7BhNdN7DvfrmmyG8+c7S0M+99uf/54K76pkuyPUD59xL8AEk2lzRX/cW50dQp6NroaKfq2iZ/VshZt",
+	"HgzWODIa3cb7YrSHDvbFneLQplqgOPRpuTSINjvHzeK2ICi+zY5c6eQ9wBAHkPFDVlD+YYFRGEwAmi1n",
+	"4JuQ2N9gIJ/YgWH8bQbeJN+SRXawiu8OwBFg2pNq6uAmvEcxTzX4KECRj4C8Zpp/yEkKAIkKjmTdOmCn",
+	"GDGnvNSKZT3kpO6Mnz0Pi4XsWFndItZUU3EDE8JouYXLirTtBzWqitHGdOr4lrlJpFI+skjrJmPPOqeb",
+	"iNeAXi9PSWrn4XLTjDtKIyck6Dox5TxjGlnTcy3lczMJcJ7N1UB3msvN4y0R4mZpXH1Hh5XDNfNagfFN",
+	"P9DDvOTH+OIZI7awiPFsLfLN9fy9ONWfk82qGI8T4T7GS5lhfJzbFH82Z6nyELQx2xhDUAdsc9mtWhrf",
+	"xH7Bb2JX+wGm/HhjUTGmrk8TlVYi7LZckjzoTkN3K9k/m7eo7b0fs9kdX6CuSNi7ceYqz+VZtM6OiZQx",
+	"kWKSN8sTe/MzP6vn4pSeu8wJp6adpEm/ZwIPGoA5bxmzJFJ/tZQgaecwXg/n8Eq60eeND98N8thdEQ8V",
+	"GEwGn0hE1rsjg5m7ztrqgJ2FFR3U0ZDKpIvfyuG3+cGxt9HrO8y3nMq65VmWc2RNY2JnULd0GhTrmM55",
+	"wemcMjfFlMQ5R0k05o384RwjbOcEYQ+HBw0K5WxyRKcfSJwfH0UcfYTDzJBr99bJJeCcvSyuAOfD3FwA",
+	"fp5+zdlcP27QQ71fPd5IACiMV+aMqBjxXeRE38sM1t0OiGUREgJeiT7SCfAhQ0tCd1z9Mbh8XYZYoOk/",
+	"CSl2zSYNKWZ61olIMYOuT+WWYm0tMXiakpBZRE5sTkfwHyobUDnx8YVifFx1fYCSj7nk/7GEcnZH+39C",
+	"EaLYFxtoc7Y/UYh84Hmf7s/NpPPz/VXYuzrhr9ORUxYco/mIf172Wyo/5PjTeRkiD73TcsQRapOgNTx0",
+	"f7jJAzt2b+ZBa6/2wHBd2BYu6hix9Ohtbnv6qSTkWaTvk/HNN9FwOt7FDp1PQr2WanB6gL3aGhhPsLvY",
+	"pnPJtrZomI6gd5p9rcV9To6Z1zNPFrpwAEfNndo0ssb+NH1puvpCt+SpyTEs+17CsuO3Z1/A1Wv5+fRz",
+	"BVslDZ1exXb0unCNcK1AMYwxm1vTWMAxLq5LO9r1IV6bVsGajSyefShX1/rlX8fNb11PUV0BJYO49czB",
+	"Blfcf+Zw415OsFcwv/Ye5T818HO8e2MMOPAYsGDb3L9Z3ygeLKJwYK/XO7GUNoebxjDwOwkD53B5/rFf",
+	"0qbUfXXfgLi7kz8nhHaZiI8BnVM7l8pT4wNCAw3dyvmtvhW6qHFQqI6PmIplf+d1hhCENdsq49mYU3fj",
+	"xQRZ6R67jKzMurzikMSpWzJGTsOOnFJOcxEu2dsVs2brPzBqboce0V2MWUW74q9y0BgLjZc9NInKEjay",
+	"iMjU0LMOyJRoDejih4Sidi5/MMy3ozBQUaBrw4TnjIFgpt9aCgRTznceBGaQOw0Ac2iLRbdZ7Kdt5bBC",
+	"PxOPFRjdZHiRzb14Dsga4sgi9rO0wO8EPHlJuyaRVRGgJMN9DJiwwnhvgylCbchR5SFqY6YxBqpOmOay",
+	"S4003qrwgm9VqLL9psRBY0Expg9OFZRWEggteSI5yJ0mDizk/mwuP7B2eozWdrwEoSJr0tSFEwTQh0RJ",
+	"bGnoXXkXcIMvHi69/dcU6nOiAeRLWftJ9oNqc9Z/k1chpv/UnphIf8sC6Oyr3Sb/b3WWOf3hzTbATP/h",
+	"r0IT6iM+3QC+XNpP+ssr+6/7/x8A0rSasPyqAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -2,6 +2,7 @@ package rapi
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/mahmudindes/orenocomic-donoengine/internal/logger"
 	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
@@ -11,22 +12,29 @@ type (
 	api struct {
 		service Service
 		oauth   OAuth
+		config  Config
 		logger  logger.Logger
 	}
 
+	Config struct {
+		CacheControl map[string]string
+		BatchHandler http.Handler
+		CursorSecret []byte
+	}
+
 	Service interface {
 		AddLanguage(ctx context.Context, data model.AddLanguage, v *model.Language) error
 		GetLanguageByIETF(ctx context.Context, ietf string) (*model.Language, error)
 		UpdateLanguageByIETF(ctx context.Context, ietf string, data model.SetLanguage, v *model.Language) error
 		DeleteLanguageByIETF(ctx context.Context, ietf string) error
-		ListLanguage(ctx context.Context, params model.ListParams) ([]*model.Language, error)
+		ListLanguage(ctx context.Context, params model.ListParams) ([]*model.Language, []any, error)
 		CountLanguage(ctx context.Context, conds any) (int, error)
 
 		AddWebsite(ctx context.Context, data model.AddWebsite, v *model.Website) error
 		GetWebsiteByDomain(ctx context.Context, domain string) (*model.Website, error)
 		UpdateWebsiteByDomain(ctx context.Context, domain string, data model.SetWebsite, v *model.Website) error
 		DeleteWebsiteByDomain(ctx context.Context, domain string) error
-		ListWebsite(ctx context.Context, params model.ListParams) ([]*model.Website, error)
+		ListWebsite(ctx context.Context, params model.ListParams) ([]*model.Website, []any, error)
 		CountWebsite(ctx context.Context, conds any) (int, error)
 
 		AddCategoryType(ctx context.Context, data model.AddCategoryType, v *model.CategoryType) error
@@ -39,7 +47,8 @@ type (
 		GetCategoryBySID(ctx context.Context, sid model.CategorySID) (*model.Category, error)
 		UpdateCategoryBySID(ctx context.Context, sid model.CategorySID, data model.SetCategory, v *model.Category) error
 		DeleteCategoryBySID(ctx context.Context, sid model.CategorySID) error
-		ListCategory(ctx context.Context, params model.ListParams) ([]*model.Category, error)
+		RestoreCategoryBySID(ctx context.Context, sid model.CategorySID, v *model.Category) error
+		ListCategory(ctx context.Context, params model.ListParams) ([]*model.Category, []any, error)
 		CountCategory(ctx context.Context, conds any) (int, error)
 		AddCategoryRelation(ctx context.Context, data model.AddCategoryRelation, v *model.CategoryRelation) error
 		GetCategoryRelationBySID(ctx context.Context, sid model.CategoryRelationSID) (*model.CategoryRelation, error)
@@ -56,7 +65,8 @@ type (
 		GetTagBySID(ctx context.Context, sid model.TagSID) (*model.Tag, error)
 		UpdateTagBySID(ctx context.Context, sid model.TagSID, data model.SetTag, v *model.Tag) error
 		DeleteTagBySID(ctx context.Context, sid model.TagSID) error
-		ListTag(ctx context.Context, params model.ListParams) ([]*model.Tag, error)
+		RestoreTagBySID(ctx context.Context, sid model.TagSID, v *model.Tag) error
+		ListTag(ctx context.Context, params model.ListParams) ([]*model.Tag, []any, error)
 		CountTag(ctx context.Context, conds any) (int, error)
 
 		// Comic
@@ -64,33 +74,46 @@ type (
 		GetComicByCode(ctx context.Context, code string) (*model.Comic, error)
 		UpdateComicByCode(ctx context.Context, code string, data model.SetComic, v *model.Comic) error
 		DeleteComicByCode(ctx context.Context, code string) error
-		ListComic(ctx context.Context, params model.ListParams) ([]*model.Comic, error)
+		RestoreComicByCode(ctx context.Context, code string, v *model.Comic) error
+		ListComic(ctx context.Context, params model.ListParams) ([]*model.Comic, []any, error)
 		CountComic(ctx context.Context, conds any) (int, error)
 		ExistsComicByCode(ctx context.Context, code string) (bool, error)
 		AddComicTitle(ctx context.Context, data model.AddComicTitle, v *model.ComicTitle) error
 		GetComicTitleBySID(ctx context.Context, sid model.ComicGenericSID) (*model.ComicTitle, error)
 		UpdateComicTitleBySID(ctx context.Context, sid model.ComicGenericSID, data model.SetComicTitle, v *model.ComicTitle) error
 		DeleteComicTitleBySID(ctx context.Context, sid model.ComicGenericSID) error
+		ListComicTitle(ctx context.Context, params model.ListParams) ([]*model.ComicTitle, error)
+		CountComicTitle(ctx context.Context, conds any) (int, error)
 		AddComicCover(ctx context.Context, data model.AddComicCover, v *model.ComicCover) error
 		GetComicCoverBySID(ctx context.Context, sid model.ComicGenericSID) (*model.ComicCover, error)
 		UpdateComicCoverBySID(ctx context.Context, sid model.ComicGenericSID, data model.SetComicCover, v *model.ComicCover) error
 		DeleteComicCoverBySID(ctx context.Context, sid model.ComicGenericSID) error
+		ListComicCover(ctx context.Context, params model.ListParams) ([]*model.ComicCover, error)
+		CountComicCover(ctx context.Context, conds any) (int, error)
 		AddComicSynopsis(ctx context.Context, data model.AddComicSynopsis, v *model.ComicSynopsis) error
 		GetComicSynopsisBySID(ctx context.Context, sid model.ComicGenericSID) (*model.ComicSynopsis, error)
 		UpdateComicSynopsisBySID(ctx context.Context, sid model.ComicGenericSID, data model.SetComicSynopsis, v *model.ComicSynopsis) error
 		DeleteComicSynopsisBySID(ctx context.Context, sid model.ComicGenericSID) error
+		ListComicSynopsis(ctx context.Context, params model.ListParams) ([]*model.ComicSynopsis, error)
+		CountComicSynopsis(ctx context.Context, conds any) (int, error)
 		AddComicExternal(ctx context.Context, data model.AddComicExternal, v *model.ComicExternal) error
 		GetComicExternalBySID(ctx context.Context, sid model.ComicGenericSID) (*model.ComicExternal, error)
 		UpdateComicExternalBySID(ctx context.Context, sid model.ComicGenericSID, data model.SetComicExternal, v *model.ComicExternal) error
 		DeleteComicExternalBySID(ctx context.Context, sid model.ComicGenericSID) error
+		ListComicExternal(ctx context.Context, params model.ListParams) ([]*model.ComicExternal, error)
+		CountComicExternal(ctx context.Context, conds any) (int, error)
 		AddComicCategory(ctx context.Context, data model.AddComicCategory, v *model.ComicCategory) error
 		GetComicCategoryBySID(ctx context.Context, sid model.ComicCategorySID) (*model.ComicCategory, error)
 		UpdateComicCategoryBySID(ctx context.Context, sid model.ComicCategorySID, data model.SetComicCategory, v *model.ComicCategory) error
 		DeleteComicCategoryBySID(ctx context.Context, sid model.ComicCategorySID) error
+		ListComicCategory(ctx context.Context, params model.ListParams) ([]*model.ComicCategory, error)
+		CountComicCategory(ctx context.Context, conds any) (int, error)
 		AddComicTag(ctx context.Context, data model.AddComicTag, v *model.ComicTag) error
 		GetComicTagBySID(ctx context.Context, sid model.ComicTagSID) (*model.ComicTag, error)
 		UpdateComicTagBySID(ctx context.Context, sid model.ComicTagSID, data model.SetComicTag, v *model.ComicTag) error
 		DeleteComicTagBySID(ctx context.Context, sid model.ComicTagSID) error
+		ListComicTag(ctx context.Context, params model.ListParams) ([]*model.ComicTag, error)
+		CountComicTag(ctx context.Context, conds any) (int, error)
 		AddComicRelationType(ctx context.Context, data model.AddComicRelationType, v *model.ComicRelationType) error
 		GetComicRelationTypeByCode(ctx context.Context, code string) (*model.ComicRelationType, error)
 		UpdateComicRelationTypeByCode(ctx context.Context, code string, data model.SetComicRelationType, v *model.ComicRelationType) error
@@ -101,13 +124,37 @@ type (
 		GetComicRelationBySID(ctx context.Context, sid model.ComicRelationSID) (*model.ComicRelation, error)
 		UpdateComicRelationBySID(ctx context.Context, sid model.ComicRelationSID, data model.SetComicRelation, v *model.ComicRelation) error
 		DeleteComicRelationBySID(ctx context.Context, sid model.ComicRelationSID) error
+		ListComicRelation(ctx context.Context, params model.ListParams) ([]*model.ComicRelation, error)
+		CountComicRelation(ctx context.Context, conds any) (int, error)
 		// Comic Chapter
 		AddComicChapter(ctx context.Context, data model.AddComicChapter, v *model.ComicChapter) error
 		GetComicChapterBySID(ctx context.Context, sid model.ComicChapterSID) (*model.ComicChapter, error)
 		UpdateComicChapterBySID(ctx context.Context, sid model.ComicChapterSID, data model.SetComicChapter, v *model.ComicChapter) error
 		DeleteComicChapterBySID(ctx context.Context, sid model.ComicChapterSID) error
-		ListComicChapter(ctx context.Context, params model.ListParams) ([]*model.ComicChapter, error)
+		ListComicChapter(ctx context.Context, params model.ListParams) ([]*model.ComicChapter, []any, error)
 		CountComicChapter(ctx context.Context, conds any) (int, error)
+		// Trash
+		ListTrash(ctx context.Context, params model.ListParams) ([]*model.Trash, error)
+		CountTrash(ctx context.Context, conds any) (int, error)
+		// Audit
+		ListAudit(ctx context.Context, params model.ListParams) ([]*model.Audit, error)
+		CountAudit(ctx context.Context, conds any) (int, error)
+		// API Key
+		AddAPIKey(ctx context.Context, data model.AddAPIKey, v *model.APIKey) (string, error)
+		DeleteAPIKeyByID(ctx context.Context, id uint) error
+		ListAPIKey(ctx context.Context, params model.ListParams) ([]*model.APIKey, error)
+		CountAPIKey(ctx context.Context, conds any) (int, error)
+		ProcessAPIKeyContext(ctx context.Context) (bool, error)
+		// JSON Schema
+		GetJSONSchema(ctx context.Context, name string) (*model.JSONSchema, error)
+		SetJSONSchema(ctx context.Context, data model.AddJSONSchema, v *model.JSONSchema) error
+		DeleteJSONSchema(ctx context.Context, name string) error
+		// Transaction
+		ContextTransactionBegin(ctx context.Context) (context.Context, error)
+		ContextTransactionCommit(ctx context.Context) error
+		ContextTransactionRollback(ctx context.Context) error
+		// Precondition
+		ContextPrecondition(ctx context.Context, check func(current any) bool) context.Context
 	}
 
 	OAuth interface {
@@ -116,10 +163,13 @@ type (
 	}
 )
 
-const SecuritySchemeBearerAuth = "BearerAuth"
+const (
+	SecuritySchemeBearerAuth = "BearerAuth"
+	SecuritySchemeApiKey     = "ApiKey"
+)
 
 var _ ServerInterface = (*api)(nil)
 
-func NewAPI(svc Service, oa OAuth, log logger.Logger) *api {
-	return &api{service: svc, oauth: oa, logger: log}
+func NewAPI(svc Service, oa OAuth, cfg Config, log logger.Logger) *api {
+	return &api{service: svc, oauth: oa, config: cfg, logger: log}
 }
@@ -0,0 +1,40 @@
+package rapi
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/controller/chttp/utilb"
+)
+
+func (api *api) DryRun(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost, http.MethodPatch, http.MethodDelete:
+		default:
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		dryRun := utilb.HeaderPrefer(r.Header, "dry-run")
+		if v := r.URL.Query().Get("dryRun"); v != "" {
+			dryRun, _ = strconv.ParseBool(v)
+		}
+		if !dryRun {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx, err := api.service.ContextTransactionBegin(r.Context())
+		if err != nil {
+			responseServiceErr(w, err)
+			api.logger.WithContext(r.Context()).ErrMessage(err, "Dry run begin transaction failed.")
+			return
+		}
+		defer api.service.ContextTransactionRollback(context.WithoutCancel(ctx))
+
+		w.Header().Set("Preference-Applied", "dry-run")
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
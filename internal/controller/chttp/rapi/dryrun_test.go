@@ -0,0 +1,91 @@
+package rapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/testsupport"
+)
+
+func checkDryRun(applied bool) func(t *testing.T, res *http.Response, body []byte) {
+	return func(t *testing.T, res *http.Response, body []byte) {
+		got := res.Header.Get("Preference-Applied")
+		if applied && got != "dry-run" || !applied && got != "" {
+			t.Errorf("preference applied: expected %v got %q", applied, got)
+		}
+	}
+}
+
+func checkName(name string) func(t *testing.T, res *http.Response, body []byte) {
+	return func(t *testing.T, res *http.Response, body []byte) {
+		var data map[string]any
+		if err := json.Unmarshal(body, &data); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if data["name"] != name {
+			t.Errorf("name: expected %s got %v", name, data["name"])
+		}
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	svr := testsupport.StartServer(t, testsupport.Config{})
+	language := svr.PermissionToken(t, "language.write")
+	comic := svr.PermissionToken(t, "comic.write")
+	prefer := http.Header{"Prefer": {"dry-run"}}
+
+	svr.Run(t, []testsupport.Case{
+		{Name: "add", Method: http.MethodPost, Path: "/api/v0/languages", Token: language, Header: prefer,
+			Body: map[string]any{"ietf": "en", "name": "English"}, Status: http.StatusCreated,
+			Check: func(t *testing.T, res *http.Response, body []byte) {
+				checkDryRun(true)(t, res, body)
+				checkName("English")(t, res, body)
+				if loc := res.Header.Get("Location"); loc != "/api/v0/languages/en" {
+					t.Errorf("location: expected /api/v0/languages/en got %s", loc)
+				}
+			}},
+		{Name: "add not persisted", Method: http.MethodGet, Path: "/api/v0/languages/en", Status: http.StatusNotFound},
+		{Name: "add query", Method: http.MethodPost, Path: "/api/v0/languages?dryRun=true", Token: language,
+			Body: map[string]any{"ietf": "en", "name": "English"}, Status: http.StatusCreated, Check: checkDryRun(true)},
+		{Name: "add query not persisted", Method: http.MethodGet, Path: "/api/v0/languages/en", Status: http.StatusNotFound},
+		{Name: "add among preferences", Method: http.MethodPost, Path: "/api/v0/languages", Token: language,
+			Header: http.Header{"Prefer": {"return=minimal, Dry-Run"}},
+			Body:   map[string]any{"ietf": "en", "name": "English"}, Status: http.StatusCreated, Check: checkDryRun(true)},
+		{Name: "add preferences not persisted", Method: http.MethodGet, Path: "/api/v0/languages/en", Status: http.StatusNotFound},
+		{Name: "add query disabled", Method: http.MethodPost, Path: "/api/v0/languages?dryRun=false", Token: language,
+			Header: prefer, Body: map[string]any{"ietf": "en", "name": "English"}, Status: http.StatusCreated,
+			Check: checkDryRun(false)},
+		{Name: "add persisted", Method: http.MethodGet, Path: "/api/v0/languages/en", Status: http.StatusOK,
+			Check: checkDryRun(false)},
+
+		{Name: "add invalid", Method: http.MethodPost, Path: "/api/v0/languages", Token: language, Header: prefer,
+			Body: map[string]any{"ietf": "en", "name": "English"}, Status: http.StatusBadRequest,
+			Check: func(t *testing.T, res *http.Response, body []byte) {
+				checkDryRun(true)(t, res, body)
+				checkError("Same ietf already exists.")(t, res, body)
+			}},
+		{Name: "add without permission", Method: http.MethodPost, Path: "/api/v0/languages", Token: comic, Header: prefer,
+			Body: map[string]any{"ietf": "id", "name": "Indonesian"}, Status: http.StatusBadRequest},
+		{Name: "add lookup", Method: http.MethodPost, Path: "/api/v0/comics", Token: comic, Header: prefer,
+			Body: map[string]any{"code": "abcdefgh", "languageIETF": "en"}, Status: http.StatusCreated,
+			Check: checkDryRun(true)},
+		{Name: "add lookup not persisted", Method: http.MethodGet, Path: "/api/v0/comics/abcdefgh", Status: http.StatusNotFound},
+		{Name: "add lookup unknown", Method: http.MethodPost, Path: "/api/v0/comics", Token: comic, Header: prefer,
+			Body: map[string]any{"code": "abcdefgh", "languageIETF": "xx"}, Status: http.StatusBadRequest,
+			Check: checkError("language data is not valid.")},
+
+		{Name: "update", Method: http.MethodPatch, Path: "/api/v0/languages/en", Token: language, Header: prefer,
+			Body: map[string]any{"name": "British English"}, Status: http.StatusOK,
+			Check: func(t *testing.T, res *http.Response, body []byte) {
+				checkDryRun(true)(t, res, body)
+				checkName("British English")(t, res, body)
+			}},
+		{Name: "update not persisted", Method: http.MethodGet, Path: "/api/v0/languages/en", Status: http.StatusOK,
+			Check: checkName("English")},
+		{Name: "delete", Method: http.MethodDelete, Path: "/api/v0/languages/en", Token: language, Header: prefer,
+			Status: http.StatusNoContent, Check: checkDryRun(true)},
+		{Name: "delete not persisted", Method: http.MethodGet, Path: "/api/v0/languages/en", Header: prefer,
+			Status: http.StatusOK, Check: checkDryRun(false)},
+	})
+}
@@ -0,0 +1,109 @@
+package rapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+func modelAPIKey(m *model.APIKey) APIKey {
+	return APIKey{
+		ID:          m.ID,
+		Name:        m.Name,
+		Prefix:      m.Prefix,
+		Permissions: m.Permissions,
+		ExpiresAt:   m.ExpiresAt,
+		LastUsedAt:  m.LastUsedAt,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+func (api *api) ListAPIKey(w http.ResponseWriter, r *http.Request, params ListAPIKeyParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	pagination := model.Pagination{Page: 1, Limit: model.APIKeyPaginationDef}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+
+	totalCountCh := make(chan int, 1)
+	go func() {
+		count, err := api.service.CountAPIKey(ctx, nil)
+		if err != nil {
+			totalCountCh <- -1
+			log.ErrMessage(err, "Count api key failed.")
+			return
+		}
+		totalCountCh <- count
+	}()
+
+	result0, err := api.service.ListAPIKey(ctx, model.ListParams{
+		Pagination: &pagination,
+	})
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "List api key failed.")
+		return
+	}
+
+	totalCount := <-totalCountCh
+	wHeader := w.Header()
+	wHeader.Set("X-Total-Count", strconv.Itoa(totalCount))
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	if link := paginationLink(r, pagination, totalCount, ""); link != "" {
+		wHeader.Set("Link", link)
+	}
+	result := []APIKey{}
+	for _, r := range result0 {
+		result = append(result, modelAPIKey(r))
+	}
+	response(w, result, http.StatusOK)
+}
+
+func (api *api) AddAPIKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	var data0 AddAPIKeyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&data0); err != nil {
+		responseErr(w, "Bad request body.", http.StatusBadRequest)
+		log.ErrMessage(err, "Add api key decode json body failed.")
+		return
+	}
+
+	result := new(model.APIKey)
+	secret, err := api.service.AddAPIKey(ctx, model.AddAPIKey{
+		Name:        data0.Name,
+		Permissions: data0.Permissions,
+		ExpiresAt:   data0.ExpiresAt,
+	}, result)
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Add api key failed.")
+		return
+	}
+
+	w.Header().Set("Location", r.URL.Path+"/"+strconv.FormatUint(uint64(result.ID), 10))
+	w.Header().Set("Cache-Control", "no-store")
+	response(w, APIKeySecret{APIKey: modelAPIKey(result), Secret: secret}, http.StatusCreated)
+}
+
+func (api *api) DeleteAPIKey(w http.ResponseWriter, r *http.Request, id uint) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	if err := api.service.DeleteAPIKeyByID(ctx, id); err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Delete api key failed.")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
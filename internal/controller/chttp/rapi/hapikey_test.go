@@ -0,0 +1,18 @@
+package rapi_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/testsupport"
+)
+
+func TestListAPIKeyEmpty(t *testing.T) {
+	svr := testsupport.StartServer(t, testsupport.Config{})
+	admin := svr.PermissionToken(t, "admin")
+
+	svr.Run(t, []testsupport.Case{
+		{Name: "list", Method: http.MethodGet, Path: "/api/v0/api-keys", Token: admin,
+			Status: http.StatusOK, Check: checkEmptyList},
+	})
+}
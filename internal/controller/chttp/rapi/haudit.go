@@ -0,0 +1,90 @@
+package rapi
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+func modelAudit(m *model.Audit) Audit {
+	return Audit{
+		ID:        m.ID,
+		Actor:     m.Actor,
+		Action:    m.Action,
+		Entity:    m.Entity,
+		Key:       m.EntityKey,
+		Before:    m.DataBefore,
+		After:     m.DataAfter,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+func (api *api) ListAudit(w http.ResponseWriter, r *http.Request, params ListAuditParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	pagination := model.Pagination{Page: 1, Limit: model.AuditPaginationDef}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+
+	conditions := map[string]any{}
+	if params.Actor != nil {
+		conditions[model.DBAuditActor] = *params.Actor
+	}
+	if params.Action != nil {
+		conditions[model.DBAuditAction] = *params.Action
+	}
+	if params.Entity != nil {
+		conditions[model.DBAuditEntity] = *params.Entity
+	}
+	if params.Key != nil {
+		conditions[model.DBAuditEntityKey] = *params.Key
+	}
+	switch {
+	case params.Since != nil && params.Until != nil:
+		conditions[model.DBGenericCreatedAt] = model.DBBetween{From: *params.Since, To: *params.Until}
+	case params.Since != nil:
+		conditions[model.DBGenericCreatedAt] = model.DBGreaterOrEqual{Value: *params.Since}
+	case params.Until != nil:
+		conditions[model.DBGenericCreatedAt] = model.DBLessOrEqual{Value: *params.Until}
+	}
+
+	totalCountCh := make(chan int, 1)
+	go func() {
+		count, err := api.service.CountAudit(ctx, conditions)
+		if err != nil {
+			totalCountCh <- -1
+			log.ErrMessage(err, "Count audit failed.")
+			return
+		}
+		totalCountCh <- count
+	}()
+
+	result0, err := api.service.ListAudit(ctx, model.ListParams{
+		Conditions: conditions,
+		Pagination: &pagination,
+	})
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "List audit failed.")
+		return
+	}
+
+	totalCount := <-totalCountCh
+	wHeader := w.Header()
+	wHeader.Set("X-Total-Count", strconv.Itoa(totalCount))
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	if link := paginationLink(r, pagination, totalCount, ""); link != "" {
+		wHeader.Set("Link", link)
+	}
+	result := []Audit{}
+	for _, r := range result0 {
+		result = append(result, modelAudit(r))
+	}
+	response(w, result, http.StatusOK)
+}
@@ -0,0 +1,44 @@
+package rapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/testsupport"
+)
+
+func TestListAuditEmpty(t *testing.T) {
+	svr := testsupport.StartServer(t, testsupport.Config{})
+	admin := svr.PermissionToken(t, "admin")
+
+	svr.Run(t, []testsupport.Case{
+		{Name: "list", Method: http.MethodGet, Path: "/api/v0/audit", Token: admin,
+			Status: http.StatusOK, Check: checkEmptyList},
+	})
+}
+
+func TestListAuditActor(t *testing.T) {
+	svr := testsupport.StartServer(t, testsupport.Config{})
+	admin := svr.PermissionToken(t, "admin")
+	language := svr.PermissionToken(t, "language.write")
+	actor := svr.Issuer.URL() + "#testsupport"
+
+	svr.Run(t, []testsupport.Case{
+		{Name: "add language", Method: http.MethodPost, Path: "/api/v0/languages", Token: language,
+			Body: map[string]any{"ietf": "en", "name": "English"}, Status: http.StatusCreated},
+		{Name: "list", Method: http.MethodGet, Path: "/api/v0/audit?actor=" + url.QueryEscape(actor), Token: admin,
+			Status: http.StatusOK, Check: func(t *testing.T, res *http.Response, body []byte) {
+				var data []map[string]any
+				if err := json.Unmarshal(body, &data); err != nil {
+					t.Fatalf("decode body: %v", err)
+				}
+				if len(data) != 1 || data[0]["actor"] != actor {
+					t.Errorf("audit: expected one by %s got %v", actor, data)
+				}
+			}},
+		{Name: "list bare subject", Method: http.MethodGet, Path: "/api/v0/audit?actor=testsupport", Token: admin,
+			Status: http.StatusOK, Check: checkEmptyList},
+	})
+}
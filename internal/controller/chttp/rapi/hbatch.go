@@ -0,0 +1,203 @@
+package rapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	batchModeAllOrNothing = "all-or-nothing"
+	batchModeBestEffort   = "best-effort"
+	batchOperationMax     = 100
+)
+
+var batchResultHeaders = []string{"Location", "ETag", "Last-Modified"}
+
+type batchResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *batchResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *batchResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *batchResponseWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.body.Write(b)
+}
+
+func (api *api) Batch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	var data BatchJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+		responseErr(w, "Bad request body.", http.StatusBadRequest)
+		log.ErrMessage(err, "Batch decode json body failed.")
+		return
+	}
+
+	mode := batchModeAllOrNothing
+	if data.Mode != nil {
+		mode = *data.Mode
+	}
+	switch mode {
+	case batchModeAllOrNothing, batchModeBestEffort:
+	default:
+		responseErr(w, "Batch mode must be all-or-nothing or best-effort.", http.StatusBadRequest)
+		return
+	}
+
+	switch {
+	case len(data.Operations) < 1:
+		responseErr(w, "Batch operations cannot be empty.", http.StatusBadRequest)
+		return
+	case len(data.Operations) > batchOperationMax:
+		responseErr(w, "Batch operations must be at most "+strconv.Itoa(batchOperationMax)+".", http.StatusBadRequest)
+		return
+	}
+	for i, op := range data.Operations {
+		switch op.Method {
+		case http.MethodPost, http.MethodPatch, http.MethodDelete:
+		default:
+			responseErr(w, "Batch operation "+strconv.Itoa(i)+" method must be POST, PATCH or DELETE.", http.StatusBadRequest)
+			return
+		}
+		if !strings.HasPrefix(op.Path, "/") || strings.HasPrefix(op.Path, "/batch") {
+			responseErr(w, "Batch operation "+strconv.Itoa(i)+" path is not valid.", http.StatusBadRequest)
+			return
+		}
+	}
+
+	txCtx, err := api.service.ContextTransactionBegin(ctx)
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Batch begin transaction failed.")
+		return
+	}
+	defer api.service.ContextTransactionRollback(context.WithoutCancel(txCtx))
+
+	base := strings.TrimSuffix(r.URL.Path, "/batch")
+	result := Batch{Mode: mode, Results: make([]BatchResult, 0, len(data.Operations))}
+	failed := false
+	for _, op := range data.Operations {
+		if failed {
+			result.Results = append(result.Results, BatchResult{Status: http.StatusFailedDependency})
+			continue
+		}
+
+		if mode == batchModeAllOrNothing {
+			res := api.batchOperation(txCtx, r, base, op)
+			failed = res.Status >= http.StatusBadRequest
+			result.Results = append(result.Results, res)
+			continue
+		}
+
+		opCtx, err := api.service.ContextTransactionBegin(txCtx)
+		if err != nil {
+			responseServiceErr(w, err)
+			log.ErrMessage(err, "Batch begin operation transaction failed.")
+			return
+		}
+		res := api.batchOperation(opCtx, r, base, op)
+		switch {
+		case res.Status >= http.StatusBadRequest:
+			if err := api.service.ContextTransactionRollback(opCtx); err != nil {
+				responseServiceErr(w, err)
+				log.ErrMessage(err, "Batch rollback operation transaction failed.")
+				return
+			}
+		default:
+			if err := api.service.ContextTransactionCommit(opCtx); err != nil {
+				responseServiceErr(w, err)
+				log.ErrMessage(err, "Batch commit operation transaction failed.")
+				return
+			}
+		}
+		result.Results = append(result.Results, res)
+	}
+
+	if !failed {
+		if err := api.service.ContextTransactionCommit(txCtx); err != nil {
+			responseServiceErr(w, err)
+			log.ErrMessage(err, "Batch commit transaction failed.")
+			return
+		}
+		result.Committed = true
+	}
+
+	response(w, result, http.StatusOK)
+}
+
+func (api *api) batchOperation(ctx context.Context, r *http.Request, base string, op BatchOperation) BatchResult {
+	rec := &batchResponseWriter{header: http.Header{}}
+
+	var body io.Reader
+	if op.Body != nil {
+		b, err := json.Marshal(op.Body)
+		if err != nil {
+			responseErr(rec, "Bad batch operation body.", http.StatusBadRequest)
+			return batchResult(rec)
+		}
+		body = bytes.NewReader(b)
+	}
+
+	// A fresh route context lets the handler route the operation from the top.
+	ctx = context.WithValue(ctx, chi.RouteCtxKey, (*chi.Context)(nil))
+	req, err := http.NewRequestWithContext(ctx, op.Method, base+op.Path, body)
+	if err != nil {
+		responseErr(rec, "Bad batch operation path.", http.StatusBadRequest)
+		return batchResult(rec)
+	}
+	for key, val := range op.Headers {
+		req.Header.Set(key, val)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		req.Header.Set("Authorization", auth)
+	}
+	if key := r.Header.Get("X-API-Key"); key != "" {
+		req.Header.Set("X-API-Key", key)
+	}
+
+	api.config.BatchHandler.ServeHTTP(rec, req)
+	return batchResult(rec)
+}
+
+func batchResult(rec *batchResponseWriter) BatchResult {
+	result := BatchResult{Status: rec.status}
+	if result.Status == 0 {
+		result.Status = http.StatusOK
+	}
+	for _, key := range batchResultHeaders {
+		if val := rec.header.Get(key); val != "" {
+			if result.Headers == nil {
+				result.Headers = map[string]string{}
+			}
+			result.Headers[key] = val
+		}
+	}
+	if b := rec.body.Bytes(); len(b) > 0 && json.Valid(b) {
+		result.Body = json.RawMessage(b)
+	}
+	return result
+}
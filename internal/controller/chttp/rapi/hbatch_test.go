@@ -0,0 +1,133 @@
+package rapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/testsupport"
+)
+
+type batchResult struct {
+	Committed bool   `json:"committed"`
+	Mode      string `json:"mode"`
+	Results   []struct {
+		Status  int               `json:"status"`
+		Headers map[string]string `json:"headers"`
+		Body    map[string]any    `json:"body"`
+	} `json:"results"`
+}
+
+func checkBatch(committed bool, statuses ...int) func(t *testing.T, res *http.Response, body []byte) {
+	return func(t *testing.T, res *http.Response, body []byte) {
+		var data batchResult
+		if err := json.Unmarshal(body, &data); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if data.Committed != committed {
+			t.Errorf("committed: expected %v got %v", committed, data.Committed)
+		}
+		if len(data.Results) != len(statuses) {
+			t.Fatalf("results: expected %d got %d", len(statuses), len(data.Results))
+		}
+		for i, status := range statuses {
+			if data.Results[i].Status != status {
+				t.Errorf("result %d status: expected %d got %d", i, status, data.Results[i].Status)
+			}
+		}
+	}
+}
+
+func batchAddLanguage(ietf, name string) map[string]any {
+	return map[string]any{
+		"method": http.MethodPost,
+		"path":   "/languages",
+		"body":   map[string]any{"ietf": ietf, "name": name},
+	}
+}
+
+func TestBatch(t *testing.T) {
+	svr := testsupport.StartServer(t, testsupport.Config{})
+	write := svr.PermissionToken(t, "language.write")
+	other := svr.PermissionToken(t, "website.write")
+
+	svr.Run(t, []testsupport.Case{
+		{Name: "anonymous", Method: http.MethodPost, Path: "/api/v0/batch",
+			Body:   map[string]any{"operations": []any{batchAddLanguage("en", "English")}},
+			Status: http.StatusUnauthorized},
+		{Name: "empty", Method: http.MethodPost, Path: "/api/v0/batch", Token: write,
+			Body: map[string]any{"operations": []any{}}, Status: http.StatusBadRequest,
+			Check: checkError("Batch operations cannot be empty.")},
+		{Name: "unknown mode", Method: http.MethodPost, Path: "/api/v0/batch", Token: write,
+			Body:   map[string]any{"mode": "some", "operations": []any{batchAddLanguage("en", "English")}},
+			Status: http.StatusBadRequest, Check: checkError("Batch mode must be all-or-nothing or best-effort.")},
+		{Name: "read operation", Method: http.MethodPost, Path: "/api/v0/batch", Token: write,
+			Body:   map[string]any{"operations": []any{map[string]any{"method": http.MethodGet, "path": "/languages"}}},
+			Status: http.StatusBadRequest, Check: checkError("Batch operation 0 method must be POST, PATCH or DELETE.")},
+		{Name: "nested batch", Method: http.MethodPost, Path: "/api/v0/batch", Token: write,
+			Body:   map[string]any{"operations": []any{map[string]any{"method": http.MethodPost, "path": "/batch"}}},
+			Status: http.StatusBadRequest, Check: checkError("Batch operation 0 path is not valid.")},
+
+		{Name: "all or nothing", Method: http.MethodPost, Path: "/api/v0/batch", Token: write,
+			Body: map[string]any{"operations": []any{
+				batchAddLanguage("en", "English"),
+				batchAddLanguage("id", "Indonesian"),
+			}},
+			Status: http.StatusOK, Check: func(t *testing.T, res *http.Response, body []byte) {
+				checkBatch(true, http.StatusCreated, http.StatusCreated)(t, res, body)
+				var data batchResult
+				if err := json.Unmarshal(body, &data); err != nil {
+					t.Fatalf("decode body: %v", err)
+				}
+				if data.Mode != "all-or-nothing" {
+					t.Errorf("mode: expected all-or-nothing got %s", data.Mode)
+				}
+				if loc := data.Results[0].Headers["Location"]; loc != "/api/v0/languages/en" {
+					t.Errorf("location: expected /api/v0/languages/en got %s", loc)
+				}
+				if data.Results[1].Body["ietf"] != "id" {
+					t.Errorf("body: expected ietf id got %v", data.Results[1].Body)
+				}
+			}},
+		{Name: "all or nothing committed", Method: http.MethodGet, Path: "/api/v0/languages/id", Status: http.StatusOK},
+
+		{Name: "all or nothing failure", Method: http.MethodPost, Path: "/api/v0/batch", Token: write,
+			Body: map[string]any{"operations": []any{
+				batchAddLanguage("ja", "Japanese"),
+				batchAddLanguage("en", "English"),
+				batchAddLanguage("ko", "Korean"),
+			}},
+			Status: http.StatusOK, Check: checkBatch(false, http.StatusCreated, http.StatusBadRequest, http.StatusFailedDependency)},
+		{Name: "all or nothing rolled back", Method: http.MethodGet, Path: "/api/v0/languages/ja", Status: http.StatusNotFound},
+		{Name: "all or nothing skipped", Method: http.MethodGet, Path: "/api/v0/languages/ko", Status: http.StatusNotFound},
+
+		{Name: "best effort", Method: http.MethodPost, Path: "/api/v0/batch", Token: write,
+			Body: map[string]any{"mode": "best-effort", "operations": []any{
+				batchAddLanguage("ja", "Japanese"),
+				batchAddLanguage("en", "English"),
+				map[string]any{"method": http.MethodPatch, "path": "/languages/id", "body": map[string]any{"name": "Bahasa Indonesia"}},
+				map[string]any{"method": http.MethodDelete, "path": "/languages/en"},
+			}},
+			Status: http.StatusOK, Check: checkBatch(true, http.StatusCreated, http.StatusBadRequest, http.StatusOK, http.StatusNoContent)},
+		{Name: "best effort added", Method: http.MethodGet, Path: "/api/v0/languages/ja", Status: http.StatusOK},
+		{Name: "best effort updated", Method: http.MethodGet, Path: "/api/v0/languages/id", Status: http.StatusOK,
+			Check: func(t *testing.T, res *http.Response, body []byte) {
+				var data map[string]any
+				if err := json.Unmarshal(body, &data); err != nil {
+					t.Fatalf("decode body: %v", err)
+				}
+				if data["name"] != "Bahasa Indonesia" {
+					t.Errorf("name: expected Bahasa Indonesia got %v", data["name"])
+				}
+			}},
+		{Name: "best effort deleted", Method: http.MethodGet, Path: "/api/v0/languages/en", Status: http.StatusNotFound},
+
+		{Name: "operation permission", Method: http.MethodPost, Path: "/api/v0/batch", Token: other,
+			Body: map[string]any{"mode": "best-effort", "operations": []any{
+				batchAddLanguage("ko", "Korean"),
+				map[string]any{"method": http.MethodPost, "path": "/websites", "body": map[string]any{"domain": "example.com", "name": "Example"}},
+			}},
+			Status: http.StatusOK, Check: checkBatch(true, http.StatusBadRequest, http.StatusCreated)},
+		{Name: "operation permission denied", Method: http.MethodGet, Path: "/api/v0/languages/ko", Status: http.StatusNotFound},
+	})
+}
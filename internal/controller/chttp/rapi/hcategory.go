@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
 	"github.com/mahmudindes/orenocomic-donoengine/internal/utila"
@@ -70,7 +71,7 @@ func (api *api) AddCategory(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.Header().Set("Location", r.URL.Path+"/"+utila.Utoa(result.TypeID)+"-"+result.Code)
-	response(w, modelCategory(result), http.StatusCreated)
+	responseEntity(w, modelCategory(result), http.StatusCreated)
 }
 
 func (api *api) GetCategory(w http.ResponseWriter, r *http.Request, typeID uint, code string) {
@@ -87,7 +88,8 @@ func (api *api) GetCategory(w http.ResponseWriter, r *http.Request, typeID uint,
 		return
 	}
 
-	response(w, modelCategory(result), http.StatusOK)
+	// Hydrated children do not touch updated_at, so only the ETag is reliable here.
+	api.responseCached(w, r, cacheCategory, modelCategory(result), time.Time{})
 }
 
 func (api *api) UpdateCategory(w http.ResponseWriter, r *http.Request, typeID uint, code string) {
@@ -129,6 +131,11 @@ func (api *api) UpdateCategory(w http.ResponseWriter, r *http.Request, typeID ui
 		}
 	}
 
+	ctx = api.precondition(r, false, func(v any) (string, time.Time) {
+		current := v.(*model.Category)
+		return entityTag(modelCategory(current)), lastModified(current.CreatedAt, current.UpdatedAt)
+	})
+
 	result := new(model.Category)
 	if err := api.service.UpdateCategoryBySID(ctx, model.CategorySID{
 		TypeID: &typeID,
@@ -145,13 +152,18 @@ func (api *api) UpdateCategory(w http.ResponseWriter, r *http.Request, typeID ui
 	}
 
 	w.Header().Set("Location", r.URL.Path+"/"+utila.Utoa(result.TypeID)+"-"+result.Code)
-	response(w, modelCategory(result), http.StatusOK)
+	responseEntity(w, modelCategory(result), http.StatusOK)
 }
 
 func (api *api) DeleteCategory(w http.ResponseWriter, r *http.Request, typeID uint, code string) {
 	ctx := r.Context()
 	log := api.logger.WithContext(ctx)
 
+	ctx = api.precondition(r, false, func(v any) (string, time.Time) {
+		current := v.(*model.Category)
+		return entityTag(modelCategory(current)), lastModified(current.CreatedAt, current.UpdatedAt)
+	})
+
 	if err := api.service.DeleteCategoryBySID(ctx, model.CategorySID{
 		TypeID: &typeID,
 		Code:   code,
@@ -164,6 +176,23 @@ func (api *api) DeleteCategory(w http.ResponseWriter, r *http.Request, typeID ui
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func (api *api) RestoreCategory(w http.ResponseWriter, r *http.Request, typeID uint, code string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	result := new(model.Category)
+	if err := api.service.RestoreCategoryBySID(ctx, model.CategorySID{
+		TypeID: &typeID,
+		Code:   code,
+	}, result); err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Restore category failed.")
+		return
+	}
+
+	responseEntity(w, modelCategory(result), http.StatusOK)
+}
+
 func (api *api) ListCategory(w http.ResponseWriter, r *http.Request, params ListCategoryParams) {
 	ctx := r.Context()
 	log := api.logger.WithContext(ctx)
@@ -176,6 +205,16 @@ func (api *api) ListCategory(w http.ResponseWriter, r *http.Request, params List
 		pagination.Limit = *params.Limit
 	}
 
+	if params.Cursor != nil {
+		cursor, err := api.decodeCursor(*params.Cursor)
+		if err != nil {
+			responseErr(w, "Bad pagination cursor.", http.StatusBadRequest)
+			log.ErrMessage(err, "List category decode cursor failed.")
+			return
+		}
+		pagination.Cursor = cursor
+	}
+
 	var orderBys model.OrderBys
 	if params.OrderBy != nil {
 		orderBys = queryOrderBys(*params.OrderBy)
@@ -192,7 +231,7 @@ func (api *api) ListCategory(w http.ResponseWriter, r *http.Request, params List
 		totalCountCh <- count
 	}()
 
-	result0, err := api.service.ListCategory(ctx, model.ListParams{
+	result0, next, err := api.service.ListCategory(ctx, model.ListParams{
 		OrderBys:   orderBys,
 		Pagination: &pagination,
 	})
@@ -202,13 +241,28 @@ func (api *api) ListCategory(w http.ResponseWriter, r *http.Request, params List
 		return
 	}
 
+	totalCount := <-totalCountCh
 	wHeader := w.Header()
-	wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	wHeader.Set("X-Total-Count", strconv.Itoa(totalCount))
 	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	nextCursor := ""
+	if next != nil {
+		cursor, err := api.encodeCursor(next)
+		if err != nil {
+			log.ErrMessage(err, "List category encode cursor failed.")
+		} else {
+			nextCursor = cursor
+			wHeader.Set("X-Pagination-Next-Cursor", cursor)
+		}
+	}
+	if link := paginationLink(r, pagination, totalCount, nextCursor); link != "" {
+		wHeader.Set("Link", link)
+	}
 	var result []Category
 	for _, r := range result0 {
 		result = append(result, modelCategory(r))
 	}
+	api.cacheControl(w, cacheCategory)
 	response(w, result, http.StatusOK)
 }
 
@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
 	"github.com/mahmudindes/orenocomic-donoengine/internal/utila"
@@ -65,7 +66,61 @@ func (api *api) AddComic(w http.ResponseWriter, r *http.Request) {
 			TotalVolume:   data0.TotalVolume,
 			NSFW:          data0.NSFW,
 			NSFL:          data0.NSFL,
-			Additionals:   nil,
+			Additionals:   data0.Additionals,
+		}
+		for _, child := range data0.Titles {
+			data.Titles = append(data.Titles, model.AddComicTitle{
+				RID:          child.RID,
+				LanguageID:   child.LanguageID,
+				LanguageIETF: child.LanguageIETF,
+				Title:        child.Title,
+				Synonym:      child.Synonym,
+				Romanized:    child.Romanized,
+			})
+		}
+		for _, child := range data0.Covers {
+			data.Covers = append(data.Covers, model.AddComicCover{
+				RID:           child.RID,
+				WebsiteID:     child.WebsiteID,
+				WebsiteDomain: child.WebsiteDomain,
+				RelativeURL:   child.RelativeURL,
+				Priority:      child.Priority,
+			})
+		}
+		for _, child := range data0.Synopses {
+			data.Synopses = append(data.Synopses, model.AddComicSynopsis{
+				RID:          child.RID,
+				LanguageID:   child.LanguageID,
+				LanguageIETF: child.LanguageIETF,
+				Synopsis:     child.Synopsis,
+				Version:      child.Version,
+				Romanized:    child.Romanized,
+			})
+		}
+		for _, child := range data0.Externals {
+			data.Externals = append(data.Externals, model.AddComicExternal{
+				RID:           child.RID,
+				WebsiteID:     child.WebsiteID,
+				WebsiteDomain: child.WebsiteDomain,
+				RelativeURL:   child.RelativeURL,
+				Official:      child.Official,
+			})
+		}
+		for _, child := range data0.Categories {
+			data.Categories = append(data.Categories, model.AddComicCategory{
+				CategoryID:       child.CategoryID,
+				CategoryTypeID:   child.CategoryTypeID,
+				CategoryTypeCode: child.CategoryTypeCode,
+				CategoryCode:     child.CategoryCode,
+			})
+		}
+		for _, child := range data0.Tags {
+			data.Tags = append(data.Tags, model.AddComicTag{
+				TagID:       child.TagID,
+				TagTypeID:   child.TagTypeID,
+				TagTypeCode: child.TagTypeCode,
+				TagCode:     child.TagCode,
+			})
 		}
 	case "application/x-www-form-urlencoded":
 		if err := r.ParseForm(); err != nil {
@@ -101,7 +156,7 @@ func (api *api) AddComic(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.Header().Set("Location", r.URL.Path+"/"+result.Code)
-	response(w, modelComic(result), http.StatusCreated)
+	responseEntity(w, modelComic(result), http.StatusCreated)
 }
 
 func (api *api) GetComic(w http.ResponseWriter, r *http.Request, code string) {
@@ -115,7 +170,8 @@ func (api *api) GetComic(w http.ResponseWriter, r *http.Request, code string) {
 		return
 	}
 
-	response(w, modelComic(result), http.StatusOK)
+	// Hydrated children do not touch updated_at, so only the ETag is reliable here.
+	api.responseCached(w, r, cacheComic, modelComic(result), time.Time{})
 }
 
 func (api *api) UpdateComic(w http.ResponseWriter, r *http.Request, code string) {
@@ -141,7 +197,7 @@ func (api *api) UpdateComic(w http.ResponseWriter, r *http.Request, code string)
 			TotalVolume:   data0.TotalVolume,
 			NSFW:          data0.NSFW,
 			NSFL:          data0.NSFL,
-			Additionals:   nil,
+			Additionals:   data0.Additionals,
 			SetNull:       data0.SetNull,
 		}
 	case "application/x-www-form-urlencoded":
@@ -171,6 +227,11 @@ func (api *api) UpdateComic(w http.ResponseWriter, r *http.Request, code string)
 		}
 	}
 
+	ctx = api.precondition(r, false, func(v any) (string, time.Time) {
+		current := v.(*model.Comic)
+		return entityTag(modelComic(current)), lastModified(current.CreatedAt, current.UpdatedAt)
+	})
+
 	result := new(model.Comic)
 	if err := api.service.UpdateComicByCode(ctx, code, data, result); err != nil {
 		if errors.As(err, &model.ErrNotFound) {
@@ -184,13 +245,18 @@ func (api *api) UpdateComic(w http.ResponseWriter, r *http.Request, code string)
 	}
 
 	w.Header().Set("Location", r.URL.Path+"/"+result.Code)
-	response(w, modelComic(result), http.StatusOK)
+	responseEntity(w, modelComic(result), http.StatusOK)
 }
 
 func (api *api) DeleteComic(w http.ResponseWriter, r *http.Request, code string) {
 	ctx := r.Context()
 	log := api.logger.WithContext(ctx)
 
+	ctx = api.precondition(r, false, func(v any) (string, time.Time) {
+		current := v.(*model.Comic)
+		return entityTag(modelComic(current)), lastModified(current.CreatedAt, current.UpdatedAt)
+	})
+
 	if err := api.service.DeleteComicByCode(ctx, code); err != nil {
 		responseServiceErr(w, err)
 		log.ErrMessage(err, "Delete comic failed.")
@@ -200,6 +266,20 @@ func (api *api) DeleteComic(w http.ResponseWriter, r *http.Request, code string)
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func (api *api) RestoreComic(w http.ResponseWriter, r *http.Request, code string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	result := new(model.Comic)
+	if err := api.service.RestoreComicByCode(ctx, code, result); err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Restore comic failed.")
+		return
+	}
+
+	responseEntity(w, modelComic(result), http.StatusOK)
+}
+
 func (api *api) ListComic(w http.ResponseWriter, r *http.Request, params ListComicParams) {
 	ctx := r.Context()
 	log := api.logger.WithContext(ctx)
@@ -212,12 +292,27 @@ func (api *api) ListComic(w http.ResponseWriter, r *http.Request, params ListCom
 		pagination.Limit = *params.Limit
 	}
 
+	if params.Cursor != nil {
+		// The relevance rank of a search is not kept in the cursor.
+		if params.Q != nil && *params.Q != "" {
+			responseErr(w, "Pagination cursor cannot be used with search.", http.StatusBadRequest)
+			return
+		}
+		cursor, err := api.decodeCursor(*params.Cursor)
+		if err != nil {
+			responseErr(w, "Bad pagination cursor.", http.StatusBadRequest)
+			log.ErrMessage(err, "List comic decode cursor failed.")
+			return
+		}
+		pagination.Cursor = cursor
+	}
+
 	var orderBys model.OrderBys
 	if params.OrderBy != nil {
 		orderBys = queryOrderBys(*params.OrderBy)
 	}
 
-	conditions := []any{}
+	conditions := []any{model.DBLogicalAND{}}
 
 	if params.ComicExternal != nil {
 		conditions1 := []any{}
@@ -256,6 +351,118 @@ func (api *api) ListComic(w http.ResponseWriter, r *http.Request, params ListCom
 		}
 	}
 
+	if params.Q != nil && *params.Q != "" {
+		conditions = append(conditions, model.DBCrossConditional{
+			Table: model.DBComicTitle,
+			Conditions: model.DBConditionalKV{
+				Key:   model.DBComicTitleTitle,
+				Value: model.DBTextSearch(*params.Q),
+			},
+		})
+	}
+
+	if params.Tag != nil {
+		conditions1 := queryTypeCodes(*params.Tag, model.DBTagGenericTagTypeID, model.DBTagGenericTagCode)
+		if len(conditions1) > 0 {
+			cc := model.DBCrossConditional{Table: model.DBComicTag, Conditions: conditions1}
+			if params.TagMatch != nil && *params.TagMatch == "all" {
+				cc.HavingCount = len(conditions1)
+			}
+			conditions = append(conditions, cc)
+		}
+	}
+
+	if params.TagExclude != nil {
+		conditions1 := queryTypeCodes(*params.TagExclude, model.DBTagGenericTagTypeID, model.DBTagGenericTagCode)
+		if len(conditions1) > 0 {
+			conditions = append(conditions, model.DBCrossConditional{
+				Table:      model.DBComicTag,
+				Conditions: conditions1,
+				Exclude:    true,
+			})
+		}
+	}
+
+	if params.Category != nil {
+		conditions1 := queryTypeCodes(*params.Category, model.DBCategoryGenericCategoryTypeID, model.DBCategoryGenericCategoryCode)
+		if len(conditions1) > 0 {
+			cc := model.DBCrossConditional{Table: model.DBComicCategory, Conditions: conditions1}
+			if params.CategoryMatch != nil && *params.CategoryMatch == "all" {
+				cc.HavingCount = len(conditions1)
+			}
+			conditions = append(conditions, cc)
+		}
+	}
+
+	if params.CategoryExclude != nil {
+		conditions1 := queryTypeCodes(*params.CategoryExclude, model.DBCategoryGenericCategoryTypeID, model.DBCategoryGenericCategoryCode)
+		if len(conditions1) > 0 {
+			conditions = append(conditions, model.DBCrossConditional{
+				Table:      model.DBComicCategory,
+				Conditions: conditions1,
+				Exclude:    true,
+			})
+		}
+	}
+
+	if params.Language != nil {
+		conditions1 := []any{}
+		for _, ietf := range *params.Language {
+			conditions1 = append(conditions1, model.DBConditionalKV{
+				Key:   model.DBLanguageGenericLanguageIETF,
+				Value: ietf,
+			})
+		}
+		if len(conditions1) > 0 {
+			conditions = append(conditions, conditions1)
+		}
+	}
+
+	if params.NSFW != nil {
+		conditions = append(conditions, model.DBConditionalKV{
+			Key:   model.DBComicNSFW,
+			Value: *params.NSFW,
+		})
+	}
+
+	if params.NSFL != nil {
+		conditions = append(conditions, model.DBConditionalKV{
+			Key:   model.DBComicNSFL,
+			Value: *params.NSFL,
+		})
+	}
+
+	// The publication range matches the comics published at some time in it,
+	// those without published to are still being published.
+	if params.PublishedFrom != nil {
+		conditions = append(conditions, []any{
+			model.DBLogicalOR{},
+			model.DBConditionalKV{
+				Key:   model.DBComicPublishedTo,
+				Value: model.DBGreaterOrEqual{Value: *params.PublishedFrom},
+			},
+			map[string]any{
+				model.DBComicPublishedTo:   model.DBIsNull{},
+				model.DBComicPublishedFrom: model.DBIsNotNull{},
+			},
+		})
+	}
+
+	if params.PublishedTo != nil {
+		conditions = append(conditions, model.DBConditionalKV{
+			Key:   model.DBComicPublishedFrom,
+			Value: model.DBLessOrEqual{Value: *params.PublishedTo},
+		})
+	}
+	if params.Additionals != nil {
+		for _, additional := range queryJSONPaths(*params.Additionals) {
+			conditions = append(conditions, model.DBConditionalKV{
+				Key:   model.DBComicAdditionals,
+				Value: model.DBJSONContains{Value: additional},
+			})
+		}
+	}
+
 	totalCountCh := make(chan int, 1)
 	go func() {
 		count, err := api.service.CountComic(ctx, conditions)
@@ -267,7 +474,7 @@ func (api *api) ListComic(w http.ResponseWriter, r *http.Request, params ListCom
 		totalCountCh <- count
 	}()
 
-	result0, err := api.service.ListComic(ctx, model.ListParams{
+	result0, next, err := api.service.ListComic(ctx, model.ListParams{
 		Conditions: conditions,
 		OrderBys:   orderBys,
 		Pagination: &pagination,
@@ -278,13 +485,28 @@ func (api *api) ListComic(w http.ResponseWriter, r *http.Request, params ListCom
 		return
 	}
 
+	totalCount := <-totalCountCh
 	wHeader := w.Header()
-	wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	wHeader.Set("X-Total-Count", strconv.Itoa(totalCount))
 	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	nextCursor := ""
+	if next != nil {
+		cursor, err := api.encodeCursor(next)
+		if err != nil {
+			log.ErrMessage(err, "List comic encode cursor failed.")
+		} else {
+			nextCursor = cursor
+			wHeader.Set("X-Pagination-Next-Cursor", cursor)
+		}
+	}
+	if link := paginationLink(r, pagination, totalCount, nextCursor); link != "" {
+		wHeader.Set("Link", link)
+	}
 	result := []Comic{}
 	for _, r := range result0 {
 		result = append(result, modelComic(r))
 	}
+	api.cacheControl(w, cacheComic)
 	response(w, result, http.StatusOK)
 }
 
@@ -459,6 +681,64 @@ func (api *api) DeleteComicTitle(w http.ResponseWriter, r *http.Request, code st
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func (api *api) ListComicTitle(w http.ResponseWriter, r *http.Request, code string, params ListComicTitleParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	pagination := model.Pagination{Page: 1, Limit: 10}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+
+	var orderBys model.OrderBys
+	if params.OrderBy != nil {
+		orderBys = queryOrderBys(*params.OrderBy)
+	}
+
+	conditions := model.DBConditionalKV{
+		Key:   model.DBComicGenericComicID,
+		Value: model.DBComicCodeToID(code),
+	}
+
+	totalCountCh := make(chan int, 1)
+	go func() {
+		count, err := api.service.CountComicTitle(ctx, conditions)
+		if err != nil {
+			totalCountCh <- -1
+			log.ErrMessage(err, "Count comic title failed.")
+			return
+		}
+		totalCountCh <- count
+	}()
+
+	result0, err := api.service.ListComicTitle(ctx, model.ListParams{
+		Conditions: conditions,
+		OrderBys:   orderBys,
+		Pagination: &pagination,
+	})
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "List comic title failed.")
+		return
+	}
+
+	totalCount := <-totalCountCh
+	wHeader := w.Header()
+	wHeader.Set("X-Total-Count", strconv.Itoa(totalCount))
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	if link := paginationLink(r, pagination, totalCount, ""); link != "" {
+		wHeader.Set("Link", link)
+	}
+	result := []ComicTitle{}
+	for _, r := range result0 {
+		result = append(result, modelComicTitle(r))
+	}
+	response(w, result, http.StatusOK)
+}
+
 // Comic Cover
 
 func modelComicCover(m *model.ComicCover) ComicCover {
@@ -625,6 +905,64 @@ func (api *api) DeleteComicCover(w http.ResponseWriter, r *http.Request, code st
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func (api *api) ListComicCover(w http.ResponseWriter, r *http.Request, code string, params ListComicCoverParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	pagination := model.Pagination{Page: 1, Limit: 10}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+
+	var orderBys model.OrderBys
+	if params.OrderBy != nil {
+		orderBys = queryOrderBys(*params.OrderBy)
+	}
+
+	conditions := model.DBConditionalKV{
+		Key:   model.DBComicGenericComicID,
+		Value: model.DBComicCodeToID(code),
+	}
+
+	totalCountCh := make(chan int, 1)
+	go func() {
+		count, err := api.service.CountComicCover(ctx, conditions)
+		if err != nil {
+			totalCountCh <- -1
+			log.ErrMessage(err, "Count comic cover failed.")
+			return
+		}
+		totalCountCh <- count
+	}()
+
+	result0, err := api.service.ListComicCover(ctx, model.ListParams{
+		Conditions: conditions,
+		OrderBys:   orderBys,
+		Pagination: &pagination,
+	})
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "List comic cover failed.")
+		return
+	}
+
+	totalCount := <-totalCountCh
+	wHeader := w.Header()
+	wHeader.Set("X-Total-Count", strconv.Itoa(totalCount))
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	if link := paginationLink(r, pagination, totalCount, ""); link != "" {
+		wHeader.Set("Link", link)
+	}
+	result := []ComicCover{}
+	for _, r := range result0 {
+		result = append(result, modelComicCover(r))
+	}
+	response(w, result, http.StatusOK)
+}
+
 // Comic Synopsis
 
 func modelComicSynopsis(m *model.ComicSynopsis) ComicSynopsis {
@@ -796,6 +1134,64 @@ func (api *api) DeleteComicSynopsis(w http.ResponseWriter, r *http.Request, code
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func (api *api) ListComicSynopsis(w http.ResponseWriter, r *http.Request, code string, params ListComicSynopsisParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	pagination := model.Pagination{Page: 1, Limit: 10}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+
+	var orderBys model.OrderBys
+	if params.OrderBy != nil {
+		orderBys = queryOrderBys(*params.OrderBy)
+	}
+
+	conditions := model.DBConditionalKV{
+		Key:   model.DBComicGenericComicID,
+		Value: model.DBComicCodeToID(code),
+	}
+
+	totalCountCh := make(chan int, 1)
+	go func() {
+		count, err := api.service.CountComicSynopsis(ctx, conditions)
+		if err != nil {
+			totalCountCh <- -1
+			log.ErrMessage(err, "Count comic synopsis failed.")
+			return
+		}
+		totalCountCh <- count
+	}()
+
+	result0, err := api.service.ListComicSynopsis(ctx, model.ListParams{
+		Conditions: conditions,
+		OrderBys:   orderBys,
+		Pagination: &pagination,
+	})
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "List comic synopsis failed.")
+		return
+	}
+
+	totalCount := <-totalCountCh
+	wHeader := w.Header()
+	wHeader.Set("X-Total-Count", strconv.Itoa(totalCount))
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	if link := paginationLink(r, pagination, totalCount, ""); link != "" {
+		wHeader.Set("Link", link)
+	}
+	result := []ComicSynopsis{}
+	for _, r := range result0 {
+		result = append(result, modelComicSynopsis(r))
+	}
+	response(w, result, http.StatusOK)
+}
+
 // Comic External
 
 func modelComicExternal(m *model.ComicExternal) ComicExternal {
@@ -962,6 +1358,64 @@ func (api *api) DeleteComicExternal(w http.ResponseWriter, r *http.Request, code
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func (api *api) ListComicExternal(w http.ResponseWriter, r *http.Request, code string, params ListComicExternalParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	pagination := model.Pagination{Page: 1, Limit: 10}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+
+	var orderBys model.OrderBys
+	if params.OrderBy != nil {
+		orderBys = queryOrderBys(*params.OrderBy)
+	}
+
+	conditions := model.DBConditionalKV{
+		Key:   model.DBComicGenericComicID,
+		Value: model.DBComicCodeToID(code),
+	}
+
+	totalCountCh := make(chan int, 1)
+	go func() {
+		count, err := api.service.CountComicExternal(ctx, conditions)
+		if err != nil {
+			totalCountCh <- -1
+			log.ErrMessage(err, "Count comic external failed.")
+			return
+		}
+		totalCountCh <- count
+	}()
+
+	result0, err := api.service.ListComicExternal(ctx, model.ListParams{
+		Conditions: conditions,
+		OrderBys:   orderBys,
+		Pagination: &pagination,
+	})
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "List comic external failed.")
+		return
+	}
+
+	totalCount := <-totalCountCh
+	wHeader := w.Header()
+	wHeader.Set("X-Total-Count", strconv.Itoa(totalCount))
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	if link := paginationLink(r, pagination, totalCount, ""); link != "" {
+		wHeader.Set("Link", link)
+	}
+	result := []ComicExternal{}
+	for _, r := range result0 {
+		result = append(result, modelComicExternal(r))
+	}
+	response(w, result, http.StatusOK)
+}
+
 // Comic Category
 
 func modelComicCategory(m *model.ComicCategory) ComicCategory {
@@ -1119,6 +1573,64 @@ func (api *api) DeleteComicCategory(w http.ResponseWriter, r *http.Request, code
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func (api *api) ListComicCategory(w http.ResponseWriter, r *http.Request, code string, params ListComicCategoryParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	pagination := model.Pagination{Page: 1, Limit: 10}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+
+	var orderBys model.OrderBys
+	if params.OrderBy != nil {
+		orderBys = queryOrderBys(*params.OrderBy)
+	}
+
+	conditions := model.DBConditionalKV{
+		Key:   model.DBComicGenericComicID,
+		Value: model.DBComicCodeToID(code),
+	}
+
+	totalCountCh := make(chan int, 1)
+	go func() {
+		count, err := api.service.CountComicCategory(ctx, conditions)
+		if err != nil {
+			totalCountCh <- -1
+			log.ErrMessage(err, "Count comic category failed.")
+			return
+		}
+		totalCountCh <- count
+	}()
+
+	result0, err := api.service.ListComicCategory(ctx, model.ListParams{
+		Conditions: conditions,
+		OrderBys:   orderBys,
+		Pagination: &pagination,
+	})
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "List comic category failed.")
+		return
+	}
+
+	totalCount := <-totalCountCh
+	wHeader := w.Header()
+	wHeader.Set("X-Total-Count", strconv.Itoa(totalCount))
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	if link := paginationLink(r, pagination, totalCount, ""); link != "" {
+		wHeader.Set("Link", link)
+	}
+	result := []ComicCategory{}
+	for _, r := range result0 {
+		result = append(result, modelComicCategory(r))
+	}
+	response(w, result, http.StatusOK)
+}
+
 // Comic Tag
 
 func modelComicTag(m *model.ComicTag) ComicTag {
@@ -1276,6 +1788,64 @@ func (api *api) DeleteComicTag(w http.ResponseWriter, r *http.Request, code stri
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func (api *api) ListComicTag(w http.ResponseWriter, r *http.Request, code string, params ListComicTagParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	pagination := model.Pagination{Page: 1, Limit: 10}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+
+	var orderBys model.OrderBys
+	if params.OrderBy != nil {
+		orderBys = queryOrderBys(*params.OrderBy)
+	}
+
+	conditions := model.DBConditionalKV{
+		Key:   model.DBComicGenericComicID,
+		Value: model.DBComicCodeToID(code),
+	}
+
+	totalCountCh := make(chan int, 1)
+	go func() {
+		count, err := api.service.CountComicTag(ctx, conditions)
+		if err != nil {
+			totalCountCh <- -1
+			log.ErrMessage(err, "Count comic tag failed.")
+			return
+		}
+		totalCountCh <- count
+	}()
+
+	result0, err := api.service.ListComicTag(ctx, model.ListParams{
+		Conditions: conditions,
+		OrderBys:   orderBys,
+		Pagination: &pagination,
+	})
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "List comic tag failed.")
+		return
+	}
+
+	totalCount := <-totalCountCh
+	wHeader := w.Header()
+	wHeader.Set("X-Total-Count", strconv.Itoa(totalCount))
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	if link := paginationLink(r, pagination, totalCount, ""); link != "" {
+		wHeader.Set("Link", link)
+	}
+	result := []ComicTag{}
+	for _, r := range result0 {
+		result = append(result, modelComicTag(r))
+	}
+	response(w, result, http.StatusOK)
+}
+
 // Comic Relation
 
 func modelComicRelation(m *model.ComicRelation) ComicRelation {
@@ -1436,6 +2006,64 @@ func (api *api) DeleteComicRelation(w http.ResponseWriter, r *http.Request, code
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func (api *api) ListComicRelation(w http.ResponseWriter, r *http.Request, code string, params ListComicRelationParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	pagination := model.Pagination{Page: 1, Limit: 10}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+
+	var orderBys model.OrderBys
+	if params.OrderBy != nil {
+		orderBys = queryOrderBys(*params.OrderBy)
+	}
+
+	conditions := model.DBConditionalKV{
+		Key:   model.DBComicRelationParentID,
+		Value: model.DBComicCodeToID(code),
+	}
+
+	totalCountCh := make(chan int, 1)
+	go func() {
+		count, err := api.service.CountComicRelation(ctx, conditions)
+		if err != nil {
+			totalCountCh <- -1
+			log.ErrMessage(err, "Count comic relation failed.")
+			return
+		}
+		totalCountCh <- count
+	}()
+
+	result0, err := api.service.ListComicRelation(ctx, model.ListParams{
+		Conditions: conditions,
+		OrderBys:   orderBys,
+		Pagination: &pagination,
+	})
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "List comic relation failed.")
+		return
+	}
+
+	totalCount := <-totalCountCh
+	wHeader := w.Header()
+	wHeader.Set("X-Total-Count", strconv.Itoa(totalCount))
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	if link := paginationLink(r, pagination, totalCount, ""); link != "" {
+		wHeader.Set("Link", link)
+	}
+	result := []ComicRelation{}
+	for _, r := range result0 {
+		result = append(result, modelComicRelation(r))
+	}
+	response(w, result, http.StatusOK)
+}
+
 //
 // Comic Chapter
 //
@@ -1508,7 +2136,7 @@ func (api *api) AddComicChapter(w http.ResponseWriter, r *http.Request, code str
 	}
 
 	w.Header().Set("Location", r.URL.Path+"/"+slug)
-	response(w, modelComicChapter(result), http.StatusCreated)
+	responseEntity(w, modelComicChapter(result), http.StatusCreated)
 }
 
 func (api *api) GetComicChapter(w http.ResponseWriter, r *http.Request, code string, cv string) {
@@ -1537,7 +2165,7 @@ func (api *api) GetComicChapter(w http.ResponseWriter, r *http.Request, code str
 		return
 	}
 
-	response(w, modelComicChapter(result), http.StatusOK)
+	api.responseCached(w, r, cacheComicChapter, modelComicChapter(result), lastModified(result.CreatedAt, result.UpdatedAt))
 }
 
 func (api *api) UpdateComicChapter(w http.ResponseWriter, r *http.Request, code string, cv string) {
@@ -1596,6 +2224,11 @@ func (api *api) UpdateComicChapter(w http.ResponseWriter, r *http.Request, code
 		return
 	}
 
+	ctx = api.precondition(r, true, func(v any) (string, time.Time) {
+		current := v.(*model.ComicChapter)
+		return entityTag(modelComicChapter(current)), lastModified(current.CreatedAt, current.UpdatedAt)
+	})
+
 	result := new(model.ComicChapter)
 	if err := api.service.UpdateComicChapterBySID(ctx, model.ComicChapterSID{
 		ComicCode: &code,
@@ -1618,7 +2251,7 @@ func (api *api) UpdateComicChapter(w http.ResponseWriter, r *http.Request, code
 	}
 
 	w.Header().Set("Location", r.URL.Path+"/"+slug)
-	response(w, modelComicChapter(result), http.StatusOK)
+	responseEntity(w, modelComicChapter(result), http.StatusOK)
 }
 
 func (api *api) DeleteComicChapter(w http.ResponseWriter, r *http.Request, code string, cv string) {
@@ -1636,6 +2269,11 @@ func (api *api) DeleteComicChapter(w http.ResponseWriter, r *http.Request, code
 		return
 	}
 
+	ctx = api.precondition(r, true, func(v any) (string, time.Time) {
+		current := v.(*model.ComicChapter)
+		return entityTag(modelComicChapter(current)), lastModified(current.CreatedAt, current.UpdatedAt)
+	})
+
 	if err := api.service.DeleteComicChapterBySID(ctx, model.ComicChapterSID{
 		ComicCode: &code,
 		Chapter:   chapter,
@@ -1661,6 +2299,16 @@ func (api *api) ListComicChapter(w http.ResponseWriter, r *http.Request, code st
 		pagination.Limit = *params.Limit
 	}
 
+	if params.Cursor != nil {
+		cursor, err := api.decodeCursor(*params.Cursor)
+		if err != nil {
+			responseErr(w, "Bad pagination cursor.", http.StatusBadRequest)
+			log.ErrMessage(err, "List comic chapter decode cursor failed.")
+			return
+		}
+		pagination.Cursor = cursor
+	}
+
 	var orderBys model.OrderBys
 	if params.OrderBy != nil {
 		orderBys = queryOrderBys(*params.OrderBy)
@@ -1682,7 +2330,7 @@ func (api *api) ListComicChapter(w http.ResponseWriter, r *http.Request, code st
 		totalCountCh <- count
 	}()
 
-	result0, err := api.service.ListComicChapter(ctx, model.ListParams{
+	result0, next, err := api.service.ListComicChapter(ctx, model.ListParams{
 		Conditions: conditions,
 		OrderBys:   orderBys,
 		Pagination: &pagination,
@@ -1693,12 +2341,27 @@ func (api *api) ListComicChapter(w http.ResponseWriter, r *http.Request, code st
 		return
 	}
 
+	totalCount := <-totalCountCh
 	wHeader := w.Header()
-	wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	wHeader.Set("X-Total-Count", strconv.Itoa(totalCount))
 	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	nextCursor := ""
+	if next != nil {
+		cursor, err := api.encodeCursor(next)
+		if err != nil {
+			log.ErrMessage(err, "List comic chapter encode cursor failed.")
+		} else {
+			nextCursor = cursor
+			wHeader.Set("X-Pagination-Next-Cursor", cursor)
+		}
+	}
+	if link := paginationLink(r, pagination, totalCount, nextCursor); link != "" {
+		wHeader.Set("Link", link)
+	}
 	var result []ComicChapter
 	for _, r := range result0 {
 		result = append(result, modelComicChapter(r))
 	}
+	api.cacheControl(w, cacheComicChapter)
 	response(w, result, http.StatusOK)
 }
@@ -0,0 +1,88 @@
+package rapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/testsupport"
+)
+
+func checkCodes(codes ...string) func(t *testing.T, res *http.Response, body []byte) {
+	return func(t *testing.T, res *http.Response, body []byte) {
+		var data []map[string]any
+		if err := json.Unmarshal(body, &data); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		var got []string
+		for _, d := range data {
+			code, _ := d["code"].(string)
+			got = append(got, code)
+		}
+		if len(got) != len(codes) {
+			t.Fatalf("codes: expected %v got %v", codes, got)
+		}
+		for i := range codes {
+			if got[i] != codes[i] {
+				t.Fatalf("codes: expected %v got %v", codes, got)
+			}
+		}
+	}
+}
+
+func TestListComicSearch(t *testing.T) {
+	svr := testsupport.StartServer(t, testsupport.Config{})
+	language := svr.PermissionToken(t, "language.write")
+	comic := svr.PermissionToken(t, "comic.write")
+
+	svr.Run(t, []testsupport.Case{
+		{Name: "add language", Method: http.MethodPost, Path: "/api/v0/languages", Token: language,
+			Body: map[string]any{"ietf": "ja", "name": "Japanese"}, Status: http.StatusCreated},
+		{Name: "add comic one", Method: http.MethodPost, Path: "/api/v0/comics", Token: comic,
+			Body: map[string]any{"code": "aaaaaaaa"}, Status: http.StatusCreated},
+		{Name: "add comic two", Method: http.MethodPost, Path: "/api/v0/comics", Token: comic,
+			Body: map[string]any{"code": "bbbbbbbb"}, Status: http.StatusCreated},
+		{Name: "add title", Method: http.MethodPost, Path: "/api/v0/comics/aaaaaaaa/titles", Token: comic,
+			Body: map[string]any{"languageID": 1, "title": "Shingeki no Kyojin", "romanized": true}, Status: http.StatusCreated},
+		{Name: "add synonym title", Method: http.MethodPost, Path: "/api/v0/comics/bbbbbbbb/titles", Token: comic,
+			Body: map[string]any{"languageID": 1, "title": "Fullmetal Alchemist", "synonym": true}, Status: http.StatusCreated},
+		{Name: "search romanized", Method: http.MethodGet, Path: "/api/v0/comics?q=kyojin", Status: http.StatusOK,
+			Check: checkCodes("aaaaaaaa")},
+		{Name: "search synonym", Method: http.MethodGet, Path: "/api/v0/comics?q=alchemist", Status: http.StatusOK,
+			Check: checkCodes("bbbbbbbb")},
+		{Name: "search total count", Method: http.MethodGet, Path: "/api/v0/comics?q=alchemist", Status: http.StatusOK,
+			Check: func(t *testing.T, res *http.Response, body []byte) {
+				if count := res.Header.Get("X-Total-Count"); count != "1" {
+					t.Errorf("total count: expected 1 got %s", count)
+				}
+			}},
+		{Name: "search with cursor", Method: http.MethodGet, Path: "/api/v0/comics?q=alchemist&cursor=abc", Status: http.StatusBadRequest},
+	})
+}
+
+func TestListComicPublished(t *testing.T) {
+	svr := testsupport.StartServer(t, testsupport.Config{})
+	comic := svr.PermissionToken(t, "comic.write")
+
+	add := func(code string, from, to any) testsupport.Case {
+		return testsupport.Case{Name: "add " + code, Method: http.MethodPost, Path: "/api/v0/comics", Token: comic,
+			Body:   map[string]any{"code": code, "publishedFrom": from, "publishedTo": to},
+			Status: http.StatusCreated}
+	}
+	list := func(name, query string, codes ...string) testsupport.Case {
+		return testsupport.Case{Name: name, Method: http.MethodGet, Path: "/api/v0/comics?orderBy=code&" + query,
+			Status: http.StatusOK, Check: checkCodes(codes...)}
+	}
+	svr.Run(t, []testsupport.Case{
+		add("ended000", "2010-01-01T00:00:00Z", "2012-01-01T00:00:00Z"),
+		add("inside00", "2016-01-01T00:00:00Z", "2018-01-01T00:00:00Z"),
+		add("ongoing0", "2012-01-01T00:00:00Z", nil),
+		add("overlap0", "2014-01-01T00:00:00Z", "2016-01-01T00:00:00Z"),
+		add("upcoming", "2024-01-01T00:00:00Z", nil),
+		add("unknown0", nil, nil),
+		list("from", "published_from=2015-01-01T00:00:00Z", "inside00", "ongoing0", "overlap0", "upcoming"),
+		list("to", "published_to=2013-01-01T00:00:00Z", "ended000", "ongoing0"),
+		list("range", "published_from=2015-01-01T00:00:00Z&published_to=2020-01-01T00:00:00Z",
+			"inside00", "ongoing0", "overlap0"),
+	})
+}
@@ -1,17 +1,36 @@
 package rapi
 
 import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/url"
+	"reflect"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/mahmudindes/orenocomic-donoengine/internal/controller/chttp/utilb"
 	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
 	"github.com/mahmudindes/orenocomic-donoengine/internal/utila"
 )
 
+const (
+	cacheDefault      = "default"
+	cacheComic        = "comic"
+	cacheComicChapter = "comic_chapter"
+	cacheCategory     = "category"
+	cacheTag          = "tag"
+	cacheLanguage     = "language"
+	cacheWebsite      = "website"
+)
+
 func queryOrderBys(obs []string) model.OrderBys {
 	var orderBys model.OrderBys
 	for _, ob := range obs {
@@ -40,6 +59,202 @@ func queryOrderBys(obs []string) model.OrderBys {
 	return orderBys
 }
 
+func queryTypeCodes(tcs []string, typeKey, codeKey string) []any {
+	var conds []any
+	seen := map[string]bool{}
+	for _, tc := range tcs {
+		typeID, code, ok := strings.Cut(tc, "-")
+		if !ok || code == "" {
+			continue
+		}
+
+		typeID0, err := strconv.ParseUint(typeID, 10, 0)
+		if err != nil {
+			continue
+		}
+
+		key := strconv.FormatUint(typeID0, 10) + "-" + code
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		conds = append(conds, map[string]any{typeKey: uint(typeID0), codeKey: code})
+	}
+	return conds
+}
+
+func queryJSONPaths(pvs []string) []map[string]any {
+	var values []map[string]any
+	for _, pv := range pvs {
+		path, value, ok := strings.Cut(pv, "=")
+		if !ok || path == "" {
+			continue
+		}
+
+		var value0 any
+		if err := json.Unmarshal([]byte(value), &value0); err != nil {
+			value0 = value
+		}
+
+		keys := strings.Split(path, ".")
+		if slices.Contains(keys, "") {
+			continue
+		}
+		for i := len(keys) - 1; i >= 0; i-- {
+			value0 = map[string]any{keys[i]: value0}
+		}
+
+		values = append(values, value0.(map[string]any))
+	}
+	return values
+}
+
+// cursorValue keeps the type of a cursor value through JSON, the value is
+// bound as it is to the condition of the next page.
+type cursorValue struct {
+	Type  string          `json:"t"`
+	Value json.RawMessage `json:"v,omitempty"`
+}
+
+// encodeCursor signs the cursor so a client can only hand back the cursors it
+// was given.
+func (api *api) encodeCursor(values []any) (string, error) {
+	cursor := make([]cursorValue, 0, len(values))
+	for _, value := range values {
+		var typ string
+		rv := reflect.ValueOf(value)
+		switch {
+		case value == nil:
+			cursor = append(cursor, cursorValue{Type: "null"})
+			continue
+		case rv.Type() == reflect.TypeOf(time.Time{}):
+			typ = "time"
+		case rv.Kind() == reflect.String:
+			typ, value = "string", rv.String()
+		case rv.Kind() == reflect.Bool:
+			typ, value = "bool", rv.Bool()
+		case rv.CanInt():
+			typ, value = "int", rv.Int()
+		case rv.CanUint():
+			typ, value = "uint", rv.Uint()
+		case rv.CanFloat():
+			typ, value = "float", rv.Float()
+		default:
+			return "", errors.New("cursor value of type " + rv.Type().String() + " is not supported")
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			return "", err
+		}
+		cursor = append(cursor, cursorValue{Type: typ, Value: data})
+	}
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString(data) + "." + enc.EncodeToString(api.cursorMAC(data)), nil
+}
+
+func (api *api) decodeCursor(cursor string) ([]any, error) {
+	enc := base64.RawURLEncoding
+	data0, mac0, ok := strings.Cut(cursor, ".")
+	if !ok {
+		return nil, errors.New("cursor is not signed")
+	}
+	data, err := enc.DecodeString(data0)
+	if err != nil {
+		return nil, err
+	}
+	mac, err := enc.DecodeString(mac0)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal(mac, api.cursorMAC(data)) {
+		return nil, errors.New("cursor signature mismatch")
+	}
+
+	var cursor0 []cursorValue
+	if err := json.Unmarshal(data, &cursor0); err != nil {
+		return nil, err
+	}
+	values := make([]any, 0, len(cursor0))
+	for _, value := range cursor0 {
+		var dst any
+		switch value.Type {
+		case "null":
+			values = append(values, nil)
+			continue
+		case "time":
+			dst = new(time.Time)
+		case "string":
+			dst = new(string)
+		case "bool":
+			dst = new(bool)
+		case "int":
+			dst = new(int64)
+		case "uint":
+			dst = new(uint)
+		case "float":
+			dst = new(float64)
+		default:
+			return nil, errors.New("cursor value type " + value.Type + " is not supported")
+		}
+		if err := json.Unmarshal(value.Value, dst); err != nil {
+			return nil, err
+		}
+		values = append(values, reflect.ValueOf(dst).Elem().Interface())
+	}
+	return values, nil
+}
+
+func (api *api) cursorMAC(data []byte) []byte {
+	mac := hmac.New(sha256.New, api.config.CursorSecret)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
+
+func paginationLink(r *http.Request, pagination model.Pagination, totalCount int, nextCursor string) string {
+	link := func(rel string, page int, cursor string) string {
+		query := r.URL.Query()
+		query.Set("limit", strconv.Itoa(pagination.Limit))
+		if cursor != "" {
+			query.Del("page")
+			query.Set("cursor", cursor)
+		} else {
+			query.Del("cursor")
+			query.Set("page", strconv.Itoa(page))
+		}
+		return "<" + r.URL.Path + "?" + query.Encode() + ">; rel=\"" + rel + "\""
+	}
+
+	if pagination.Limit < 1 {
+		return ""
+	}
+
+	lastPage := -1
+	if totalCount >= 0 {
+		lastPage = max((totalCount+pagination.Limit-1)/pagination.Limit, 1)
+	}
+
+	links := []string{}
+	switch {
+	case nextCursor != "":
+		links = append(links, link("next", 0, nextCursor))
+	case pagination.Cursor == nil && lastPage >= 0 && pagination.Page < lastPage:
+		links = append(links, link("next", pagination.Page+1, ""))
+	}
+	if pagination.Cursor == nil && pagination.Page > 1 {
+		links = append(links, link("prev", min(pagination.Page-1, max(lastPage, 1)), ""))
+	}
+	links = append(links, link("first", 1, ""))
+	if lastPage >= 0 {
+		links = append(links, link("last", lastPage, ""))
+	}
+	return strings.Join(links, ", ")
+}
+
 func formDecode(form url.Values, v any) error {
 	return utilb.FormDecoder.Decode(v, form)
 }
@@ -55,10 +270,118 @@ func slicesModel[E, M any](ms []*M, mf func(*M) E) *[]E {
 	return es
 }
 
+func entityTag(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+func lastModified(createdAt time.Time, updatedAt *time.Time) time.Time {
+	if updatedAt != nil {
+		return *updatedAt
+	}
+	return createdAt
+}
+
+func checkPrecondition(r *http.Request, etag string, modified time.Time) bool {
+	if im := r.Header.Get("If-Match"); im != "" {
+		for _, tag := range strings.Split(im, ",") {
+			tag = strings.TrimSpace(tag)
+			if tag == "*" || (etag != "" && tag == etag) {
+				return true
+			}
+		}
+		return false
+	}
+	if ius := r.Header.Get("If-Unmodified-Since"); ius != "" {
+		since, err := http.ParseTime(ius)
+		if err != nil {
+			return true
+		}
+		return !modified.Truncate(time.Second).After(since)
+	}
+	return true
+}
+
+func checkNotModified(r *http.Request, etag string, modified time.Time) bool {
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		for _, tag := range strings.Split(inm, ",") {
+			tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+			if tag == "*" || (etag != "" && tag == etag) {
+				return true
+			}
+		}
+		return false
+	}
+	if ims := r.Header.Get("If-Modified-Since"); ims != "" && !modified.IsZero() {
+		since, err := http.ParseTime(ims)
+		if err != nil {
+			return false
+		}
+		return !modified.Truncate(time.Second).After(since)
+	}
+	return false
+}
+
+func (api *api) cacheControl(w http.ResponseWriter, resource string) {
+	cc, ok := api.config.CacheControl[resource]
+	if !ok {
+		cc = api.config.CacheControl[cacheDefault]
+	}
+	if cc != "" {
+		w.Header().Set("Cache-Control", cc)
+	}
+}
+
+func (api *api) responseCached(w http.ResponseWriter, r *http.Request, resource string, v any, modified time.Time) {
+	api.cacheControl(w, resource)
+
+	wHeader := w.Header()
+	etag := entityTag(v)
+	if etag != "" {
+		wHeader.Set("ETag", etag)
+	}
+	if !modified.IsZero() {
+		wHeader.Set("Last-Modified", modified.UTC().Format(http.TimeFormat))
+	}
+
+	if checkNotModified(r, etag, modified) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	response(w, v, http.StatusOK)
+}
+
+// precondition passes the request preconditions to the service, which checks
+// them against the current entity inside the write transaction. Entities
+// hydrated with their children only honour If-Match, the children do not bump
+// the entity modification time.
+func (api *api) precondition(r *http.Request, unmodifiedSince bool, current func(v any) (string, time.Time)) context.Context {
+	ctx := r.Context()
+	if r.Header.Get("If-Match") == "" && (!unmodifiedSince || r.Header.Get("If-Unmodified-Since") == "") {
+		return ctx
+	}
+
+	return api.service.ContextPrecondition(ctx, func(v any) bool {
+		etag, modified := current(v)
+		return checkPrecondition(r, etag, modified)
+	})
+}
+
 func response(w http.ResponseWriter, v any, code int) {
 	utilb.ResponseJSON(w, v, code)
 }
 
+func responseEntity(w http.ResponseWriter, v any, code int) {
+	if etag := entityTag(v); etag != "" {
+		w.Header().Set("ETag", etag)
+	}
+	response(w, v, code)
+}
+
 type errorData = struct {
 	Message string `json:"message"`
 	Status  string `json:"status"`
@@ -81,6 +404,8 @@ func responseErr500(w http.ResponseWriter) {
 
 func responseServiceErr(w http.ResponseWriter, err error) {
 	switch {
+	case errors.As(err, &model.ErrPrecondition):
+		responseErr(w, "Precondition failed.", http.StatusPreconditionFailed)
 	case errors.As(err, &model.ErrNotFound):
 		responseErr404(w)
 	case errors.As(err, &model.ErrGeneric):
@@ -0,0 +1,71 @@
+package rapi
+
+import (
+	"encoding/base64"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	iapi := &api{config: Config{CursorSecret: []byte("secret")}}
+	values := []any{"code asc,id asc", "action", nil, uint(7), int64(-1), 1.5, true, time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)}
+	cursor, err := iapi.encodeCursor(values)
+	if err != nil {
+		t.Fatalf("encodeCursor: %v", err)
+	}
+	got, err := iapi.decodeCursor(cursor)
+	if err != nil {
+		t.Fatalf("decodeCursor: %v", err)
+	}
+	if !reflect.DeepEqual(got, values) {
+		t.Errorf("decodeCursor = %#v, want %#v", got, values)
+	}
+
+	// Values of a struct field come back as the widest type of their kind.
+	cursor, err = iapi.encodeCursor([]any{int(3), float32(0.5)})
+	if err != nil {
+		t.Fatalf("encodeCursor: %v", err)
+	}
+	if got, err := iapi.decodeCursor(cursor); err != nil || !reflect.DeepEqual(got, []any{int64(3), 0.5}) {
+		t.Errorf("decodeCursor = %#v, %v", got, err)
+	}
+
+	if _, err := iapi.encodeCursor([]any{[]string{"a"}}); err == nil {
+		t.Error("encodeCursor of a slice succeeded, want error")
+	}
+}
+
+func TestDecodeCursorInvalid(t *testing.T) {
+	iapi := &api{config: Config{CursorSecret: []byte("secret")}}
+	other := &api{config: Config{CursorSecret: []byte("other")}}
+	enc := base64.RawURLEncoding
+	sign := func(data string) string {
+		return enc.EncodeToString([]byte(data)) + "." + enc.EncodeToString(iapi.cursorMAC([]byte(data)))
+	}
+	cursor, err := iapi.encodeCursor([]any{"code asc,id asc", "action", uint(7)})
+	if err != nil {
+		t.Fatalf("encodeCursor: %v", err)
+	}
+	data, mac, _ := strings.Cut(cursor, ".")
+	forged := enc.EncodeToString([]byte(`[{"t":"string","v":"code asc,id asc"},{"t":"string","v":"zzz"},{"t":"uint","v":1}]`))
+
+	for _, c := range []struct {
+		name   string
+		api    *api
+		cursor string
+	}{
+		{"not base64", iapi, "not base64!.abc"},
+		{"unsigned", iapi, data},
+		{"bad signature", iapi, data + "." + enc.EncodeToString([]byte("mac"))},
+		{"forged values", iapi, forged + "." + mac},
+		{"other secret", other, cursor},
+		{"unknown type", iapi, sign(`[{"t":"map","v":{}}]`)},
+		{"mistyped value", iapi, sign(`[{"t":"uint","v":"7"}]`)},
+	} {
+		if _, err := c.api.decodeCursor(c.cursor); err == nil {
+			t.Errorf("decodeCursor %s succeeded, want error", c.name)
+		}
+	}
+}
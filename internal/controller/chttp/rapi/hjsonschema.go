@@ -0,0 +1,69 @@
+package rapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+func modelJSONSchema(m *model.JSONSchema) JSONSchema {
+	return JSONSchema{
+		ID:        m.ID,
+		Name:      m.Name,
+		Schema:    m.Schema,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+func (api *api) GetJSONSchema(w http.ResponseWriter, r *http.Request, name string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	result, err := api.service.GetJSONSchema(ctx, name)
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Get json schema failed.")
+		return
+	}
+
+	response(w, modelJSONSchema(result), http.StatusOK)
+}
+
+func (api *api) SetJSONSchema(w http.ResponseWriter, r *http.Request, name string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	var data0 SetJSONSchemaJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&data0); err != nil {
+		responseErr(w, "Bad request body.", http.StatusBadRequest)
+		log.ErrMessage(err, "Set json schema decode json body failed.")
+		return
+	}
+
+	result := new(model.JSONSchema)
+	if err := api.service.SetJSONSchema(ctx, model.AddJSONSchema{
+		Name:   name,
+		Schema: data0.Schema,
+	}, result); err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Set json schema failed.")
+		return
+	}
+
+	response(w, modelJSONSchema(result), http.StatusOK)
+}
+
+func (api *api) DeleteJSONSchema(w http.ResponseWriter, r *http.Request, name string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	if err := api.service.DeleteJSONSchema(ctx, name); err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Delete json schema failed.")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,73 @@
+package rapi_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/service"
+	"github.com/mahmudindes/orenocomic-donoengine/internal/testsupport"
+)
+
+func TestJSONSchemaComicAdditionals(t *testing.T) {
+	svr := testsupport.StartServer(t, testsupport.Config{})
+	admin := svr.PermissionToken(t, "admin")
+	comic := svr.PermissionToken(t, "comic.write")
+
+	schema := map[string]any{
+		"type":       "object",
+		"properties": map[string]any{"source": map[string]any{"type": "string"}},
+		"required":   []string{"source"},
+	}
+	svr.Run(t, []testsupport.Case{
+		{Name: "add comic without schema", Method: http.MethodPost, Path: "/api/v0/comics", Token: comic,
+			Body: map[string]any{"code": "aaaaaaaa"}, Status: http.StatusCreated},
+		{Name: "set schema not admin", Method: http.MethodPut, Path: "/api/v0/json-schemas/comic_additionals", Token: comic,
+			Body: map[string]any{"schema": schema}, Status: http.StatusBadRequest, Check: checkError("Missing admin permission")},
+		{Name: "set schema unknown name", Method: http.MethodPut, Path: "/api/v0/json-schemas/unknown", Token: admin,
+			Body: map[string]any{"schema": schema}, Status: http.StatusBadRequest, Check: checkError("Name is not a known json schema.")},
+		{Name: "set schema invalid", Method: http.MethodPut, Path: "/api/v0/json-schemas/comic_additionals", Token: admin,
+			Body: map[string]any{"schema": map[string]any{"type": 5}}, Status: http.StatusBadRequest,
+			Check: checkError("Schema is not valid")},
+		{Name: "set schema remote ref", Method: http.MethodPut, Path: "/api/v0/json-schemas/comic_additionals", Token: admin,
+			Body: map[string]any{"schema": map[string]any{"$ref": "http://example.com/schema.json"}}, Status: http.StatusBadRequest},
+		{Name: "set schema", Method: http.MethodPut, Path: "/api/v0/json-schemas/comic_additionals", Token: admin,
+			Body: map[string]any{"schema": schema}, Status: http.StatusOK, Check: checkID(1)},
+		{Name: "get schema", Method: http.MethodGet, Path: "/api/v0/json-schemas/comic_additionals", Status: http.StatusOK},
+
+		{Name: "add comic missing required", Method: http.MethodPost, Path: "/api/v0/comics", Token: comic,
+			Body: map[string]any{"code": "bbbbbbbb"}, Status: http.StatusBadRequest,
+			Check: checkError("Additionals missing properties: 'source'.")},
+		{Name: "add comic wrong type", Method: http.MethodPost, Path: "/api/v0/comics", Token: comic,
+			Body: map[string]any{"code": "bbbbbbbb", "additionals": map[string]any{"source": 1}}, Status: http.StatusBadRequest,
+			Check: checkError("Additionals source expected string")},
+		{Name: "add comic", Method: http.MethodPost, Path: "/api/v0/comics", Token: comic,
+			Body: map[string]any{"code": "bbbbbbbb", "additionals": map[string]any{"source": "web"}}, Status: http.StatusCreated},
+		{Name: "update comic remove required", Method: http.MethodPatch, Path: "/api/v0/comics/bbbbbbbb", Token: comic,
+			Body: map[string]any{"additionals": map[string]any{"source": nil}}, Status: http.StatusBadRequest},
+		{Name: "update comic", Method: http.MethodPatch, Path: "/api/v0/comics/bbbbbbbb", Token: comic,
+			Body: map[string]any{"additionals": map[string]any{"source": "print"}}, Status: http.StatusOK},
+
+		{Name: "delete schema", Method: http.MethodDelete, Path: "/api/v0/json-schemas/comic_additionals", Token: admin,
+			Status: http.StatusNoContent},
+		{Name: "get schema deleted", Method: http.MethodGet, Path: "/api/v0/json-schemas/comic_additionals", Status: http.StatusNotFound},
+		{Name: "add comic after delete", Method: http.MethodPost, Path: "/api/v0/comics", Token: comic,
+			Body: map[string]any{"code": "cccccccc"}, Status: http.StatusCreated},
+	})
+}
+
+func TestJSONSchemaConfigPrecedence(t *testing.T) {
+	svr := testsupport.StartServer(t, testsupport.Config{Service: service.Config{
+		ComicAdditionalsSchema: map[string]any{"required": []any{"source"}},
+	}})
+	admin := svr.PermissionToken(t, "admin")
+	comic := svr.PermissionToken(t, "comic.write")
+
+	svr.Run(t, []testsupport.Case{
+		{Name: "set schema", Method: http.MethodPut, Path: "/api/v0/json-schemas/comic_additionals", Token: admin,
+			Body: map[string]any{"schema": map[string]any{}}, Status: http.StatusOK},
+		{Name: "add comic missing required", Method: http.MethodPost, Path: "/api/v0/comics", Token: comic,
+			Body: map[string]any{"code": "aaaaaaaa"}, Status: http.StatusBadRequest},
+		{Name: "add comic", Method: http.MethodPost, Path: "/api/v0/comics", Token: comic,
+			Body: map[string]any{"code": "aaaaaaaa", "additionals": map[string]any{"source": "web"}}, Status: http.StatusCreated},
+	})
+}
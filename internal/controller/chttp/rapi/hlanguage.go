@@ -5,10 +5,21 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
 )
 
+func modelLanguage(m *model.Language) Language {
+	return Language{
+		ID:        m.ID,
+		IETF:      m.IETF,
+		Name:      m.Name,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
 func (api *api) AddLanguage(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	log := api.logger.WithContext(ctx)
@@ -52,13 +63,7 @@ func (api *api) AddLanguage(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.Header().Set("Location", r.URL.Path+"/"+result.IETF)
-	response(w, Language{
-		ID:        result.ID,
-		IETF:      result.IETF,
-		Name:      result.Name,
-		CreatedAt: result.CreatedAt,
-		UpdatedAt: result.UpdatedAt,
-	}, http.StatusCreated)
+	responseEntity(w, modelLanguage(result), http.StatusCreated)
 }
 
 func (api *api) GetLanguage(w http.ResponseWriter, r *http.Request, ietf string) {
@@ -72,13 +77,7 @@ func (api *api) GetLanguage(w http.ResponseWriter, r *http.Request, ietf string)
 		return
 	}
 
-	response(w, Language{
-		ID:        result.ID,
-		IETF:      result.IETF,
-		Name:      result.Name,
-		CreatedAt: result.CreatedAt,
-		UpdatedAt: result.UpdatedAt,
-	}, http.StatusOK)
+	api.responseCached(w, r, cacheLanguage, modelLanguage(result), lastModified(result.CreatedAt, result.UpdatedAt))
 }
 
 func (api *api) UpdateLanguage(w http.ResponseWriter, r *http.Request, ietf string) {
@@ -116,6 +115,11 @@ func (api *api) UpdateLanguage(w http.ResponseWriter, r *http.Request, ietf stri
 		}
 	}
 
+	ctx = api.precondition(r, true, func(v any) (string, time.Time) {
+		current := v.(*model.Language)
+		return entityTag(modelLanguage(current)), lastModified(current.CreatedAt, current.UpdatedAt)
+	})
+
 	result := new(model.Language)
 	if err := api.service.UpdateLanguageByIETF(ctx, ietf, data, result); err != nil {
 		if errors.As(err, &model.ErrNotFound) {
@@ -129,19 +133,18 @@ func (api *api) UpdateLanguage(w http.ResponseWriter, r *http.Request, ietf stri
 	}
 
 	w.Header().Set("Location", r.URL.Path+"/"+result.IETF)
-	response(w, Language{
-		ID:        result.ID,
-		IETF:      result.IETF,
-		Name:      result.Name,
-		CreatedAt: result.CreatedAt,
-		UpdatedAt: result.UpdatedAt,
-	}, http.StatusOK)
+	responseEntity(w, modelLanguage(result), http.StatusOK)
 }
 
 func (api *api) DeleteLanguage(w http.ResponseWriter, r *http.Request, ietf string) {
 	ctx := r.Context()
 	log := api.logger.WithContext(ctx)
 
+	ctx = api.precondition(r, true, func(v any) (string, time.Time) {
+		current := v.(*model.Language)
+		return entityTag(modelLanguage(current)), lastModified(current.CreatedAt, current.UpdatedAt)
+	})
+
 	if err := api.service.DeleteLanguageByIETF(ctx, ietf); err != nil {
 		responseServiceErr(w, err)
 		log.ErrMessage(err, "Delete language failed.")
@@ -163,6 +166,16 @@ func (api *api) ListLanguage(w http.ResponseWriter, r *http.Request, params List
 		pagination.Limit = *params.Limit
 	}
 
+	if params.Cursor != nil {
+		cursor, err := api.decodeCursor(*params.Cursor)
+		if err != nil {
+			responseErr(w, "Bad pagination cursor.", http.StatusBadRequest)
+			log.ErrMessage(err, "List language decode cursor failed.")
+			return
+		}
+		pagination.Cursor = cursor
+	}
+
 	var orderBys model.OrderBys
 	if params.OrderBy != nil {
 		orderBys = queryOrderBys(*params.OrderBy)
@@ -179,7 +192,7 @@ func (api *api) ListLanguage(w http.ResponseWriter, r *http.Request, params List
 		totalCountCh <- count
 	}()
 
-	result0, err := api.service.ListLanguage(ctx, model.ListParams{
+	result0, next, err := api.service.ListLanguage(ctx, model.ListParams{
 		OrderBys:   orderBys,
 		Pagination: &pagination,
 	})
@@ -189,18 +202,27 @@ func (api *api) ListLanguage(w http.ResponseWriter, r *http.Request, params List
 		return
 	}
 
+	totalCount := <-totalCountCh
 	wHeader := w.Header()
-	wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	wHeader.Set("X-Total-Count", strconv.Itoa(totalCount))
 	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	nextCursor := ""
+	if next != nil {
+		cursor, err := api.encodeCursor(next)
+		if err != nil {
+			log.ErrMessage(err, "List language encode cursor failed.")
+		} else {
+			nextCursor = cursor
+			wHeader.Set("X-Pagination-Next-Cursor", cursor)
+		}
+	}
+	if link := paginationLink(r, pagination, totalCount, nextCursor); link != "" {
+		wHeader.Set("Link", link)
+	}
 	var result []Language
 	for _, r := range result0 {
-		result = append(result, Language{
-			ID:        r.ID,
-			IETF:      r.IETF,
-			Name:      r.Name,
-			CreatedAt: r.CreatedAt,
-			UpdatedAt: r.UpdatedAt,
-		})
+		result = append(result, modelLanguage(r))
 	}
+	api.cacheControl(w, cacheLanguage)
 	response(w, result, http.StatusOK)
 }
@@ -0,0 +1,64 @@
+package rapi_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/testsupport"
+)
+
+func TestPrecondition(t *testing.T) {
+	svr := testsupport.StartServer(t, testsupport.Config{})
+	website := svr.PermissionToken(t, "website.write")
+	comic := svr.PermissionToken(t, "comic.write", "comic.delete")
+
+	var websiteTag, comicTag string
+	saveTag := func(tag *string) func(t *testing.T, res *http.Response, body []byte) {
+		return func(t *testing.T, res *http.Response, body []byte) {
+			if *tag = res.Header.Get("ETag"); *tag == "" {
+				t.Fatal("missing etag")
+			}
+		}
+	}
+	svr.Run(t, []testsupport.Case{
+		{Name: "add website", Method: http.MethodPost, Path: "/api/v0/websites", Token: website,
+			Body: map[string]any{"domain": "example.com", "name": "Example"}, Status: http.StatusCreated},
+		{Name: "get website", Method: http.MethodGet, Path: "/api/v0/websites/example.com", Status: http.StatusOK,
+			Check: saveTag(&websiteTag)},
+		{Name: "add comic", Method: http.MethodPost, Path: "/api/v0/comics", Token: comic,
+			Body: map[string]any{"code": "abcdefgh"}, Status: http.StatusCreated},
+		{Name: "get comic", Method: http.MethodGet, Path: "/api/v0/comics/abcdefgh", Status: http.StatusOK,
+			Check: saveTag(&comicTag)},
+	})
+
+	past := http.Header{"If-Unmodified-Since": {time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat)}}
+	svr.Run(t, []testsupport.Case{
+		{Name: "update website stale etag", Method: http.MethodPatch, Path: "/api/v0/websites/example.com", Token: website,
+			Header: http.Header{"If-Match": {`"stale"`}}, Body: map[string]any{"name": "Stale"},
+			Status: http.StatusPreconditionFailed, Check: checkError("Precondition failed.")},
+		{Name: "update website unmodified since", Method: http.MethodPatch, Path: "/api/v0/websites/example.com", Token: website,
+			Header: past, Body: map[string]any{"name": "Stale"}, Status: http.StatusPreconditionFailed},
+		{Name: "update website", Method: http.MethodPatch, Path: "/api/v0/websites/example.com", Token: website,
+			Header: http.Header{"If-Match": {websiteTag}}, Body: map[string]any{"name": "Fresh"}, Status: http.StatusOK},
+		{Name: "delete website old etag", Method: http.MethodDelete, Path: "/api/v0/websites/example.com", Token: website,
+			Header: http.Header{"If-Match": {websiteTag}}, Status: http.StatusPreconditionFailed},
+		{Name: "update website missing", Method: http.MethodPatch, Path: "/api/v0/websites/missing.com", Token: website,
+			Header: http.Header{"If-Match": {"*"}}, Body: map[string]any{"name": "Missing"}, Status: http.StatusPreconditionFailed},
+		{Name: "delete website any", Method: http.MethodDelete, Path: "/api/v0/websites/example.com", Token: website,
+			Header: http.Header{"If-Match": {"*"}}, Status: http.StatusNoContent},
+
+		{Name: "update comic unmodified since ignored", Method: http.MethodPatch, Path: "/api/v0/comics/abcdefgh", Token: comic,
+			Header: past, Body: map[string]any{"publishedFrom": "2020-01-01T00:00:00Z"}, Status: http.StatusOK},
+		{Name: "update comic old etag", Method: http.MethodPatch, Path: "/api/v0/comics/abcdefgh", Token: comic,
+			Header: http.Header{"If-Match": {comicTag}}, Body: map[string]any{"publishedFrom": "2021-01-01T00:00:00Z"},
+			Status: http.StatusPreconditionFailed},
+		{Name: "get comic updated", Method: http.MethodGet, Path: "/api/v0/comics/abcdefgh", Status: http.StatusOK,
+			Check: saveTag(&comicTag)},
+	})
+
+	svr.Run(t, []testsupport.Case{
+		{Name: "delete comic", Method: http.MethodDelete, Path: "/api/v0/comics/abcdefgh", Token: comic,
+			Header: http.Header{"If-Match": {comicTag}}, Status: http.StatusNoContent},
+	})
+}
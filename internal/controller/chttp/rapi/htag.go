@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
 	"github.com/mahmudindes/orenocomic-donoengine/internal/utila"
@@ -69,7 +70,7 @@ func (api *api) AddTag(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.Header().Set("Location", r.URL.Path+"/"+utila.Utoa(result.TypeID)+"-"+result.Code)
-	response(w, modelTag(result), http.StatusCreated)
+	responseEntity(w, modelTag(result), http.StatusCreated)
 }
 
 func (api *api) GetTag(w http.ResponseWriter, r *http.Request, typeID uint, code string) {
@@ -86,7 +87,7 @@ func (api *api) GetTag(w http.ResponseWriter, r *http.Request, typeID uint, code
 		return
 	}
 
-	response(w, modelTag(result), http.StatusOK)
+	api.responseCached(w, r, cacheTag, modelTag(result), lastModified(result.CreatedAt, result.UpdatedAt))
 }
 
 func (api *api) UpdateTag(w http.ResponseWriter, r *http.Request, typeID uint, code string) {
@@ -128,6 +129,11 @@ func (api *api) UpdateTag(w http.ResponseWriter, r *http.Request, typeID uint, c
 		}
 	}
 
+	ctx = api.precondition(r, true, func(v any) (string, time.Time) {
+		current := v.(*model.Tag)
+		return entityTag(modelTag(current)), lastModified(current.CreatedAt, current.UpdatedAt)
+	})
+
 	result := new(model.Tag)
 	if err := api.service.UpdateTagBySID(ctx, model.TagSID{
 		TypeID: &typeID,
@@ -144,13 +150,18 @@ func (api *api) UpdateTag(w http.ResponseWriter, r *http.Request, typeID uint, c
 	}
 
 	w.Header().Set("Location", r.URL.Path+"/"+utila.Utoa(result.TypeID)+"-"+result.Code)
-	response(w, modelTag(result), http.StatusOK)
+	responseEntity(w, modelTag(result), http.StatusOK)
 }
 
 func (api *api) DeleteTag(w http.ResponseWriter, r *http.Request, typeID uint, code string) {
 	ctx := r.Context()
 	log := api.logger.WithContext(ctx)
 
+	ctx = api.precondition(r, true, func(v any) (string, time.Time) {
+		current := v.(*model.Tag)
+		return entityTag(modelTag(current)), lastModified(current.CreatedAt, current.UpdatedAt)
+	})
+
 	if err := api.service.DeleteTagBySID(ctx, model.TagSID{
 		TypeID: &typeID,
 		Code:   code,
@@ -163,6 +174,23 @@ func (api *api) DeleteTag(w http.ResponseWriter, r *http.Request, typeID uint, c
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func (api *api) RestoreTag(w http.ResponseWriter, r *http.Request, typeID uint, code string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	result := new(model.Tag)
+	if err := api.service.RestoreTagBySID(ctx, model.TagSID{
+		TypeID: &typeID,
+		Code:   code,
+	}, result); err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Restore tag failed.")
+		return
+	}
+
+	responseEntity(w, modelTag(result), http.StatusOK)
+}
+
 func (api *api) ListTag(w http.ResponseWriter, r *http.Request, params ListTagParams) {
 	ctx := r.Context()
 	log := api.logger.WithContext(ctx)
@@ -175,6 +203,16 @@ func (api *api) ListTag(w http.ResponseWriter, r *http.Request, params ListTagPa
 		pagination.Limit = *params.Limit
 	}
 
+	if params.Cursor != nil {
+		cursor, err := api.decodeCursor(*params.Cursor)
+		if err != nil {
+			responseErr(w, "Bad pagination cursor.", http.StatusBadRequest)
+			log.ErrMessage(err, "List tag decode cursor failed.")
+			return
+		}
+		pagination.Cursor = cursor
+	}
+
 	var orderBys model.OrderBys
 	if params.OrderBy != nil {
 		orderBys = queryOrderBys(*params.OrderBy)
@@ -191,7 +229,7 @@ func (api *api) ListTag(w http.ResponseWriter, r *http.Request, params ListTagPa
 		totalCountCh <- count
 	}()
 
-	result0, err := api.service.ListTag(ctx, model.ListParams{
+	result0, next, err := api.service.ListTag(ctx, model.ListParams{
 		OrderBys:   orderBys,
 		Pagination: &pagination,
 	})
@@ -201,12 +239,27 @@ func (api *api) ListTag(w http.ResponseWriter, r *http.Request, params ListTagPa
 		return
 	}
 
+	totalCount := <-totalCountCh
 	wHeader := w.Header()
-	wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	wHeader.Set("X-Total-Count", strconv.Itoa(totalCount))
 	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	nextCursor := ""
+	if next != nil {
+		cursor, err := api.encodeCursor(next)
+		if err != nil {
+			log.ErrMessage(err, "List tag encode cursor failed.")
+		} else {
+			nextCursor = cursor
+			wHeader.Set("X-Pagination-Next-Cursor", cursor)
+		}
+	}
+	if link := paginationLink(r, pagination, totalCount, nextCursor); link != "" {
+		wHeader.Set("Link", link)
+	}
 	var result []Tag
 	for _, r := range result0 {
 		result = append(result, modelTag(r))
 	}
+	api.cacheControl(w, cacheTag)
 	response(w, result, http.StatusOK)
 }
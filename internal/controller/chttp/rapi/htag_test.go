@@ -0,0 +1,50 @@
+package rapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/testsupport"
+)
+
+func TestListTagCursor(t *testing.T) {
+	svr := testsupport.StartServer(t, testsupport.Config{})
+	token := svr.PermissionToken(t, "taxonomy.write")
+
+	cases := []testsupport.Case{
+		{Name: "add tag type", Method: http.MethodPost, Path: "/api/v0/types/tags", Token: token,
+			Body: map[string]any{"code": "genre", "name": "Genre"}, Status: http.StatusCreated},
+	}
+	for _, code := range []string{"action", "comedy", "drama"} {
+		cases = append(cases, testsupport.Case{Name: "add tag " + code, Method: http.MethodPost, Path: "/api/v0/tags", Token: token,
+			Body: map[string]any{"typeID": 1, "code": code, "name": code}, Status: http.StatusCreated})
+	}
+	var cursor string
+	cases = append(cases, testsupport.Case{Name: "first page", Method: http.MethodGet, Path: "/api/v0/tags?limit=2", Status: http.StatusOK,
+		Check: func(t *testing.T, res *http.Response, body []byte) {
+			if cursor = res.Header.Get("X-Pagination-Next-Cursor"); cursor == "" {
+				t.Fatal("missing next cursor")
+			}
+		}})
+	svr.Run(t, cases)
+
+	svr.Run(t, []testsupport.Case{
+		{Name: "next page", Method: http.MethodGet, Path: "/api/v0/tags?limit=2&cursor=" + cursor, Status: http.StatusOK,
+			Check: func(t *testing.T, res *http.Response, body []byte) {
+				var data []map[string]any
+				if err := json.Unmarshal(body, &data); err != nil {
+					t.Fatalf("decode body: %v", err)
+				}
+				if len(data) != 1 || data[0]["code"] != "drama" {
+					t.Errorf("next page: expected drama got %s", body)
+				}
+			}},
+		{Name: "other order", Method: http.MethodGet, Status: http.StatusBadRequest,
+			Path: "/api/v0/tags?limit=2&cursor=" + cursor + "&order_by=" + url.QueryEscape("code sort=desc")},
+		{Name: "other field", Method: http.MethodGet, Status: http.StatusBadRequest,
+			Path: "/api/v0/tags?limit=2&cursor=" + cursor + "&order_by=name"},
+		{Name: "bad cursor", Method: http.MethodGet, Path: "/api/v0/tags?limit=2&cursor=bad", Status: http.StatusBadRequest},
+	})
+}
@@ -0,0 +1,72 @@
+package rapi
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+func modelTrash(m *model.Trash) Trash {
+	return Trash{
+		Type:      m.Type,
+		ID:        m.ID,
+		Code:      m.Code,
+		TypeID:    m.TypeID,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+		DeletedAt: m.DeletedAt,
+	}
+}
+
+func (api *api) ListTrash(w http.ResponseWriter, r *http.Request, params ListTrashParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	pagination := model.Pagination{Page: 1, Limit: model.TrashPaginationDef}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+
+	var conditions any
+	if params.Type != nil {
+		conditions = map[string]any{model.DBTrashType: *params.Type}
+	}
+
+	totalCountCh := make(chan int, 1)
+	go func() {
+		count, err := api.service.CountTrash(ctx, conditions)
+		if err != nil {
+			totalCountCh <- -1
+			log.ErrMessage(err, "Count trash failed.")
+			return
+		}
+		totalCountCh <- count
+	}()
+
+	result0, err := api.service.ListTrash(ctx, model.ListParams{
+		Conditions: conditions,
+		Pagination: &pagination,
+	})
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "List trash failed.")
+		return
+	}
+
+	totalCount := <-totalCountCh
+	wHeader := w.Header()
+	wHeader.Set("X-Total-Count", strconv.Itoa(totalCount))
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	if link := paginationLink(r, pagination, totalCount, ""); link != "" {
+		wHeader.Set("Link", link)
+	}
+	result := []Trash{}
+	for _, r := range result0 {
+		result = append(result, modelTrash(r))
+	}
+	response(w, result, http.StatusOK)
+}
@@ -0,0 +1,95 @@
+package rapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/testsupport"
+)
+
+func checkID(id float64) func(t *testing.T, res *http.Response, body []byte) {
+	return func(t *testing.T, res *http.Response, body []byte) {
+		var data map[string]any
+		if err := json.Unmarshal(body, &data); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if data["id"] != id {
+			t.Errorf("id: expected %v got %v", id, data["id"])
+		}
+	}
+}
+
+func checkError(message string) func(t *testing.T, res *http.Response, body []byte) {
+	return func(t *testing.T, res *http.Response, body []byte) {
+		if !strings.Contains(string(body), message) {
+			t.Errorf("error: expected %q in %s", message, body)
+		}
+	}
+}
+
+func checkEmptyList(t *testing.T, res *http.Response, body []byte) {
+	if got := strings.TrimSpace(string(body)); got != "[]" {
+		t.Errorf("body: expected [] got %s", got)
+	}
+}
+
+func TestListTrashEmpty(t *testing.T) {
+	svr := testsupport.StartServer(t, testsupport.Config{})
+	admin := svr.PermissionToken(t, "admin")
+
+	svr.Run(t, []testsupport.Case{
+		{Name: "list", Method: http.MethodGet, Path: "/api/v0/trash", Token: admin,
+			Status: http.StatusOK, Check: checkEmptyList},
+	})
+}
+
+func TestSoftDeleteReuseCode(t *testing.T) {
+	svr := testsupport.StartServer(t, testsupport.Config{})
+	taxonomy := svr.PermissionToken(t, "taxonomy.write")
+	comic := svr.PermissionToken(t, "comic.write", "comic.delete")
+
+	svr.Run(t, []testsupport.Case{
+		{Name: "add tag type", Method: http.MethodPost, Path: "/api/v0/types/tags", Token: taxonomy,
+			Body: map[string]any{"code": "genre", "name": "Genre"}, Status: http.StatusCreated},
+		{Name: "add tag", Method: http.MethodPost, Path: "/api/v0/tags", Token: taxonomy,
+			Body: map[string]any{"typeID": 1, "code": "action", "name": "Action"}, Status: http.StatusCreated,
+			Check: checkID(1)},
+		{Name: "delete tag", Method: http.MethodDelete, Path: "/api/v0/tags/1-action", Token: taxonomy,
+			Status: http.StatusNoContent},
+		{Name: "add tag deleted code", Method: http.MethodPost, Path: "/api/v0/tags", Token: taxonomy,
+			Body: map[string]any{"typeID": 1, "code": "action", "name": "Action"}, Status: http.StatusCreated,
+			Check: checkID(2)},
+		{Name: "add tag live code", Method: http.MethodPost, Path: "/api/v0/tags", Token: taxonomy,
+			Body: map[string]any{"typeID": 1, "code": "action", "name": "Action"}, Status: http.StatusBadRequest},
+		{Name: "restore tag live code", Method: http.MethodPost, Path: "/api/v0/tags/1-action/restore", Token: taxonomy,
+			Status: http.StatusBadRequest, Check: checkError("Same type id + code already exists.")},
+		{Name: "delete tag again", Method: http.MethodDelete, Path: "/api/v0/tags/1-action", Token: taxonomy,
+			Status: http.StatusNoContent},
+		{Name: "restore tag latest", Method: http.MethodPost, Path: "/api/v0/tags/1-action/restore", Token: taxonomy,
+			Status: http.StatusOK, Check: checkID(2)},
+		{Name: "restore tag older live code", Method: http.MethodPost, Path: "/api/v0/tags/1-action/restore", Token: taxonomy,
+			Status: http.StatusBadRequest},
+
+		{Name: "add category type", Method: http.MethodPost, Path: "/api/v0/types/categories", Token: taxonomy,
+			Body: map[string]any{"code": "format", "name": "Format"}, Status: http.StatusCreated},
+		{Name: "add category", Method: http.MethodPost, Path: "/api/v0/categories", Token: taxonomy,
+			Body: map[string]any{"typeID": 1, "code": "manga", "name": "Manga"}, Status: http.StatusCreated},
+		{Name: "delete category", Method: http.MethodDelete, Path: "/api/v0/categories/1-manga", Token: taxonomy,
+			Status: http.StatusNoContent},
+		{Name: "add category deleted code", Method: http.MethodPost, Path: "/api/v0/categories", Token: taxonomy,
+			Body: map[string]any{"typeID": 1, "code": "manga", "name": "Manga"}, Status: http.StatusCreated},
+		{Name: "restore category live code", Method: http.MethodPost, Path: "/api/v0/categories/1-manga/restore", Token: taxonomy,
+			Status: http.StatusBadRequest, Check: checkError("Same type id + code already exists.")},
+
+		{Name: "add comic", Method: http.MethodPost, Path: "/api/v0/comics", Token: comic,
+			Body: map[string]any{"code": "abcdefgh"}, Status: http.StatusCreated},
+		{Name: "delete comic", Method: http.MethodDelete, Path: "/api/v0/comics/abcdefgh", Token: comic,
+			Status: http.StatusNoContent},
+		{Name: "add comic deleted code", Method: http.MethodPost, Path: "/api/v0/comics", Token: comic,
+			Body: map[string]any{"code": "abcdefgh"}, Status: http.StatusCreated},
+		{Name: "restore comic live code", Method: http.MethodPost, Path: "/api/v0/comics/abcdefgh/restore", Token: comic,
+			Status: http.StatusBadRequest, Check: checkError("Same code already exists.")},
+	})
+}
@@ -193,9 +193,13 @@ func (api *api) ListCategoryType(w http.ResponseWriter, r *http.Request, params
 		return
 	}
 
+	totalCount := <-totalCountCh
 	wHeader := w.Header()
-	wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	wHeader.Set("X-Total-Count", strconv.Itoa(totalCount))
 	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	if link := paginationLink(r, pagination, totalCount, ""); link != "" {
+		wHeader.Set("Link", link)
+	}
 	var result []GenericType
 	for _, r := range result0 {
 		result = append(result, GenericType{
@@ -393,9 +397,13 @@ func (api *api) ListTagType(w http.ResponseWriter, r *http.Request, params ListT
 		return
 	}
 
+	totalCount := <-totalCountCh
 	wHeader := w.Header()
-	wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	wHeader.Set("X-Total-Count", strconv.Itoa(totalCount))
 	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	if link := paginationLink(r, pagination, totalCount, ""); link != "" {
+		wHeader.Set("Link", link)
+	}
 	var result []GenericType
 	for _, r := range result0 {
 		result = append(result, GenericType{
@@ -593,9 +601,13 @@ func (api *api) ListComicRelationType(w http.ResponseWriter, r *http.Request, pa
 		return
 	}
 
+	totalCount := <-totalCountCh
 	wHeader := w.Header()
-	wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	wHeader.Set("X-Total-Count", strconv.Itoa(totalCount))
 	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	if link := paginationLink(r, pagination, totalCount, ""); link != "" {
+		wHeader.Set("Link", link)
+	}
 	var result []GenericType
 	for _, r := range result0 {
 		result = append(result, GenericType{
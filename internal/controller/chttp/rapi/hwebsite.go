@@ -5,10 +5,21 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
 )
 
+func modelWebsite(m *model.Website) Website {
+	return Website{
+		ID:        m.ID,
+		Domain:    m.Domain,
+		Name:      m.Name,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
 func (api *api) AddWebsite(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	log := api.logger.WithContext(ctx)
@@ -52,13 +63,7 @@ func (api *api) AddWebsite(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.Header().Set("Location", r.URL.Path+"/"+result.Domain)
-	response(w, Website{
-		ID:        result.ID,
-		Domain:    result.Domain,
-		Name:      result.Name,
-		CreatedAt: result.CreatedAt,
-		UpdatedAt: result.UpdatedAt,
-	}, http.StatusCreated)
+	responseEntity(w, modelWebsite(result), http.StatusCreated)
 }
 
 func (api *api) GetWebsite(w http.ResponseWriter, r *http.Request, domain string) {
@@ -72,13 +77,7 @@ func (api *api) GetWebsite(w http.ResponseWriter, r *http.Request, domain string
 		return
 	}
 
-	response(w, Website{
-		ID:        result.ID,
-		Domain:    result.Domain,
-		Name:      result.Name,
-		CreatedAt: result.CreatedAt,
-		UpdatedAt: result.UpdatedAt,
-	}, http.StatusOK)
+	api.responseCached(w, r, cacheWebsite, modelWebsite(result), lastModified(result.CreatedAt, result.UpdatedAt))
 }
 
 func (api *api) UpdateWebsite(w http.ResponseWriter, r *http.Request, domain string) {
@@ -116,6 +115,11 @@ func (api *api) UpdateWebsite(w http.ResponseWriter, r *http.Request, domain str
 		}
 	}
 
+	ctx = api.precondition(r, true, func(v any) (string, time.Time) {
+		current := v.(*model.Website)
+		return entityTag(modelWebsite(current)), lastModified(current.CreatedAt, current.UpdatedAt)
+	})
+
 	result := new(model.Website)
 	if err := api.service.UpdateWebsiteByDomain(ctx, domain, data, result); err != nil {
 		if errors.As(err, &model.ErrNotFound) {
@@ -129,19 +133,18 @@ func (api *api) UpdateWebsite(w http.ResponseWriter, r *http.Request, domain str
 	}
 
 	w.Header().Set("Location", r.URL.Path+"/"+result.Domain)
-	response(w, Website{
-		ID:        result.ID,
-		Domain:    result.Domain,
-		Name:      result.Name,
-		CreatedAt: result.CreatedAt,
-		UpdatedAt: result.UpdatedAt,
-	}, http.StatusOK)
+	responseEntity(w, modelWebsite(result), http.StatusOK)
 }
 
 func (api *api) DeleteWebsite(w http.ResponseWriter, r *http.Request, domain string) {
 	ctx := r.Context()
 	log := api.logger.WithContext(ctx)
 
+	ctx = api.precondition(r, true, func(v any) (string, time.Time) {
+		current := v.(*model.Website)
+		return entityTag(modelWebsite(current)), lastModified(current.CreatedAt, current.UpdatedAt)
+	})
+
 	if err := api.service.DeleteWebsiteByDomain(ctx, domain); err != nil {
 		responseServiceErr(w, err)
 		log.ErrMessage(err, "Delete website failed.")
@@ -163,6 +166,16 @@ func (api *api) ListWebsite(w http.ResponseWriter, r *http.Request, params ListW
 		pagination.Limit = *params.Limit
 	}
 
+	if params.Cursor != nil {
+		cursor, err := api.decodeCursor(*params.Cursor)
+		if err != nil {
+			responseErr(w, "Bad pagination cursor.", http.StatusBadRequest)
+			log.ErrMessage(err, "List website decode cursor failed.")
+			return
+		}
+		pagination.Cursor = cursor
+	}
+
 	var orderBys model.OrderBys
 	if params.OrderBy != nil {
 		orderBys = queryOrderBys(*params.OrderBy)
@@ -179,7 +192,7 @@ func (api *api) ListWebsite(w http.ResponseWriter, r *http.Request, params ListW
 		totalCountCh <- count
 	}()
 
-	result0, err := api.service.ListWebsite(ctx, model.ListParams{
+	result0, next, err := api.service.ListWebsite(ctx, model.ListParams{
 		OrderBys:   orderBys,
 		Pagination: &pagination,
 	})
@@ -189,18 +202,27 @@ func (api *api) ListWebsite(w http.ResponseWriter, r *http.Request, params ListW
 		return
 	}
 
+	totalCount := <-totalCountCh
 	wHeader := w.Header()
-	wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	wHeader.Set("X-Total-Count", strconv.Itoa(totalCount))
 	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	nextCursor := ""
+	if next != nil {
+		cursor, err := api.encodeCursor(next)
+		if err != nil {
+			log.ErrMessage(err, "List website encode cursor failed.")
+		} else {
+			nextCursor = cursor
+			wHeader.Set("X-Pagination-Next-Cursor", cursor)
+		}
+	}
+	if link := paginationLink(r, pagination, totalCount, nextCursor); link != "" {
+		wHeader.Set("Link", link)
+	}
 	var result []Website
 	for _, r := range result0 {
-		result = append(result, Website{
-			ID:        r.ID,
-			Domain:    r.Domain,
-			Name:      r.Name,
-			CreatedAt: r.CreatedAt,
-			UpdatedAt: r.UpdatedAt,
-		})
+		result = append(result, modelWebsite(r))
 	}
+	api.cacheControl(w, cacheWebsite)
 	response(w, result, http.StatusOK)
 }
@@ -30,6 +30,18 @@ func (api *api) Authentication(ctx context.Context, input *openapi3filter.Authen
 			return errors.New("bearer authentication invalid")
 		}
 		return nil
+	case SecuritySchemeApiKey:
+		valid, err := api.service.ProcessAPIKeyContext(ctx)
+		switch {
+		case errors.As(err, &model.ErrGeneric):
+			return fmt.Errorf("api key authentication failed: %w", err)
+		case err != nil:
+			api.logger.ErrMessage(err, "API key authentication proccess context failed.")
+			return errors.New("api key authentication failed")
+		case !valid:
+			return errors.New("api key authentication invalid")
+		}
+		return nil
 	}
 	return fmt.Errorf("security scheme %s is not supported", input.SecuritySchemeName)
 }
@@ -1,6 +1,7 @@
 package utilb
 
 import (
+	"net/http"
 	"slices"
 	"sort"
 	"strconv"
@@ -120,3 +121,35 @@ func HeaderAcceptFirst(v string, st ...string) string {
 	}
 	return ""
 }
+
+func HeaderAddVary(h http.Header, fields ...string) {
+	vary := []string{}
+	for _, v := range h.Values("Vary") {
+		for _, f := range strings.Split(v, ",") {
+			if f = strings.TrimSpace(f); f != "" {
+				vary = append(vary, f)
+			}
+		}
+	}
+	for _, f := range fields {
+		if !slices.ContainsFunc(vary, func(v string) bool { return strings.EqualFold(v, f) }) {
+			vary = append(vary, f)
+		}
+	}
+	if len(vary) > 0 {
+		h.Set("Vary", strings.Join(vary, ", "))
+	}
+}
+
+func HeaderPrefer(h http.Header, preference string) bool {
+	for _, v := range h.Values("Prefer") {
+		for _, p := range strings.Split(v, ",") {
+			p, _, _ = strings.Cut(p, ";")
+			p, _, _ = strings.Cut(p, "=")
+			if strings.EqualFold(strings.TrimSpace(p), preference) {
+				return true
+			}
+		}
+	}
+	return false
+}
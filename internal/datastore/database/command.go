@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 
+	"github.com/georgysavva/scany/v2/dbscan"
 	"github.com/georgysavva/scany/v2/pgxscan"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
+	"modernc.org/sqlite"
 
 	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
 )
@@ -20,6 +22,11 @@ const (
 type ctxTXClient struct{}
 
 func (db Database) Exec(ctx context.Context, sql string, args ...any) error {
+	if db.sqlite != nil {
+		_, err := db.sqliteClient(ctx).ExecContext(ctx, sql, sqliteArgs(args)...)
+		return databaseError(err)
+	}
+
 	var client interface {
 		Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
 	} = db.client
@@ -33,6 +40,14 @@ func (db Database) Exec(ctx context.Context, sql string, args ...any) error {
 }
 
 func (db Database) QueryAll(ctx context.Context, dst any, sql string, args ...any) error {
+	if db.sqlite != nil {
+		rows, err := db.sqliteQuery(ctx, sql, args)
+		if err != nil {
+			return err
+		}
+		return databaseError(sqliteScanAPI.ScanAll(dst, rows))
+	}
+
 	var client interface {
 		Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
 	} = db.client
@@ -58,6 +73,21 @@ func (db Database) QueryAll(ctx context.Context, dst any, sql string, args ...an
 }
 
 func (db Database) QueryOne(ctx context.Context, dst any, sql string, args ...any) error {
+	if db.sqlite != nil {
+		rows, err := db.sqliteQuery(ctx, sql, args)
+		if err != nil {
+			return err
+		}
+		if err := sqliteScanAPI.ScanOne(dst, rows); err != nil {
+			if dbscan.NotFound(err) {
+				return model.NotFoundError(err)
+			}
+
+			return databaseError(err)
+		}
+		return nil
+	}
+
 	var client interface {
 		Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
 	} = db.client
@@ -83,6 +113,11 @@ func (db Database) QueryOne(ctx context.Context, dst any, sql string, args ...an
 }
 
 func databaseError(err error) error {
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteError(sqliteErr)
+	}
+
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) {
 		switch pgErr.Code {
@@ -102,6 +137,10 @@ func databaseError(err error) error {
 }
 
 func (db Database) ContextTransactionBegin(ctx context.Context) (context.Context, error) {
+	if db.sqlite != nil {
+		return db.sqliteTransactionBegin(ctx)
+	}
+
 	if tx, ok := ctx.Value(ctxTXClient{}).(pgx.Tx); ok {
 		tx, err := tx.Begin(ctx)
 		if err != nil {
@@ -118,6 +157,9 @@ func (db Database) ContextTransactionBegin(ctx context.Context) (context.Context
 }
 
 func (db Database) ContextTransactionCommit(ctx context.Context) error {
+	if tx, ok := ctx.Value(ctxTXClient{}).(*sqliteTX); ok {
+		return tx.commit(ctx)
+	}
 	if tx, ok := ctx.Value(ctxTXClient{}).(pgx.Tx); ok {
 		return tx.Commit(ctx)
 	}
@@ -125,6 +167,9 @@ func (db Database) ContextTransactionCommit(ctx context.Context) error {
 }
 
 func (db Database) ContextTransactionRollback(ctx context.Context) error {
+	if tx, ok := ctx.Value(ctxTXClient{}).(*sqliteTX); ok {
+		return tx.rollback(ctx)
+	}
 	if tx, ok := ctx.Value(ctxTXClient{}).(pgx.Tx); ok {
 		return tx.Rollback(ctx)
 	}
@@ -2,8 +2,10 @@ package database
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"io/fs"
 	"strings"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -12,34 +14,56 @@ import (
 
 	donoengine "github.com/mahmudindes/orenocomic-donoengine"
 	"github.com/mahmudindes/orenocomic-donoengine/embedded"
+	"github.com/mahmudindes/orenocomic-donoengine/internal/logger"
 )
 
 type (
 	Database struct {
-		client *pgxpool.Pool
+		client   *pgxpool.Pool
+		sqlite   *sql.DB
+		dialect  Dialect
+		provider string
+		logger   logger.Logger
 	}
 
 	Config struct {
-		URL      string `conf:"url"`
-		Provider string `conf:"provider"`
+		URL         string `conf:"url"`
+		Provider    string `conf:"provider"`
+		AutoMigrate bool   `conf:"auto_migrate"`
 	}
 )
 
-func New(ctx context.Context, cfg Config) (*Database, error) {
-	config, err := pgxpool.ParseConfig(cfg.URL)
-	if err != nil {
+func New(ctx context.Context, cfg Config, log logger.Logger) (*Database, error) {
+	provider := strings.ToLower(cfg.Provider)
+	if _, err := migrationFS(provider); err != nil {
 		return nil, err
 	}
 
-	client, err := pgxpool.NewWithConfig(context.Background(), config)
-	if err != nil {
-		return nil, err
+	db := &Database{provider: provider, logger: log}
+	switch provider {
+	case "sqlite", "sqlite3":
+		db.sqlite, db.dialect = openSQLite(cfg.URL), DialectSQLite
+		if err := db.sqlite.PingContext(ctx); err != nil {
+			db.sqlite.Close()
+			return nil, err
+		}
+	default:
+		config, err := pgxpool.ParseConfig(cfg.URL)
+		if err != nil {
+			return nil, err
+		}
+
+		client, err := pgxpool.NewWithConfig(context.Background(), config)
+		if err != nil {
+			return nil, err
+		}
+		db.client = client
 	}
 
-	db := &Database{client: client}
-
-	if err := db.Migrate(ctx, strings.ToLower(cfg.Provider)); err != nil {
-		return nil, err
+	if cfg.AutoMigrate {
+		if err := db.Migrate(ctx, "up"); err != nil {
+			return nil, err
+		}
 	}
 
 	return db, nil
@@ -50,34 +74,64 @@ func (db Database) Close() error {
 		db.client.Close()
 	}
 
+	if db.sqlite != nil {
+		return db.sqlite.Close()
+	}
+
 	return nil
 }
 
-func (db Database) Migrate(ctx context.Context, provider string) error {
-	if err := goose.SetDialect("pgx"); err != nil {
-		return err
-	}
-	switch provider {
-	case "crdb", "cockroachdb":
-		goose.SetBaseFS(embedded.CRDBMigrations)
-	case "pg", "postgres", "postgresql":
-		goose.SetBaseFS(embedded.PGMigrations)
+func (db Database) Migrate(ctx context.Context, command string) error {
+	switch command {
+	case "up", "down", "status", "redo", "version":
 	default:
-		return errors.New("database provider " + provider + " not supported")
+		return errors.New("migration command " + command + " not supported")
 	}
-	goose.SetTableName(donoengine.ID + "_version")
-	goose.SetLogger(&migrationLogger{})
 
-	if err := goose.UpContext(ctx, stdlib.OpenDBFromPool(db.client), "migrations"); err != nil {
+	fsys, err := migrationFS(db.provider)
+	if err != nil {
 		return err
 	}
 
-	goose.SetBaseFS(nil)
+	dialect, table := "pgx", donoengine.ID+"_version"
+	if db.sqlite != nil {
+		// The main database of a SQLite connection does not outlive it.
+		dialect, table = "sqlite3", donoengine.ID+"."+table
+	}
+	if err := goose.SetDialect(dialect); err != nil {
+		return err
+	}
+	goose.SetBaseFS(fsys)
+	defer goose.SetBaseFS(nil)
+	goose.SetTableName(table)
+	goose.SetLogger(&migrationLogger{logger: db.logger})
+
+	client := db.sqlite
+	if client == nil {
+		client = stdlib.OpenDBFromPool(db.client)
+	}
+	return goose.RunContext(ctx, command, client, "migrations")
+}
 
-	return nil
+func migrationFS(provider string) (fs.FS, error) {
+	switch provider {
+	case "crdb", "cockroachdb":
+		return embedded.CRDBMigrations, nil
+	case "pg", "postgres", "postgresql":
+		return embedded.PGMigrations, nil
+	case "sqlite", "sqlite3":
+		return embedded.SQLiteMigrations, nil
+	}
+	return nil, errors.New("database provider " + provider + " not supported")
 }
 
-type migrationLogger struct{}
+type migrationLogger struct {
+	logger logger.Logger
+}
 
 func (ml *migrationLogger) Fatalf(format string, v ...interface{}) { panic(fmt.Sprintf(format, v...)) }
-func (ml *migrationLogger) Printf(format string, v ...interface{}) {}
+func (ml *migrationLogger) Printf(format string, v ...interface{}) {
+	if msg := strings.TrimSpace(fmt.Sprintf(format, v...)); msg != "" {
+		ml.logger.Message(msg)
+	}
+}
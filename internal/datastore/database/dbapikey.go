@@ -0,0 +1,63 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+func (db Database) AddAPIKey(ctx context.Context, data model.AddAPIKey, v *model.APIKey) error {
+	var dst any
+	if v != nil {
+		dst = v
+	}
+	return db.GenericAdd(ctx, model.DBAPIKey, map[string]any{
+		model.DBAPIKeyName:        data.Name,
+		model.DBAPIKeyPrefix:      data.Prefix,
+		model.DBAPIKeyHash:        data.Hash,
+		model.DBAPIKeyPermissions: data.Permissions,
+		model.DBAPIKeyExpiresAt:   data.ExpiresAt,
+	}, dst)
+}
+
+func (db Database) GetAPIKey(ctx context.Context, conds any) (*model.APIKey, error) {
+	var result model.APIKey
+	if err := db.GenericGet(ctx, model.DBAPIKey, conds, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (db Database) TouchAPIKey(ctx context.Context, conds any, at time.Time) error {
+	return db.GenericUpdate(ctx, model.DBAPIKey, map[string]any{
+		model.DBAPIKeyLastUsedAt: at,
+	}, conds, nil)
+}
+
+func (db Database) DeleteAPIKey(ctx context.Context, conds any, v *model.APIKey) error {
+	var dst any
+	if v != nil {
+		dst = v
+	}
+	return db.GenericDelete(ctx, model.DBAPIKey, conds, dst)
+}
+
+func (db Database) ListAPIKey(ctx context.Context, params model.ListParams) ([]*model.APIKey, error) {
+	result := []*model.APIKey{}
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericCreatedAt, Sort: "desc"})
+	}
+	params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID, Sort: "desc"})
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.APIKeyPaginationDef}
+	}
+	if err := db.GenericList(ctx, model.DBAPIKey, params, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (db Database) CountAPIKey(ctx context.Context, conds any) (int, error) {
+	return db.GenericCount(ctx, model.DBAPIKey, conds)
+}
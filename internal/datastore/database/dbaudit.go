@@ -0,0 +1,41 @@
+package database
+
+import (
+	"context"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+func (db Database) AddAudit(ctx context.Context, data model.AddAudit, v *model.Audit) error {
+	var dst any
+	if v != nil {
+		dst = v
+	}
+	return db.GenericAdd(ctx, model.DBAudit, map[string]any{
+		model.DBAuditActor:      data.Actor,
+		model.DBAuditAction:     data.Action,
+		model.DBAuditEntity:     data.Entity,
+		model.DBAuditEntityKey:  data.Key,
+		model.DBAuditDataBefore: data.Before,
+		model.DBAuditDataAfter:  data.After,
+	}, dst)
+}
+
+func (db Database) ListAudit(ctx context.Context, params model.ListParams) ([]*model.Audit, error) {
+	result := []*model.Audit{}
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericCreatedAt, Sort: "desc"})
+	}
+	params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID, Sort: "desc"})
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.AuditPaginationDef}
+	}
+	if err := db.GenericList(ctx, model.DBAudit, params, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (db Database) CountAudit(ctx context.Context, conds any) (int, error) {
+	return db.GenericCount(ctx, model.DBAudit, conds)
+}
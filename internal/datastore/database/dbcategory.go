@@ -91,23 +91,21 @@ func (db Database) AddCategory(ctx context.Context, data model.AddCategory, v *m
 	case data.TypeCode != nil:
 		typeID = model.DBCategoryTypeCodeToID(*data.TypeCode)
 	}
-	cols, vals, args := SetInsert(map[string]any{
+	cols, vals, args := db.dialect.SetInsert(map[string]any{
 		model.DBCategoryTypeID: typeID,
 		model.DBCategoryCode:   data.Code,
 		model.DBCategoryName:   data.Name,
 	})
 	sql := "INSERT INTO " + model.DBCategory + " (" + cols + ") VALUES (" + vals + ")"
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericID
-		sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBCategoryTypeID + ", w." + model.DBCategoryCode
-		sql += ", w." + model.DBCategoryName
-		sql += ", l." + model.DBCategoryTypeCode + " AS type_code"
-		sql += " FROM data w JOIN " + model.DBCategoryType + " l"
-		sql += " ON w." + model.DBCategoryTypeID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericID
+		sel += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBCategoryTypeID + ", w." + model.DBCategoryCode
+		sel += ", w." + model.DBCategoryName
+		sel += ", l." + model.DBCategoryTypeCode + " AS type_code"
+		sel += " FROM data w JOIN " + model.DBCategoryType + " l"
+		sel += " ON w." + model.DBCategoryTypeID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBCategory, sql, sel, args...); err != nil {
 			return categorySetError(err)
 		}
 	} else {
@@ -121,15 +119,8 @@ func (db Database) AddCategory(ctx context.Context, data model.AddCategory, v *m
 func (db Database) GetCategory(ctx context.Context, conds any) (*model.Category, error) {
 	var result model.Category
 	args := []any{}
-	cond := SetWhere(conds, &args)
-	sql := "SELECT * FROM (SELECT w." + model.DBGenericID
-	sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-	sql += ", w." + model.DBCategoryTypeID + ", w." + model.DBCategoryCode
-	sql += ", w." + model.DBCategoryName
-	sql += ", l." + model.DBCategoryTypeCode + " AS type_code"
-	sql += " FROM " + model.DBCategory + " w JOIN " + model.DBCategoryType + " l"
-	sql += " ON w." + model.DBCategoryTypeID + " = l." + model.DBGenericID
-	sql += ")"
+	cond := db.dialect.SetWhere(conds, &args)
+	sql := "SELECT * FROM (" + categorySelect() + ")"
 	sql += " WHERE " + cond
 	if err := db.QueryOne(ctx, &result, sql, args...); err != nil {
 		return nil, err
@@ -168,20 +159,18 @@ func (db Database) UpdateCategory(ctx context.Context, data model.SetCategory, c
 		defer db.ContextTransactionRollback(context.WithoutCancel(ctx))
 	}
 	data0[model.DBGenericUpdatedAt] = time.Now().UTC()
-	sets, args := SetUpdate(data0)
-	cond := SetWhere([]any{conds, SetUpdateWhere(data0)}, &args)
+	sets, args := db.dialect.SetUpdate(data0)
+	cond := db.dialect.SetWhere(softDeleteConds([]any{conds, db.dialect.SetUpdateWhere(data0)}), &args)
 	sql := "UPDATE " + model.DBCategory + " SET " + sets + " WHERE " + cond
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericID
-		sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBCategoryTypeID + ", w." + model.DBCategoryCode
-		sql += ", w." + model.DBCategoryName
-		sql += ", l." + model.DBCategoryTypeCode + " AS type_code"
-		sql += " FROM data w JOIN " + model.DBCategoryType + " l"
-		sql += " ON w." + model.DBCategoryTypeID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericID
+		sel += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBCategoryTypeID + ", w." + model.DBCategoryCode
+		sel += ", w." + model.DBCategoryName
+		sel += ", l." + model.DBCategoryTypeCode + " AS type_code"
+		sel += " FROM data w JOIN " + model.DBCategoryType + " l"
+		sel += " ON w." + model.DBCategoryTypeID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBCategory, sql, sel, args...); err != nil {
 			return categorySetError(err)
 		}
 	} else {
@@ -214,20 +203,18 @@ func (db Database) UpdateCategory(ctx context.Context, data model.SetCategory, c
 }
 
 func (db Database) DeleteCategory(ctx context.Context, conds any, v *model.Category) error {
-	args := []any{}
-	cond := SetWhere(conds, &args)
-	sql := "DELETE FROM " + model.DBCategory + " WHERE " + cond
+	args := []any{time.Now().UTC()}
+	cond := db.dialect.SetWhere(softDeleteConds(conds), &args)
+	sql := "UPDATE " + model.DBCategory + " SET " + model.DBGenericDeletedAt + " = $1 WHERE " + cond
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericID
-		sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBCategoryTypeID + ", w." + model.DBCategoryCode
-		sql += ", w." + model.DBCategoryName
-		sql += ", l." + model.DBCategoryTypeCode + " AS type_code"
-		sql += " FROM data w JOIN " + model.DBCategoryType + " l"
-		sql += " ON w." + model.DBCategoryTypeID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericID
+		sel += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBCategoryTypeID + ", w." + model.DBCategoryCode
+		sel += ", w." + model.DBCategoryName
+		sel += ", l." + model.DBCategoryTypeCode + " AS type_code"
+		sel += " FROM data w JOIN " + model.DBCategoryType + " l"
+		sel += " ON w." + model.DBCategoryTypeID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBCategory, sql, sel, args...); err != nil {
 			return err
 		}
 	} else {
@@ -238,35 +225,66 @@ func (db Database) DeleteCategory(ctx context.Context, conds any, v *model.Categ
 	return nil
 }
 
-func (db Database) ListCategory(ctx context.Context, params model.ListParams) ([]*model.Category, error) {
-	result := []*model.Category{}
+func (db Database) RestoreCategory(ctx context.Context, conds any, v *model.Category) error {
 	args := []any{}
-	sql := "SELECT * FROM (SELECT w." + model.DBGenericID
-	sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-	sql += ", w." + model.DBCategoryTypeID + ", w." + model.DBCategoryCode
-	sql += ", w." + model.DBCategoryName
-	sql += ", l." + model.DBCategoryTypeCode + " AS type_code"
-	sql += " FROM " + model.DBCategory + " w JOIN " + model.DBCategoryType + " l"
-	sql += " ON w." + model.DBCategoryTypeID + " = l." + model.DBGenericID
-	sql += ")"
-	if cond := SetWhere(params.Conditions, &args); cond != "" {
-		sql += " WHERE " + cond
+	cond := db.restoreCond(model.DBCategory, conds, &args)
+	sql := "UPDATE " + model.DBCategory + " SET " + model.DBGenericDeletedAt + " = NULL WHERE " + cond
+	if v != nil {
+		sel := "SELECT w." + model.DBGenericID
+		sel += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBCategoryTypeID + ", w." + model.DBCategoryCode
+		sel += ", w." + model.DBCategoryName
+		sel += ", l." + model.DBCategoryTypeCode + " AS type_code"
+		sel += " FROM data w JOIN " + model.DBCategoryType + " l"
+		sel += " ON w." + model.DBCategoryTypeID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBCategory, sql, sel, args...); err != nil {
+			return categorySetError(err)
+		}
+	} else {
+		if err := db.Exec(ctx, sql, args...); err != nil {
+			return categorySetError(err)
+		}
 	}
+	return nil
+}
+
+func (db Database) ListCategory(ctx context.Context, params model.ListParams) ([]*model.Category, []any, error) {
+	result := []*model.Category{}
+	args := []any{}
 	if len(params.OrderBys) < 1 {
 		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBCategoryCode})
 	}
 	params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID})
-	sql += " ORDER BY " + SetOrderBys(params.OrderBys, &args)
 	if params.Pagination == nil {
 		params.Pagination = &model.Pagination{Page: 1, Limit: model.CategoryPaginationDef}
 	}
-	if lmof := SetPagination(*params.Pagination, &args); lmof != "" {
+	if err := setListCursor(&params); err != nil {
+		return nil, nil, err
+	}
+	sql := "SELECT * FROM (" + categorySelect() + ")"
+	if cond := db.dialect.SetWhere(params.Conditions, &args); cond != "" {
+		sql += " WHERE " + cond
+	}
+	sql += " ORDER BY " + db.dialect.SetOrderBys(params.OrderBys, &args)
+	if lmof := db.dialect.SetPagination(*params.Pagination, &args); lmof != "" {
 		sql += lmof
 	}
 	if err := db.QueryAll(ctx, &result, sql, args...); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return result, nil
+	return result, listNextCursor(params, result), nil
+}
+
+func categorySelect() string {
+	sql := "SELECT w." + model.DBGenericID
+	sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+	sql += ", w." + model.DBCategoryTypeID + ", w." + model.DBCategoryCode
+	sql += ", w." + model.DBCategoryName
+	sql += ", l." + model.DBCategoryTypeCode + " AS type_code"
+	sql += " FROM " + model.DBCategory + " w JOIN " + model.DBCategoryType + " l"
+	sql += " ON w." + model.DBCategoryTypeID + " = l." + model.DBGenericID
+	sql += " WHERE w." + model.DBGenericDeletedAt + " IS NULL"
+	return sql
 }
 
 func (db Database) CountCategory(ctx context.Context, conds any) (int, error) {
@@ -320,20 +338,18 @@ func (db Database) AddCategoryRelation(ctx context.Context, data model.AddCatego
 		ctx = ttx
 		defer db.ContextTransactionRollback(context.WithoutCancel(ctx))
 	}
-	cols, vals, args := SetInsert(map[string]any{
+	cols, vals, args := db.dialect.SetInsert(map[string]any{
 		model.DBCategoryRelationParentID: parentID,
 		model.DBCategoryRelationChildID:  childID,
 	})
 	sql := "INSERT INTO " + model.DBCategoryRelation + " (" + cols + ") VALUES (" + vals + ")"
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBCategoryRelationParentID + ", w." + model.DBCategoryRelationChildID
-		sql += ", l." + model.DBCategoryCode + " AS child_code"
-		sql += " FROM data w JOIN " + model.DBCategory + " l"
-		sql += " ON w." + model.DBCategoryRelationChildID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBCategoryRelationParentID + ", w." + model.DBCategoryRelationChildID
+		sel += ", l." + model.DBCategoryCode + " AS child_code"
+		sel += " FROM data w JOIN " + model.DBCategory + " l"
+		sel += " ON w." + model.DBCategoryRelationChildID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBCategoryRelation, sql, sel, args...); err != nil {
 			return categoryRelationSetError(err)
 		}
 	} else {
@@ -365,12 +381,13 @@ func (db Database) AddCategoryRelation(ctx context.Context, data model.AddCatego
 func (db Database) GetCategoryRelation(ctx context.Context, conds any) (*model.CategoryRelation, error) {
 	var result model.CategoryRelation
 	args := []any{}
-	cond := SetWhere(conds, &args)
+	cond := db.dialect.SetWhere(conds, &args)
 	sql := "SELECT * FROM (SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
 	sql += ", w." + model.DBCategoryRelationParentID + ", w." + model.DBCategoryRelationChildID
 	sql += ", l." + model.DBCategoryCode + " AS child_code"
 	sql += " FROM " + model.DBCategoryRelation + " w JOIN " + model.DBCategory + " l"
 	sql += " ON w." + model.DBCategoryRelationChildID + " = l." + model.DBGenericID
+	sql += " AND l." + model.DBGenericDeletedAt + " IS NULL"
 	sql += ")"
 	sql += " WHERE " + cond
 	if err := db.QueryOne(ctx, &result, sql, args...); err != nil {
@@ -413,18 +430,16 @@ func (db Database) UpdateCategoryRelation(ctx context.Context, data model.SetCat
 		defer db.ContextTransactionRollback(context.WithoutCancel(ctx))
 	}
 	data0[model.DBGenericUpdatedAt] = time.Now().UTC()
-	sets, args := SetUpdate(data0)
-	cond := SetWhere([]any{conds, SetUpdateWhere(data0)}, &args)
+	sets, args := db.dialect.SetUpdate(data0)
+	cond := db.dialect.SetWhere([]any{conds, db.dialect.SetUpdateWhere(data0)}, &args)
 	sql := "UPDATE " + model.DBComicRelation + " SET " + sets + " WHERE " + cond
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBCategoryRelationParentID + ", w." + model.DBCategoryRelationChildID
-		sql += ", l." + model.DBCategoryCode + " AS child_code"
-		sql += " FROM data w JOIN " + model.DBCategory + " l"
-		sql += " ON w." + model.DBCategoryRelationChildID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBCategoryRelationParentID + ", w." + model.DBCategoryRelationChildID
+		sel += ", l." + model.DBCategoryCode + " AS child_code"
+		sel += " FROM data w JOIN " + model.DBCategory + " l"
+		sel += " ON w." + model.DBCategoryRelationChildID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBComicRelation, sql, sel, args...); err != nil {
 			return categoryRelationSetError(err)
 		}
 	} else {
@@ -455,17 +470,15 @@ func (db Database) UpdateCategoryRelation(ctx context.Context, data model.SetCat
 
 func (db Database) DeleteCategoryRelation(ctx context.Context, conds any, v *model.CategoryRelation) error {
 	args := []any{}
-	cond := SetWhere(conds, &args)
+	cond := db.dialect.SetWhere(conds, &args)
 	sql := "DELETE FROM " + model.DBCategoryRelation + " WHERE " + cond
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBCategoryRelationParentID + ", w." + model.DBCategoryRelationChildID
-		sql += ", l." + model.DBCategoryCode + " AS child_code"
-		sql += " FROM data w JOIN " + model.DBCategory + " l"
-		sql += " ON w." + model.DBCategoryRelationChildID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBCategoryRelationParentID + ", w." + model.DBCategoryRelationChildID
+		sel += ", l." + model.DBCategoryCode + " AS child_code"
+		sel += " FROM data w JOIN " + model.DBCategory + " l"
+		sel += " ON w." + model.DBCategoryRelationChildID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBCategoryRelation, sql, sel, args...); err != nil {
 			return err
 		}
 	} else {
@@ -484,18 +497,19 @@ func (db Database) ListCategoryRelation(ctx context.Context, params model.ListPa
 	sql += ", l." + model.DBCategoryCode + " AS child_code"
 	sql += " FROM " + model.DBCategoryRelation + " w JOIN " + model.DBCategory + " l"
 	sql += " ON w." + model.DBCategoryRelationChildID + " = l." + model.DBGenericID
+	sql += " AND l." + model.DBGenericDeletedAt + " IS NULL"
 	sql += ")"
-	if cond := SetWhere(params.Conditions, &args); cond != "" {
+	if cond := db.dialect.SetWhere(params.Conditions, &args); cond != "" {
 		sql += " WHERE " + cond
 	}
 	if len(params.OrderBys) < 1 {
 		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBCategoryRelationChildID})
 	}
-	sql += " ORDER BY " + SetOrderBys(params.OrderBys, &args)
+	sql += " ORDER BY " + db.dialect.SetOrderBys(params.OrderBys, &args)
 	if params.Pagination == nil {
 		params.Pagination = &model.Pagination{Page: 1, Limit: model.CategoryRelationPaginationDef}
 	}
-	if lmof := SetPagination(*params.Pagination, &args); lmof != "" {
+	if lmof := db.dialect.SetPagination(*params.Pagination, &args); lmof != "" {
 		sql += lmof
 	}
 	if err := db.QueryAll(ctx, &result, sql, args...); err != nil {
@@ -3,6 +3,7 @@ package database
 import (
 	"context"
 	"errors"
+	"strconv"
 	"time"
 
 	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
@@ -29,7 +30,7 @@ func (db Database) AddComic(ctx context.Context, data model.AddComic, v *model.C
 	case data.LanguageIETF != nil:
 		languageID = model.DBLanguageIETFToID(*data.LanguageIETF)
 	}
-	cols, vals, args := SetInsert(map[string]any{
+	cols, vals, args := db.dialect.SetInsert(map[string]any{
 		model.DBComicCode:                 code,
 		model.DBLanguageGenericLanguageID: languageID,
 		model.DBComicPublishedFrom:        data.PublishedFrom,
@@ -42,19 +43,17 @@ func (db Database) AddComic(ctx context.Context, data model.AddComic, v *model.C
 	})
 	sql := "INSERT INTO " + model.DBComic + " (" + cols + ") VALUES (" + vals + ")"
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericID
-		sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBComicCode + ", w." + model.DBComicPublishedFrom
-		sql += ", w." + model.DBComicPublishedTo + ", w." + model.DBComicTotalChapter
-		sql += ", w." + model.DBComicTotalVolume + ", w." + model.DBComicNSFW
-		sql += ", w." + model.DBComicNSFL + ", w." + model.DBLanguageGenericLanguageID
-		sql += ", w." + model.DBComicAdditionals
-		sql += ", l." + model.DBLanguageIETF + " AS language_ietf"
-		sql += " FROM data w LEFT JOIN " + model.DBLanguage + " l"
-		sql += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericID
+		sel += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBComicCode + ", w." + model.DBComicPublishedFrom
+		sel += ", w." + model.DBComicPublishedTo + ", w." + model.DBComicTotalChapter
+		sel += ", w." + model.DBComicTotalVolume + ", w." + model.DBComicNSFW
+		sel += ", w." + model.DBComicNSFL + ", w." + model.DBLanguageGenericLanguageID
+		sel += ", w." + model.DBComicAdditionals
+		sel += ", l." + model.DBLanguageIETF + " AS language_ietf"
+		sel += " FROM data w LEFT JOIN " + model.DBLanguage + " l"
+		sel += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBComic, sql, sel, args...); err != nil {
 			return comicSetError(err)
 		}
 	} else {
@@ -68,8 +67,17 @@ func (db Database) AddComic(ctx context.Context, data model.AddComic, v *model.C
 func (db Database) GetComic(ctx context.Context, conds any) (*model.Comic, error) {
 	var result model.Comic
 	args := []any{}
-	cond := SetWhere(conds, &args)
-	sql := "SELECT * FROM (SELECT w." + model.DBGenericID
+	cond := db.dialect.SetWhere(conds, &args)
+	sql := "SELECT * FROM (" + comicSelect() + ")"
+	sql += " WHERE " + cond
+	if err := db.QueryOne(ctx, &result, sql, args...); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func comicSelect() string {
+	sql := "SELECT w." + model.DBGenericID
 	sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
 	sql += ", w." + model.DBComicCode + ", w." + model.DBComicPublishedFrom
 	sql += ", w." + model.DBComicPublishedTo + ", w." + model.DBComicTotalChapter
@@ -79,12 +87,142 @@ func (db Database) GetComic(ctx context.Context, conds any) (*model.Comic, error
 	sql += ", l." + model.DBLanguageIETF + " AS language_ietf"
 	sql += " FROM " + model.DBComic + " w LEFT JOIN " + model.DBLanguage + " l"
 	sql += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
-	sql += ")"
+	sql += " WHERE w." + model.DBGenericDeletedAt + " IS NULL"
+	return sql
+}
+
+func (db Database) GetComicDetail(ctx context.Context, conds any) (*model.Comic, error) {
+	if db.dialect == DialectSQLite {
+		return db.getComicDetailRows(ctx, conds)
+	}
+	var result struct {
+		model.Comic
+		Titles0     []byte `db:"titles"`
+		Covers0     []byte `db:"covers"`
+		Synopses0   []byte `db:"synopses"`
+		Chapters0   []byte `db:"chapters"`
+		Externals0  []byte `db:"externals"`
+		Categories0 []byte `db:"categories"`
+		Tags0       []byte `db:"tags"`
+		Relations0  []byte `db:"relations"`
+	}
+	args := []any{}
+	cond := db.dialect.SetWhere(conds, &args)
+	agg := func(from, where, orderBy string) string {
+		sql := "(SELECT COALESCE(jsonb_agg(to_jsonb(x) ORDER BY " + orderBy + "), '[]')"
+		sql += " FROM (" + from + ") x WHERE " + where + ")"
+		return sql
+	}
+	sql := "SELECT c.*"
+	sql += ", " + agg(comicTitleSelect(),
+		"x."+model.DBComicGenericComicID+" = c."+model.DBGenericID,
+		"x."+model.DBComicGenericRID+", x."+model.DBGenericID) + " AS titles"
+	sql += ", " + agg(comicCoverSelect(),
+		"x."+model.DBComicGenericComicID+" = c."+model.DBGenericID,
+		"x."+model.DBComicGenericRID+", x."+model.DBGenericID) + " AS covers"
+	sql += ", " + agg(comicSynopsisSelect(),
+		"x."+model.DBComicGenericComicID+" = c."+model.DBGenericID,
+		"x."+model.DBComicGenericRID+", x."+model.DBGenericID) + " AS synopses"
+	sql += ", " + agg("SELECT * FROM "+model.DBComicChapter,
+		"x."+model.DBComicGenericComicID+" = c."+model.DBGenericID,
+		"x."+model.DBComicChapterReleasedAt+", x."+model.DBGenericID) + " AS chapters"
+	sql += ", " + agg(comicExternalSelect(),
+		"x."+model.DBComicGenericComicID+" = c."+model.DBGenericID,
+		"x."+model.DBComicGenericRID+", x."+model.DBGenericID) + " AS externals"
+	sql += ", " + agg(categorySelect(),
+		"x."+model.DBGenericID+" IN (SELECT "+model.DBCategoryGenericCategoryID+" FROM "+model.DBComicCategory+
+			" WHERE "+model.DBComicGenericComicID+" = c."+model.DBGenericID+")",
+		"x."+model.DBCategoryCode+", x."+model.DBGenericID) + " AS categories"
+	sql += ", " + agg(tagSelect(),
+		"x."+model.DBGenericID+" IN (SELECT "+model.DBTagGenericTagID+" FROM "+model.DBComicTag+
+			" WHERE "+model.DBComicGenericComicID+" = c."+model.DBGenericID+")",
+		"x."+model.DBTagCode+", x."+model.DBGenericID) + " AS tags"
+	sql += ", " + agg(comicRelationSelect(),
+		"x."+model.DBComicRelationParentID+" = c."+model.DBGenericID,
+		"x."+model.DBComicRelationChildID) + " AS relations"
+	sql += " FROM (" + comicSelect() + ") c"
 	sql += " WHERE " + cond
 	if err := db.QueryOne(ctx, &result, sql, args...); err != nil {
 		return nil, err
 	}
-	return &result, nil
+	var err error
+	comic := result.Comic
+	if comic.Titles, err = jsonRows[model.ComicTitle](result.Titles0); err != nil {
+		return nil, err
+	}
+	if comic.Covers, err = jsonRows[model.ComicCover](result.Covers0); err != nil {
+		return nil, err
+	}
+	if comic.Synopses, err = jsonRows[model.ComicSynopsis](result.Synopses0); err != nil {
+		return nil, err
+	}
+	if comic.Chapters, err = jsonRows[model.ComicChapter](result.Chapters0); err != nil {
+		return nil, err
+	}
+	if comic.Externals, err = jsonRows[model.ComicExternal](result.Externals0); err != nil {
+		return nil, err
+	}
+	if comic.Categories, err = jsonRows[model.Category](result.Categories0); err != nil {
+		return nil, err
+	}
+	if comic.Tags, err = jsonRows[model.Tag](result.Tags0); err != nil {
+		return nil, err
+	}
+	if comic.Relations, err = jsonRows[model.ComicRelation](result.Relations0); err != nil {
+		return nil, err
+	}
+	return &comic, nil
+}
+
+// getComicDetailRows loads the comic children with a query each, SQLite has no
+// row to JSON conversion to aggregate them with.
+func (db Database) getComicDetailRows(ctx context.Context, conds any) (*model.Comic, error) {
+	comic, err := db.GetComic(ctx, conds)
+	if err != nil {
+		return nil, err
+	}
+	comic.Titles, comic.Covers = []*model.ComicTitle{}, []*model.ComicCover{}
+	comic.Synopses, comic.Chapters = []*model.ComicSynopsis{}, []*model.ComicChapter{}
+	comic.Externals, comic.Categories = []*model.ComicExternal{}, []*model.Category{}
+	comic.Tags, comic.Relations = []*model.Tag{}, []*model.ComicRelation{}
+	for _, child := range []struct {
+		dst                  any
+		from, where, orderBy string
+	}{
+		{&comic.Titles, comicTitleSelect(),
+			"x." + model.DBComicGenericComicID + " = $1",
+			"x." + model.DBComicGenericRID + ", x." + model.DBGenericID},
+		{&comic.Covers, comicCoverSelect(),
+			"x." + model.DBComicGenericComicID + " = $1",
+			"x." + model.DBComicGenericRID + ", x." + model.DBGenericID},
+		{&comic.Synopses, comicSynopsisSelect(),
+			"x." + model.DBComicGenericComicID + " = $1",
+			"x." + model.DBComicGenericRID + ", x." + model.DBGenericID},
+		{&comic.Chapters, "SELECT * FROM " + model.DBComicChapter,
+			"x." + model.DBComicGenericComicID + " = $1",
+			"x." + model.DBComicChapterReleasedAt + ", x." + model.DBGenericID},
+		{&comic.Externals, comicExternalSelect(),
+			"x." + model.DBComicGenericComicID + " = $1",
+			"x." + model.DBComicGenericRID + ", x." + model.DBGenericID},
+		{&comic.Categories, categorySelect(),
+			"x." + model.DBGenericID + " IN (SELECT " + model.DBCategoryGenericCategoryID + " FROM " + model.DBComicCategory +
+				" WHERE " + model.DBComicGenericComicID + " = $1)",
+			"x." + model.DBCategoryCode + ", x." + model.DBGenericID},
+		{&comic.Tags, tagSelect(),
+			"x." + model.DBGenericID + " IN (SELECT " + model.DBTagGenericTagID + " FROM " + model.DBComicTag +
+				" WHERE " + model.DBComicGenericComicID + " = $1)",
+			"x." + model.DBTagCode + ", x." + model.DBGenericID},
+		{&comic.Relations, comicRelationSelect(),
+			"x." + model.DBComicRelationParentID + " = $1",
+			"x." + model.DBComicRelationChildID},
+	} {
+		sql := "SELECT * FROM (" + child.from + ") x"
+		sql += " WHERE " + child.where + " ORDER BY " + child.orderBy
+		if err := db.QueryAll(ctx, child.dst, sql, comic.ID); err != nil {
+			return nil, err
+		}
+	}
+	return comic, nil
 }
 
 func (db Database) UpdateComic(ctx context.Context, data model.SetComic, conds any, v *model.Comic) error {
@@ -117,29 +255,27 @@ func (db Database) UpdateComic(ctx context.Context, data model.SetComic, conds a
 		data0[model.DBComicNSFL] = data.NSFL
 	}
 	if data.Additionals != nil {
-		data0[model.DBComicAdditionals] = data.Additionals
+		data0[model.DBComicAdditionals] = model.DBJSONMerge{Value: data.Additionals}
 	}
 	for _, null := range data.SetNull {
 		data0[null] = nil
 	}
 	data0[model.DBGenericUpdatedAt] = time.Now().UTC()
-	sets, args := SetUpdate(data0)
-	cond := SetWhere([]any{conds, SetUpdateWhere(data0)}, &args)
+	sets, args := db.dialect.SetUpdate(data0)
+	cond := db.dialect.SetWhere(softDeleteConds([]any{conds, db.dialect.SetUpdateWhere(data0)}), &args)
 	sql := "UPDATE " + model.DBComic + " SET " + sets + " WHERE " + cond
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericID
-		sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBComicCode + ", w." + model.DBComicPublishedFrom
-		sql += ", w." + model.DBComicPublishedTo + ", w." + model.DBComicTotalChapter
-		sql += ", w." + model.DBComicTotalVolume + ", w." + model.DBComicNSFW
-		sql += ", w." + model.DBComicNSFL + ", w." + model.DBLanguageGenericLanguageID
-		sql += ", w." + model.DBComicAdditionals
-		sql += ", l." + model.DBLanguageIETF + " AS language_ietf"
-		sql += " FROM data w LEFT JOIN " + model.DBLanguage + " l"
-		sql += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericID
+		sel += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBComicCode + ", w." + model.DBComicPublishedFrom
+		sel += ", w." + model.DBComicPublishedTo + ", w." + model.DBComicTotalChapter
+		sel += ", w." + model.DBComicTotalVolume + ", w." + model.DBComicNSFW
+		sel += ", w." + model.DBComicNSFL + ", w." + model.DBLanguageGenericLanguageID
+		sel += ", w." + model.DBComicAdditionals
+		sel += ", l." + model.DBLanguageIETF + " AS language_ietf"
+		sel += " FROM data w LEFT JOIN " + model.DBLanguage + " l"
+		sel += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBComic, sql, sel, args...); err != nil {
 			return comicSetError(err)
 		}
 	} else {
@@ -151,23 +287,21 @@ func (db Database) UpdateComic(ctx context.Context, data model.SetComic, conds a
 }
 
 func (db Database) DeleteComic(ctx context.Context, conds any, v *model.Comic) error {
-	args := []any{}
-	cond := SetWhere(conds, &args)
-	sql := "DELETE FROM " + model.DBComic + " WHERE " + cond
+	args := []any{time.Now().UTC()}
+	cond := db.dialect.SetWhere(softDeleteConds(conds), &args)
+	sql := "UPDATE " + model.DBComic + " SET " + model.DBGenericDeletedAt + " = $1 WHERE " + cond
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericID
-		sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBComicCode + ", w." + model.DBComicPublishedFrom
-		sql += ", w." + model.DBComicPublishedTo + ", w." + model.DBComicTotalChapter
-		sql += ", w." + model.DBComicTotalVolume + ", w." + model.DBComicNSFW
-		sql += ", w." + model.DBComicNSFL + ", w." + model.DBLanguageGenericLanguageID
-		sql += ", w." + model.DBComicAdditionals
-		sql += ", l." + model.DBLanguageIETF + " AS language_ietf"
-		sql += " FROM data w LEFT JOIN " + model.DBLanguage + " l"
-		sql += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericID
+		sel += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBComicCode + ", w." + model.DBComicPublishedFrom
+		sel += ", w." + model.DBComicPublishedTo + ", w." + model.DBComicTotalChapter
+		sel += ", w." + model.DBComicTotalVolume + ", w." + model.DBComicNSFW
+		sel += ", w." + model.DBComicNSFL + ", w." + model.DBLanguageGenericLanguageID
+		sel += ", w." + model.DBComicAdditionals
+		sel += ", l." + model.DBLanguageIETF + " AS language_ietf"
+		sel += " FROM data w LEFT JOIN " + model.DBLanguage + " l"
+		sel += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBComic, sql, sel, args...); err != nil {
 			return err
 		}
 	} else {
@@ -178,137 +312,158 @@ func (db Database) DeleteComic(ctx context.Context, conds any, v *model.Comic) e
 	return nil
 }
 
-func (db Database) ListComic(ctx context.Context, params model.ListParams) ([]*model.Comic, error) {
-	result := []*model.Comic{}
+func (db Database) RestoreComic(ctx context.Context, conds any, v *model.Comic) error {
 	args := []any{}
-	ccnd := map[string]model.DBCrossConditional{}
-	switch cond := params.Conditions.(type) {
-	case model.DBCrossConditional:
-		switch cond.Table {
-		case model.DBComicExternal:
-			ccnd["ce"] = cond
-		}
-	case []any:
-		for _, cond := range cond {
-			switch cond := cond.(type) {
-			case model.DBCrossConditional:
-				switch cond.Table {
-				case model.DBComicExternal:
-					ccnd["ce"] = cond
-				}
-			}
-		}
-	}
-	sql := "SELECT * FROM ("
-	if len(ccnd) > 0 {
-		cte := ""
-		for key, val := range ccnd {
-			switch val.Table {
-			case model.DBComicExternal:
-				if cte != "" {
-					cte += ", "
-				}
-				cte += key + "cte AS("
-				cte += "SELECT * FROM (SELECT a." + model.DBGenericID
-				cte += ", a." + model.DBGenericCreatedAt + ", a." + model.DBGenericUpdatedAt
-				cte += ", a." + model.DBComicGenericComicID + ", a." + model.DBComicGenericRID
-				cte += ", a." + model.DBWebsiteGenericWebsiteID + ", a." + model.DBComicExternalRelativeURL
-				cte += ", a." + model.DBComicExternalOfficial
-				cte += ", b." + model.DBWebsiteDomain + " AS website_domain"
-				cte += " FROM " + model.DBComicExternal + " a JOIN " + model.DBWebsite + " b"
-				cte += " ON a." + model.DBWebsiteGenericWebsiteID + " = b." + model.DBGenericID
-				cte += ")"
-				if cond := SetWhere(val.Conditions, &args); cond != "" {
-					cte += " WHERE " + cond
-				}
-				cte += ")"
-			}
-		}
-		if cte != "" {
-			sql += "WITH " + cte + " "
+	cond := db.restoreCond(model.DBComic, conds, &args)
+	sql := "UPDATE " + model.DBComic + " SET " + model.DBGenericDeletedAt + " = NULL WHERE " + cond
+	if v != nil {
+		sel := "SELECT w." + model.DBGenericID
+		sel += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBComicCode + ", w." + model.DBComicPublishedFrom
+		sel += ", w." + model.DBComicPublishedTo + ", w." + model.DBComicTotalChapter
+		sel += ", w." + model.DBComicTotalVolume + ", w." + model.DBComicNSFW
+		sel += ", w." + model.DBComicNSFL + ", w." + model.DBLanguageGenericLanguageID
+		sel += ", w." + model.DBComicAdditionals
+		sel += ", l." + model.DBLanguageIETF + " AS language_ietf"
+		sel += " FROM data w LEFT JOIN " + model.DBLanguage + " l"
+		sel += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBComic, sql, sel, args...); err != nil {
+			return comicSetError(err)
 		}
-		sql += "SELECT DISTINCT ON (a." + model.DBGenericID + ")"
-		sql += " a." + model.DBGenericID
 	} else {
-		sql += "SELECT a." + model.DBGenericID
-	}
-	sql += ", a." + model.DBGenericCreatedAt + ", a." + model.DBGenericUpdatedAt
-	sql += ", a." + model.DBComicCode + ", a." + model.DBComicPublishedFrom
-	sql += ", a." + model.DBComicPublishedTo + ", a." + model.DBComicTotalChapter
-	sql += ", a." + model.DBComicTotalVolume + ", a." + model.DBComicNSFW
-	sql += ", a." + model.DBComicNSFL + ", a." + model.DBLanguageGenericLanguageID
-	sql += ", a." + model.DBComicAdditionals
-	sql += ", b." + model.DBLanguageIETF + " AS language_ietf"
-	sql += " FROM " + model.DBComic + " a LEFT JOIN " + model.DBLanguage + " b"
-	sql += " ON a." + model.DBLanguageGenericLanguageID + " = b." + model.DBGenericID
-	for key, val := range ccnd {
-		switch val.Table {
-		case model.DBComicExternal:
-			sql += " LEFT JOIN " + key + "cte " + key
-			sql += " ON a." + model.DBGenericID + " = " + key + "." + model.DBComicGenericComicID
-		}
-	}
-	if len(ccnd) > 0 {
-		whr := ""
-		for key, val := range ccnd {
-			switch val.Table {
-			case model.DBComicExternal:
-				if whr != "" {
-					whr += " AND"
-				}
-				whr += " " + key + "." + model.DBComicGenericComicID + " IS NOT NULL"
-			}
-		}
-		if whr != "" {
-			sql += " WHERE" + whr
+		if err := db.Exec(ctx, sql, args...); err != nil {
+			return comicSetError(err)
 		}
 	}
-	sql += ")"
-	if cond := SetWhere(params.Conditions, &args); cond != "" {
-		sql += " WHERE " + cond
-	}
+	return nil
+}
+
+func (db Database) ListComic(ctx context.Context, params model.ListParams) ([]*model.Comic, []any, error) {
+	result := []*model.Comic{}
+	args := []any{}
+	ccnd := comicCrossConditionals(params.Conditions)
+	_, search := ccnd["ct"]
 	if len(params.OrderBys) < 1 {
+		if search {
+			params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBComicSearchRank, Sort: "desc"})
+		}
 		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBComicCode})
 	}
-	sql += " ORDER BY " + SetOrderBys(params.OrderBys, &args)
+	params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID})
 	if params.Pagination == nil {
 		params.Pagination = &model.Pagination{Page: 1, Limit: model.ComicPaginationDef}
 	}
-	if lmof := SetPagination(*params.Pagination, &args); lmof != "" {
+	if err := setListCursor(&params); err != nil {
+		return nil, nil, err
+	}
+	sql := "SELECT * FROM ("
+	if search {
+		sql = "SELECT " + model.DBGenericID
+		sql += ", " + model.DBGenericCreatedAt + ", " + model.DBGenericUpdatedAt
+		sql += ", " + model.DBComicCode + ", " + model.DBComicPublishedFrom
+		sql += ", " + model.DBComicPublishedTo + ", " + model.DBComicTotalChapter
+		sql += ", " + model.DBComicTotalVolume + ", " + model.DBComicNSFW
+		sql += ", " + model.DBComicNSFL + ", " + model.DBLanguageGenericLanguageID
+		sql += ", " + model.DBComicAdditionals + ", language_ietf"
+		sql += " FROM ("
+	}
+	sql += db.comicCrossSelect(ccnd, &args)
+	sql += ")"
+	if cond := db.dialect.SetWhere(params.Conditions, &args); cond != "" {
+		sql += " WHERE " + cond
+	}
+	sql += " ORDER BY " + db.dialect.SetOrderBys(params.OrderBys, &args)
+	if lmof := db.dialect.SetPagination(*params.Pagination, &args); lmof != "" {
 		sql += lmof
 	}
 	if err := db.QueryAll(ctx, &result, sql, args...); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return result, nil
+	return result, listNextCursor(params, result), nil
 }
 
 func (db Database) CountComic(ctx context.Context, conds any) (int, error) {
 	var dst int
 	args := []any{}
+	ccnd := comicCrossConditionals(conds)
+	sql := "SELECT COUNT(*) FROM ("
+	sql += db.comicCrossSelect(ccnd, &args)
+	sql += ")"
+	if cond := db.dialect.SetWhere(conds, &args); cond != "" {
+		sql += " WHERE " + cond
+	}
+	if err := db.QueryOne(ctx, &dst, sql, args...); err != nil {
+		return -1, err
+	}
+	return dst, nil
+}
+
+func comicCrossConditionals(conds any) map[string]model.DBCrossConditional {
 	ccnd := map[string]model.DBCrossConditional{}
-	switch cond := conds.(type) {
-	case model.DBCrossConditional:
+	add := func(cond model.DBCrossConditional) {
+		key := ""
 		switch cond.Table {
+		case model.DBComicTitle:
+			key = "ct"
 		case model.DBComicExternal:
-			ccnd["ce"] = cond
+			key = "ce"
+		case model.DBComicCategory:
+			key = "cc"
+		case model.DBComicTag:
+			key = "cg"
+		default:
+			return
 		}
+		if cond.Exclude {
+			key = "x" + key
+		}
+		ccnd[key] = cond
+	}
+	switch cond := conds.(type) {
+	case model.DBCrossConditional:
+		add(cond)
 	case []any:
 		for _, cond := range cond {
 			switch cond := cond.(type) {
 			case model.DBCrossConditional:
-				switch cond.Table {
-				case model.DBComicExternal:
-					ccnd["ce"] = cond
-				}
+				add(cond)
 			}
 		}
 	}
-	sql := ""
+	return ccnd
+}
+
+func (db Database) comicCrossSelect(ccnd map[string]model.DBCrossConditional, args *[]any) string {
+	sql, groupBy := "", ""
 	if len(ccnd) > 0 {
 		cte := ""
 		for key, val := range ccnd {
 			switch val.Table {
+			case model.DBComicTitle:
+				if cte != "" {
+					cte += ", "
+				}
+				rank := db.dialect.SetTextSearchRank(val.Conditions, args)
+				if rank == "" {
+					rank = "0"
+				}
+				cte += key + "cte AS("
+				cte += "SELECT " + model.DBComicGenericComicID
+				cte += ", MAX(" + rank + ") AS " + model.DBComicSearchRank
+				cte += " FROM (SELECT a." + model.DBGenericID
+				cte += ", a." + model.DBGenericCreatedAt + ", a." + model.DBGenericUpdatedAt
+				cte += ", a." + model.DBComicGenericComicID + ", a." + model.DBComicGenericRID
+				cte += ", a." + model.DBLanguageGenericLanguageID + ", a." + model.DBComicTitleTitle
+				cte += ", a." + model.DBComicTitleSynonym + ", a." + model.DBComicTitleRomanized
+				cte += ", b." + model.DBLanguageIETF + " AS language_ietf"
+				cte += " FROM " + model.DBComicTitle + " a JOIN " + model.DBLanguage + " b"
+				cte += " ON a." + model.DBLanguageGenericLanguageID + " = b." + model.DBGenericID
+				cte += ")"
+				if cond := db.dialect.SetWhere(val.Conditions, args); cond != "" {
+					cte += " WHERE " + cond
+				}
+				cte += " GROUP BY " + model.DBComicGenericComicID
+				cte += ")"
 			case model.DBComicExternal:
 				if cte != "" {
 					cte += ", "
@@ -323,47 +478,93 @@ func (db Database) CountComic(ctx context.Context, conds any) (int, error) {
 				cte += " FROM " + model.DBComicExternal + " a JOIN " + model.DBWebsite + " b"
 				cte += " ON a." + model.DBWebsiteGenericWebsiteID + " = b." + model.DBGenericID
 				cte += ")"
-				if cond := SetWhere(val.Conditions, &args); cond != "" {
+				if cond := db.dialect.SetWhere(val.Conditions, args); cond != "" {
+					cte += " WHERE " + cond
+				}
+				cte += ")"
+			case model.DBComicCategory:
+				if cte != "" {
+					cte += ", "
+				}
+				cte += key + "cte AS("
+				cte += "SELECT " + model.DBComicGenericComicID
+				cte += " FROM (SELECT a." + model.DBComicGenericComicID + ", a." + model.DBCategoryGenericCategoryID
+				cte += ", b." + model.DBCategoryTypeID + " AS " + model.DBCategoryGenericCategoryTypeID
+				cte += ", b." + model.DBCategoryCode + " AS " + model.DBCategoryGenericCategoryCode
+				cte += " FROM " + model.DBComicCategory + " a JOIN " + model.DBCategory + " b"
+				cte += " ON a." + model.DBCategoryGenericCategoryID + " = b." + model.DBGenericID
+				cte += " AND b." + model.DBGenericDeletedAt + " IS NULL"
+				cte += ")"
+				if cond := db.dialect.SetWhere(val.Conditions, args); cond != "" {
 					cte += " WHERE " + cond
 				}
+				cte += " GROUP BY " + model.DBComicGenericComicID
+				if val.HavingCount > 0 {
+					*args = append(*args, val.HavingCount)
+					cte += " HAVING COUNT(DISTINCT " + model.DBCategoryGenericCategoryID + ")"
+					cte += " >= $" + strconv.Itoa(len(*args))
+				}
+				cte += ")"
+			case model.DBComicTag:
+				if cte != "" {
+					cte += ", "
+				}
+				cte += key + "cte AS("
+				cte += "SELECT " + model.DBComicGenericComicID
+				cte += " FROM (SELECT a." + model.DBComicGenericComicID + ", a." + model.DBTagGenericTagID
+				cte += ", b." + model.DBTagTypeID + " AS " + model.DBTagGenericTagTypeID
+				cte += ", b." + model.DBTagCode + " AS " + model.DBTagGenericTagCode
+				cte += " FROM " + model.DBComicTag + " a JOIN " + model.DBTag + " b"
+				cte += " ON a." + model.DBTagGenericTagID + " = b." + model.DBGenericID
+				cte += " AND b." + model.DBGenericDeletedAt + " IS NULL"
+				cte += ")"
+				if cond := db.dialect.SetWhere(val.Conditions, args); cond != "" {
+					cte += " WHERE " + cond
+				}
+				cte += " GROUP BY " + model.DBComicGenericComicID
+				if val.HavingCount > 0 {
+					*args = append(*args, val.HavingCount)
+					cte += " HAVING COUNT(DISTINCT " + model.DBTagGenericTagID + ")"
+					cte += " >= $" + strconv.Itoa(len(*args))
+				}
 				cte += ")"
 			}
 		}
 		if cte != "" {
 			sql += "WITH " + cte + " "
 		}
-		sql += "SELECT COUNT(DISTINCT a." + model.DBGenericID + ")"
-		sql += " FROM " + model.DBComic + " a"
-		for key, val := range ccnd {
-			switch val.Table {
-			case model.DBComicExternal:
-				sql += " LEFT JOIN " + key + "cte " + key
-				sql += " ON a." + model.DBGenericID + " = " + key + "." + model.DBComicGenericComicID
-			}
-		}
-		whr := SetWhere(conds, &args)
-		for key, val := range ccnd {
-			switch val.Table {
-			case model.DBComicExternal:
-				if whr != "" {
-					whr += " AND"
-				}
-				whr += " " + key + "." + model.DBComicGenericComicID + " IS NOT NULL"
-			}
-		}
-		if whr != "" {
-			sql += " WHERE" + whr
-		}
+		var distinct string
+		distinct, groupBy = db.dialect.SetDistinctOn("a." + model.DBGenericID)
+		sql += "SELECT " + distinct + "a." + model.DBGenericID
 	} else {
-		sql += "SELECT COUNT(*) FROM " + model.DBComic
-		if cond := SetWhere(conds, &args); cond != "" {
-			sql += " WHERE " + cond
-		}
+		sql += "SELECT a." + model.DBGenericID
 	}
-	if err := db.QueryOne(ctx, &dst, sql, args...); err != nil {
-		return -1, err
+	sql += ", a." + model.DBGenericCreatedAt + ", a." + model.DBGenericUpdatedAt
+	sql += ", a." + model.DBComicCode + ", a." + model.DBComicPublishedFrom
+	sql += ", a." + model.DBComicPublishedTo + ", a." + model.DBComicTotalChapter
+	sql += ", a." + model.DBComicTotalVolume + ", a." + model.DBComicNSFW
+	sql += ", a." + model.DBComicNSFL + ", a." + model.DBLanguageGenericLanguageID
+	sql += ", a." + model.DBComicAdditionals
+	sql += ", b." + model.DBLanguageIETF + " AS language_ietf"
+	if _, ok := ccnd["ct"]; ok {
+		sql += ", ct." + model.DBComicSearchRank
 	}
-	return dst, nil
+	sql += " FROM " + model.DBComic + " a LEFT JOIN " + model.DBLanguage + " b"
+	sql += " ON a." + model.DBLanguageGenericLanguageID + " = b." + model.DBGenericID
+	for key := range ccnd {
+		sql += " LEFT JOIN " + key + "cte " + key
+		sql += " ON a." + model.DBGenericID + " = " + key + "." + model.DBComicGenericComicID
+	}
+	sql += " WHERE a." + model.DBGenericDeletedAt + " IS NULL"
+	for key, val := range ccnd {
+		sql += " AND " + key + "." + model.DBComicGenericComicID
+		if val.Exclude {
+			sql += " IS NULL"
+		} else {
+			sql += " IS NOT NULL"
+		}
+	}
+	return sql + groupBy
 }
 
 func (db Database) ExistsComic(ctx context.Context, conds any) (bool, error) {
@@ -436,7 +637,7 @@ func (db Database) AddComicTitle(ctx context.Context, data model.AddComicTitle,
 	case data.LanguageIETF != nil:
 		languageID = model.DBLanguageIETFToID(*data.LanguageIETF)
 	}
-	cols, vals, args := SetInsert(map[string]any{
+	cols, vals, args := db.dialect.SetInsert(map[string]any{
 		model.DBComicGenericComicID:       comicID,
 		model.DBComicGenericRID:           rid,
 		model.DBLanguageGenericLanguageID: languageID,
@@ -446,17 +647,15 @@ func (db Database) AddComicTitle(ctx context.Context, data model.AddComicTitle,
 	})
 	sql := "INSERT INTO " + model.DBComicTitle + " (" + cols + ") VALUES (" + vals + ")"
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericID
-		sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
-		sql += ", w." + model.DBLanguageGenericLanguageID + ", w." + model.DBComicTitleTitle
-		sql += ", w." + model.DBComicTitleSynonym + ", w." + model.DBComicTitleRomanized
-		sql += ", l." + model.DBLanguageIETF + " AS language_ietf"
-		sql += " FROM data w JOIN " + model.DBLanguage + " l"
-		sql += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericID
+		sel += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
+		sel += ", w." + model.DBLanguageGenericLanguageID + ", w." + model.DBComicTitleTitle
+		sel += ", w." + model.DBComicTitleSynonym + ", w." + model.DBComicTitleRomanized
+		sel += ", l." + model.DBLanguageIETF + " AS language_ietf"
+		sel += " FROM data w JOIN " + model.DBLanguage + " l"
+		sel += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBComicTitle, sql, sel, args...); err != nil {
 			return comicTitleSetError(err)
 		}
 	} else {
@@ -470,7 +669,7 @@ func (db Database) AddComicTitle(ctx context.Context, data model.AddComicTitle,
 func (db Database) GetComicTitle(ctx context.Context, conds any) (*model.ComicTitle, error) {
 	var result model.ComicTitle
 	args := []any{}
-	cond := SetWhere(conds, &args)
+	cond := db.dialect.SetWhere(conds, &args)
 	sql := "SELECT * FROM (SELECT w." + model.DBGenericID
 	sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
 	sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
@@ -517,21 +716,19 @@ func (db Database) UpdateComicTitle(ctx context.Context, data model.SetComicTitl
 		data0[null] = nil
 	}
 	data0[model.DBGenericUpdatedAt] = time.Now().UTC()
-	sets, args := SetUpdate(data0)
-	cond := SetWhere([]any{conds, SetUpdateWhere(data0)}, &args)
+	sets, args := db.dialect.SetUpdate(data0)
+	cond := db.dialect.SetWhere([]any{conds, db.dialect.SetUpdateWhere(data0)}, &args)
 	sql := "UPDATE " + model.DBComicTitle + " SET " + sets + " WHERE " + cond
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericID
-		sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
-		sql += ", w." + model.DBLanguageGenericLanguageID + ", w." + model.DBComicTitleTitle
-		sql += ", w." + model.DBComicTitleSynonym + ", w." + model.DBComicTitleRomanized
-		sql += ", l." + model.DBLanguageIETF + " AS language_ietf"
-		sql += " FROM data w JOIN " + model.DBLanguage + " l"
-		sql += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericID
+		sel += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
+		sel += ", w." + model.DBLanguageGenericLanguageID + ", w." + model.DBComicTitleTitle
+		sel += ", w." + model.DBComicTitleSynonym + ", w." + model.DBComicTitleRomanized
+		sel += ", l." + model.DBLanguageIETF + " AS language_ietf"
+		sel += " FROM data w JOIN " + model.DBLanguage + " l"
+		sel += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBComicTitle, sql, sel, args...); err != nil {
 			return comicTitleSetError(err)
 		}
 	} else {
@@ -544,20 +741,18 @@ func (db Database) UpdateComicTitle(ctx context.Context, data model.SetComicTitl
 
 func (db Database) DeleteComicTitle(ctx context.Context, conds any, v *model.ComicTitle) error {
 	args := []any{}
-	cond := SetWhere(conds, &args)
+	cond := db.dialect.SetWhere(conds, &args)
 	sql := "DELETE FROM " + model.DBComicTitle + " WHERE " + cond
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericID
-		sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
-		sql += ", w." + model.DBLanguageGenericLanguageID + ", w." + model.DBComicTitleTitle
-		sql += ", w." + model.DBComicTitleSynonym + ", w." + model.DBComicTitleRomanized
-		sql += ", l." + model.DBLanguageIETF + " AS language_ietf"
-		sql += " FROM data w JOIN " + model.DBLanguage + " l"
-		sql += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericID
+		sel += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
+		sel += ", w." + model.DBLanguageGenericLanguageID + ", w." + model.DBComicTitleTitle
+		sel += ", w." + model.DBComicTitleSynonym + ", w." + model.DBComicTitleRomanized
+		sel += ", l." + model.DBLanguageIETF + " AS language_ietf"
+		sel += " FROM data w JOIN " + model.DBLanguage + " l"
+		sel += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBComicTitle, sql, sel, args...); err != nil {
 			return err
 		}
 	} else {
@@ -571,27 +766,19 @@ func (db Database) DeleteComicTitle(ctx context.Context, conds any, v *model.Com
 func (db Database) ListComicTitle(ctx context.Context, params model.ListParams) ([]*model.ComicTitle, error) {
 	result := []*model.ComicTitle{}
 	args := []any{}
-	sql := "SELECT * FROM (SELECT w." + model.DBGenericID
-	sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-	sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
-	sql += ", w." + model.DBLanguageGenericLanguageID + ", w." + model.DBComicTitleTitle
-	sql += ", w." + model.DBComicTitleSynonym + ", w." + model.DBComicTitleRomanized
-	sql += ", l." + model.DBLanguageIETF + " AS language_ietf"
-	sql += " FROM " + model.DBComicTitle + " w JOIN " + model.DBLanguage + " l"
-	sql += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
-	sql += ")"
-	if cond := SetWhere(params.Conditions, &args); cond != "" {
+	sql := "SELECT * FROM (" + comicTitleSelect() + ")"
+	if cond := db.dialect.SetWhere(params.Conditions, &args); cond != "" {
 		sql += " WHERE " + cond
 	}
 	if len(params.OrderBys) < 1 {
 		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBComicGenericRID})
 	}
 	params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID})
-	sql += " ORDER BY " + SetOrderBys(params.OrderBys, &args)
+	sql += " ORDER BY " + db.dialect.SetOrderBys(params.OrderBys, &args)
 	if params.Pagination == nil {
 		params.Pagination = &model.Pagination{Page: 1, Limit: model.ComicTitlePaginationDef}
 	}
-	if lmof := SetPagination(*params.Pagination, &args); lmof != "" {
+	if lmof := db.dialect.SetPagination(*params.Pagination, &args); lmof != "" {
 		sql += lmof
 	}
 	if err := db.QueryAll(ctx, &result, sql, args...); err != nil {
@@ -600,6 +787,18 @@ func (db Database) ListComicTitle(ctx context.Context, params model.ListParams)
 	return result, nil
 }
 
+func comicTitleSelect() string {
+	sql := "SELECT w." + model.DBGenericID
+	sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+	sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
+	sql += ", w." + model.DBLanguageGenericLanguageID + ", w." + model.DBComicTitleTitle
+	sql += ", w." + model.DBComicTitleSynonym + ", w." + model.DBComicTitleRomanized
+	sql += ", l." + model.DBLanguageIETF + " AS language_ietf"
+	sql += " FROM " + model.DBComicTitle + " w JOIN " + model.DBLanguage + " l"
+	sql += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
+	return sql
+}
+
 func (db Database) CountComicTitle(ctx context.Context, conds any) (int, error) {
 	return db.GenericCount(ctx, model.DBComicTitle, conds)
 }
@@ -649,7 +848,7 @@ func (db Database) AddComicCover(ctx context.Context, data model.AddComicCover,
 	case data.WebsiteDomain != nil:
 		websiteID = model.DBWebsiteDomainToID(*data.WebsiteDomain)
 	}
-	cols, vals, args := SetInsert(map[string]any{
+	cols, vals, args := db.dialect.SetInsert(map[string]any{
 		model.DBComicGenericComicID:     comicID,
 		model.DBComicGenericRID:         rid,
 		model.DBWebsiteGenericWebsiteID: websiteID,
@@ -658,17 +857,15 @@ func (db Database) AddComicCover(ctx context.Context, data model.AddComicCover,
 	})
 	sql := "INSERT INTO " + model.DBComicCover + " (" + cols + ") VALUES (" + vals + ")"
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericID
-		sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
-		sql += ", w." + model.DBWebsiteGenericWebsiteID + ", w." + model.DBComicCoverRelativeURL
-		sql += ", w." + model.DBComicCoverPriority
-		sql += ", l." + model.DBWebsiteDomain + " AS website_domain"
-		sql += " FROM data w JOIN " + model.DBWebsite + " l"
-		sql += " ON w." + model.DBWebsiteGenericWebsiteID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericID
+		sel += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
+		sel += ", w." + model.DBWebsiteGenericWebsiteID + ", w." + model.DBComicCoverRelativeURL
+		sel += ", w." + model.DBComicCoverPriority
+		sel += ", l." + model.DBWebsiteDomain + " AS website_domain"
+		sel += " FROM data w JOIN " + model.DBWebsite + " l"
+		sel += " ON w." + model.DBWebsiteGenericWebsiteID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBComicCover, sql, sel, args...); err != nil {
 			return comicCoverSetError(err)
 		}
 	} else {
@@ -682,7 +879,7 @@ func (db Database) AddComicCover(ctx context.Context, data model.AddComicCover,
 func (db Database) GetComicCover(ctx context.Context, conds any) (*model.ComicCover, error) {
 	var result model.ComicCover
 	args := []any{}
-	cond := SetWhere(conds, &args)
+	cond := db.dialect.SetWhere(conds, &args)
 	sql := "SELECT * FROM (SELECT w." + model.DBGenericID
 	sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
 	sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
@@ -726,21 +923,19 @@ func (db Database) UpdateComicCover(ctx context.Context, data model.SetComicCove
 		data0[null] = nil
 	}
 	data0[model.DBGenericUpdatedAt] = time.Now().UTC()
-	sets, args := SetUpdate(data0)
-	cond := SetWhere([]any{conds, SetUpdateWhere(data0)}, &args)
+	sets, args := db.dialect.SetUpdate(data0)
+	cond := db.dialect.SetWhere([]any{conds, db.dialect.SetUpdateWhere(data0)}, &args)
 	sql := "UPDATE " + model.DBComicCover + " SET " + sets + " WHERE " + cond
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericID
-		sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
-		sql += ", w." + model.DBWebsiteGenericWebsiteID + ", w." + model.DBComicCoverRelativeURL
-		sql += ", w." + model.DBComicCoverPriority
-		sql += ", l." + model.DBWebsiteDomain + " AS website_domain"
-		sql += " FROM data w JOIN " + model.DBWebsite + " l"
-		sql += " ON w." + model.DBWebsiteGenericWebsiteID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericID
+		sel += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
+		sel += ", w." + model.DBWebsiteGenericWebsiteID + ", w." + model.DBComicCoverRelativeURL
+		sel += ", w." + model.DBComicCoverPriority
+		sel += ", l." + model.DBWebsiteDomain + " AS website_domain"
+		sel += " FROM data w JOIN " + model.DBWebsite + " l"
+		sel += " ON w." + model.DBWebsiteGenericWebsiteID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBComicCover, sql, sel, args...); err != nil {
 			return comicCoverSetError(err)
 		}
 	} else {
@@ -753,20 +948,18 @@ func (db Database) UpdateComicCover(ctx context.Context, data model.SetComicCove
 
 func (db Database) DeleteComicCover(ctx context.Context, conds any, v *model.ComicCover) error {
 	args := []any{}
-	cond := SetWhere(conds, &args)
+	cond := db.dialect.SetWhere(conds, &args)
 	sql := "DELETE FROM " + model.DBComicCover + " WHERE " + cond
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericID
-		sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
-		sql += ", w." + model.DBWebsiteGenericWebsiteID + ", w." + model.DBComicCoverRelativeURL
-		sql += ", w." + model.DBComicCoverPriority
-		sql += ", l." + model.DBWebsiteDomain + " AS website_domain"
-		sql += " FROM data w JOIN " + model.DBWebsite + " l"
-		sql += " ON w." + model.DBWebsiteGenericWebsiteID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericID
+		sel += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
+		sel += ", w." + model.DBWebsiteGenericWebsiteID + ", w." + model.DBComicCoverRelativeURL
+		sel += ", w." + model.DBComicCoverPriority
+		sel += ", l." + model.DBWebsiteDomain + " AS website_domain"
+		sel += " FROM data w JOIN " + model.DBWebsite + " l"
+		sel += " ON w." + model.DBWebsiteGenericWebsiteID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBComicCover, sql, sel, args...); err != nil {
 			return err
 		}
 	} else {
@@ -780,27 +973,19 @@ func (db Database) DeleteComicCover(ctx context.Context, conds any, v *model.Com
 func (db Database) ListComicCover(ctx context.Context, params model.ListParams) ([]*model.ComicCover, error) {
 	result := []*model.ComicCover{}
 	args := []any{}
-	sql := "SELECT * FROM (SELECT w." + model.DBGenericID
-	sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-	sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
-	sql += ", w." + model.DBWebsiteGenericWebsiteID + ", w." + model.DBComicCoverRelativeURL
-	sql += ", w." + model.DBComicCoverPriority
-	sql += ", l." + model.DBWebsiteDomain + " AS website_domain"
-	sql += " FROM " + model.DBComicCover + " w JOIN " + model.DBWebsite + " l"
-	sql += " ON w." + model.DBWebsiteGenericWebsiteID + " = l." + model.DBGenericID
-	sql += ")"
-	if cond := SetWhere(params.Conditions, &args); cond != "" {
+	sql := "SELECT * FROM (" + comicCoverSelect() + ")"
+	if cond := db.dialect.SetWhere(params.Conditions, &args); cond != "" {
 		sql += " WHERE " + cond
 	}
 	if len(params.OrderBys) < 1 {
 		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBComicGenericRID})
 	}
 	params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID})
-	sql += " ORDER BY " + SetOrderBys(params.OrderBys, &args)
+	sql += " ORDER BY " + db.dialect.SetOrderBys(params.OrderBys, &args)
 	if params.Pagination == nil {
 		params.Pagination = &model.Pagination{Page: 1, Limit: model.ComicCoverPaginationDef}
 	}
-	if lmof := SetPagination(*params.Pagination, &args); lmof != "" {
+	if lmof := db.dialect.SetPagination(*params.Pagination, &args); lmof != "" {
 		sql += lmof
 	}
 	if err := db.QueryAll(ctx, &result, sql, args...); err != nil {
@@ -809,6 +994,18 @@ func (db Database) ListComicCover(ctx context.Context, params model.ListParams)
 	return result, nil
 }
 
+func comicCoverSelect() string {
+	sql := "SELECT w." + model.DBGenericID
+	sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+	sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
+	sql += ", w." + model.DBWebsiteGenericWebsiteID + ", w." + model.DBComicCoverRelativeURL
+	sql += ", w." + model.DBComicCoverPriority
+	sql += ", l." + model.DBWebsiteDomain + " AS website_domain"
+	sql += " FROM " + model.DBComicCover + " w JOIN " + model.DBWebsite + " l"
+	sql += " ON w." + model.DBWebsiteGenericWebsiteID + " = l." + model.DBGenericID
+	return sql
+}
+
 func (db Database) CountComicCover(ctx context.Context, conds any) (int, error) {
 	return db.GenericCount(ctx, model.DBComicCover, conds)
 }
@@ -858,7 +1055,7 @@ func (db Database) AddComicSynopsis(ctx context.Context, data model.AddComicSyno
 	case data.LanguageIETF != nil:
 		languageID = model.DBLanguageIETFToID(*data.LanguageIETF)
 	}
-	cols, vals, args := SetInsert(map[string]any{
+	cols, vals, args := db.dialect.SetInsert(map[string]any{
 		model.DBComicGenericComicID:       comicID,
 		model.DBComicGenericRID:           rid,
 		model.DBLanguageGenericLanguageID: languageID,
@@ -868,17 +1065,15 @@ func (db Database) AddComicSynopsis(ctx context.Context, data model.AddComicSyno
 	})
 	sql := "INSERT INTO " + model.DBComicSynopsis + " (" + cols + ") VALUES (" + vals + ")"
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericID
-		sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
-		sql += ", w." + model.DBLanguageGenericLanguageID + ", w." + model.DBComicSynopsisSynopsis
-		sql += ", w." + model.DBComicSynopsisVersion + ", w." + model.DBComicSynopsisRomanized
-		sql += ", l." + model.DBLanguageIETF + " AS language_ietf"
-		sql += " FROM data w JOIN " + model.DBLanguage + " l"
-		sql += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericID
+		sel += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
+		sel += ", w." + model.DBLanguageGenericLanguageID + ", w." + model.DBComicSynopsisSynopsis
+		sel += ", w." + model.DBComicSynopsisVersion + ", w." + model.DBComicSynopsisRomanized
+		sel += ", l." + model.DBLanguageIETF + " AS language_ietf"
+		sel += " FROM data w JOIN " + model.DBLanguage + " l"
+		sel += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBComicSynopsis, sql, sel, args...); err != nil {
 			return comicSynopsisSetError(err)
 		}
 	} else {
@@ -892,7 +1087,7 @@ func (db Database) AddComicSynopsis(ctx context.Context, data model.AddComicSyno
 func (db Database) GetComicSynopsis(ctx context.Context, conds any) (*model.ComicSynopsis, error) {
 	var result model.ComicSynopsis
 	args := []any{}
-	cond := SetWhere(conds, &args)
+	cond := db.dialect.SetWhere(conds, &args)
 	sql := "SELECT * FROM (SELECT w." + model.DBGenericID
 	sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
 	sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
@@ -939,21 +1134,19 @@ func (db Database) UpdateComicSynopsis(ctx context.Context, data model.SetComicS
 		data0[null] = nil
 	}
 	data0[model.DBGenericUpdatedAt] = time.Now().UTC()
-	sets, args := SetUpdate(data0)
-	cond := SetWhere([]any{conds, SetUpdateWhere(data0)}, &args)
+	sets, args := db.dialect.SetUpdate(data0)
+	cond := db.dialect.SetWhere([]any{conds, db.dialect.SetUpdateWhere(data0)}, &args)
 	sql := "UPDATE " + model.DBComicSynopsis + " SET " + sets + " WHERE " + cond
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericID
-		sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
-		sql += ", w." + model.DBLanguageGenericLanguageID + ", w." + model.DBComicSynopsisSynopsis
-		sql += ", w." + model.DBComicSynopsisVersion + ", w." + model.DBComicSynopsisRomanized
-		sql += ", l." + model.DBLanguageIETF + " AS language_ietf"
-		sql += " FROM data w JOIN " + model.DBLanguage + " l"
-		sql += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericID
+		sel += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
+		sel += ", w." + model.DBLanguageGenericLanguageID + ", w." + model.DBComicSynopsisSynopsis
+		sel += ", w." + model.DBComicSynopsisVersion + ", w." + model.DBComicSynopsisRomanized
+		sel += ", l." + model.DBLanguageIETF + " AS language_ietf"
+		sel += " FROM data w JOIN " + model.DBLanguage + " l"
+		sel += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBComicSynopsis, sql, sel, args...); err != nil {
 			return comicSynopsisSetError(err)
 		}
 	} else {
@@ -966,20 +1159,18 @@ func (db Database) UpdateComicSynopsis(ctx context.Context, data model.SetComicS
 
 func (db Database) DeleteComicSynopsis(ctx context.Context, conds any, v *model.ComicSynopsis) error {
 	args := []any{}
-	cond := SetWhere(conds, &args)
+	cond := db.dialect.SetWhere(conds, &args)
 	sql := "DELETE FROM " + model.DBComicSynopsis + " WHERE " + cond
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericID
-		sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
-		sql += ", w." + model.DBLanguageGenericLanguageID + ", w." + model.DBComicSynopsisSynopsis
-		sql += ", w." + model.DBComicSynopsisVersion + ", w." + model.DBComicSynopsisRomanized
-		sql += ", l." + model.DBLanguageIETF + " AS language_ietf"
-		sql += " FROM data w JOIN " + model.DBLanguage + " l"
-		sql += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericID
+		sel += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
+		sel += ", w." + model.DBLanguageGenericLanguageID + ", w." + model.DBComicSynopsisSynopsis
+		sel += ", w." + model.DBComicSynopsisVersion + ", w." + model.DBComicSynopsisRomanized
+		sel += ", l." + model.DBLanguageIETF + " AS language_ietf"
+		sel += " FROM data w JOIN " + model.DBLanguage + " l"
+		sel += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBComicSynopsis, sql, sel, args...); err != nil {
 			return err
 		}
 	} else {
@@ -993,27 +1184,19 @@ func (db Database) DeleteComicSynopsis(ctx context.Context, conds any, v *model.
 func (db Database) ListComicSynopsis(ctx context.Context, params model.ListParams) ([]*model.ComicSynopsis, error) {
 	result := []*model.ComicSynopsis{}
 	args := []any{}
-	sql := "SELECT * FROM (SELECT w." + model.DBGenericID
-	sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-	sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
-	sql += ", w." + model.DBLanguageGenericLanguageID + ", w." + model.DBComicSynopsisSynopsis
-	sql += ", w." + model.DBComicSynopsisVersion + ", w." + model.DBComicSynopsisRomanized
-	sql += ", l." + model.DBLanguageIETF + " AS language_ietf"
-	sql += " FROM " + model.DBComicSynopsis + " w JOIN " + model.DBLanguage + " l"
-	sql += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
-	sql += ")"
-	if cond := SetWhere(params.Conditions, &args); cond != "" {
+	sql := "SELECT * FROM (" + comicSynopsisSelect() + ")"
+	if cond := db.dialect.SetWhere(params.Conditions, &args); cond != "" {
 		sql += " WHERE " + cond
 	}
 	if len(params.OrderBys) < 1 {
 		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBComicGenericRID})
 	}
 	params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID})
-	sql += " ORDER BY " + SetOrderBys(params.OrderBys, &args)
+	sql += " ORDER BY " + db.dialect.SetOrderBys(params.OrderBys, &args)
 	if params.Pagination == nil {
 		params.Pagination = &model.Pagination{Page: 1, Limit: model.ComicSynopsisPaginationDef}
 	}
-	if lmof := SetPagination(*params.Pagination, &args); lmof != "" {
+	if lmof := db.dialect.SetPagination(*params.Pagination, &args); lmof != "" {
 		sql += lmof
 	}
 	if err := db.QueryAll(ctx, &result, sql, args...); err != nil {
@@ -1022,6 +1205,18 @@ func (db Database) ListComicSynopsis(ctx context.Context, params model.ListParam
 	return result, nil
 }
 
+func comicSynopsisSelect() string {
+	sql := "SELECT w." + model.DBGenericID
+	sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+	sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
+	sql += ", w." + model.DBLanguageGenericLanguageID + ", w." + model.DBComicSynopsisSynopsis
+	sql += ", w." + model.DBComicSynopsisVersion + ", w." + model.DBComicSynopsisRomanized
+	sql += ", l." + model.DBLanguageIETF + " AS language_ietf"
+	sql += " FROM " + model.DBComicSynopsis + " w JOIN " + model.DBLanguage + " l"
+	sql += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
+	return sql
+}
+
 func (db Database) CountComicSynopsis(ctx context.Context, conds any) (int, error) {
 	return db.GenericCount(ctx, model.DBComicSynopsis, conds)
 }
@@ -1071,7 +1266,7 @@ func (db Database) AddComicExternal(ctx context.Context, data model.AddComicExte
 	case data.WebsiteDomain != nil:
 		websiteID = model.DBWebsiteDomainToID(*data.WebsiteDomain)
 	}
-	cols, vals, args := SetInsert(map[string]any{
+	cols, vals, args := db.dialect.SetInsert(map[string]any{
 		model.DBComicGenericComicID:      comicID,
 		model.DBComicGenericRID:          rid,
 		model.DBWebsiteGenericWebsiteID:  websiteID,
@@ -1080,17 +1275,15 @@ func (db Database) AddComicExternal(ctx context.Context, data model.AddComicExte
 	})
 	sql := "INSERT INTO " + model.DBComicExternal + " (" + cols + ") VALUES (" + vals + ")"
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericID
-		sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
-		sql += ", w." + model.DBWebsiteGenericWebsiteID + ", w." + model.DBComicExternalRelativeURL
-		sql += ", w." + model.DBComicExternalOfficial
-		sql += ", l." + model.DBWebsiteDomain + " AS website_domain"
-		sql += " FROM data w JOIN " + model.DBWebsite + " l"
-		sql += " ON w." + model.DBWebsiteGenericWebsiteID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericID
+		sel += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
+		sel += ", w." + model.DBWebsiteGenericWebsiteID + ", w." + model.DBComicExternalRelativeURL
+		sel += ", w." + model.DBComicExternalOfficial
+		sel += ", l." + model.DBWebsiteDomain + " AS website_domain"
+		sel += " FROM data w JOIN " + model.DBWebsite + " l"
+		sel += " ON w." + model.DBWebsiteGenericWebsiteID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBComicExternal, sql, sel, args...); err != nil {
 			return comicExternalSetError(err)
 		}
 	} else {
@@ -1104,7 +1297,7 @@ func (db Database) AddComicExternal(ctx context.Context, data model.AddComicExte
 func (db Database) GetComicExternal(ctx context.Context, conds any) (*model.ComicExternal, error) {
 	var result model.ComicExternal
 	args := []any{}
-	cond := SetWhere(conds, &args)
+	cond := db.dialect.SetWhere(conds, &args)
 	sql := "SELECT * FROM (SELECT w." + model.DBGenericID
 	sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
 	sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
@@ -1148,21 +1341,19 @@ func (db Database) UpdateComicExternal(ctx context.Context, data model.SetComicE
 		data0[null] = nil
 	}
 	data0[model.DBGenericUpdatedAt] = time.Now().UTC()
-	sets, args := SetUpdate(data0)
-	cond := SetWhere([]any{conds, SetUpdateWhere(data0)}, &args)
+	sets, args := db.dialect.SetUpdate(data0)
+	cond := db.dialect.SetWhere([]any{conds, db.dialect.SetUpdateWhere(data0)}, &args)
 	sql := "UPDATE " + model.DBComicExternal + " SET " + sets + " WHERE " + cond
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericID
-		sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
-		sql += ", w." + model.DBWebsiteGenericWebsiteID + ", w." + model.DBComicExternalRelativeURL
-		sql += ", w." + model.DBComicExternalOfficial
-		sql += ", l." + model.DBWebsiteDomain + " AS website_domain"
-		sql += " FROM data w JOIN " + model.DBWebsite + " l"
-		sql += " ON w." + model.DBWebsiteGenericWebsiteID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericID
+		sel += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
+		sel += ", w." + model.DBWebsiteGenericWebsiteID + ", w." + model.DBComicExternalRelativeURL
+		sel += ", w." + model.DBComicExternalOfficial
+		sel += ", l." + model.DBWebsiteDomain + " AS website_domain"
+		sel += " FROM data w JOIN " + model.DBWebsite + " l"
+		sel += " ON w." + model.DBWebsiteGenericWebsiteID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBComicExternal, sql, sel, args...); err != nil {
 			return comicExternalSetError(err)
 		}
 	} else {
@@ -1175,20 +1366,18 @@ func (db Database) UpdateComicExternal(ctx context.Context, data model.SetComicE
 
 func (db Database) DeleteComicExternal(ctx context.Context, conds any, v *model.ComicExternal) error {
 	args := []any{}
-	cond := SetWhere(conds, &args)
+	cond := db.dialect.SetWhere(conds, &args)
 	sql := "DELETE FROM " + model.DBComicExternal + " WHERE " + cond
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericID
-		sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
-		sql += ", w." + model.DBWebsiteGenericWebsiteID + ", w." + model.DBComicExternalRelativeURL
-		sql += ", w." + model.DBComicExternalOfficial
-		sql += ", l." + model.DBWebsiteDomain + " AS website_domain"
-		sql += " FROM data w JOIN " + model.DBWebsite + " l"
-		sql += " ON w." + model.DBWebsiteGenericWebsiteID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericID
+		sel += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
+		sel += ", w." + model.DBWebsiteGenericWebsiteID + ", w." + model.DBComicExternalRelativeURL
+		sel += ", w." + model.DBComicExternalOfficial
+		sel += ", l." + model.DBWebsiteDomain + " AS website_domain"
+		sel += " FROM data w JOIN " + model.DBWebsite + " l"
+		sel += " ON w." + model.DBWebsiteGenericWebsiteID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBComicExternal, sql, sel, args...); err != nil {
 			return err
 		}
 	} else {
@@ -1202,27 +1391,19 @@ func (db Database) DeleteComicExternal(ctx context.Context, conds any, v *model.
 func (db Database) ListComicExternal(ctx context.Context, params model.ListParams) ([]*model.ComicExternal, error) {
 	result := []*model.ComicExternal{}
 	args := []any{}
-	sql := "SELECT * FROM (SELECT w." + model.DBGenericID
-	sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-	sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
-	sql += ", w." + model.DBWebsiteGenericWebsiteID + ", w." + model.DBComicExternalRelativeURL
-	sql += ", w." + model.DBComicExternalOfficial
-	sql += ", l." + model.DBWebsiteDomain + " AS website_domain"
-	sql += " FROM " + model.DBComicExternal + " w JOIN " + model.DBWebsite + " l"
-	sql += " ON w." + model.DBWebsiteGenericWebsiteID + " = l." + model.DBGenericID
-	sql += ")"
-	if cond := SetWhere(params.Conditions, &args); cond != "" {
+	sql := "SELECT * FROM (" + comicExternalSelect() + ")"
+	if cond := db.dialect.SetWhere(params.Conditions, &args); cond != "" {
 		sql += " WHERE " + cond
 	}
 	if len(params.OrderBys) < 1 {
 		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBComicGenericRID})
 	}
 	params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID})
-	sql += " ORDER BY " + SetOrderBys(params.OrderBys, &args)
+	sql += " ORDER BY " + db.dialect.SetOrderBys(params.OrderBys, &args)
 	if params.Pagination == nil {
 		params.Pagination = &model.Pagination{Page: 1, Limit: model.ComicExternalPaginationDef}
 	}
-	if lmof := SetPagination(*params.Pagination, &args); lmof != "" {
+	if lmof := db.dialect.SetPagination(*params.Pagination, &args); lmof != "" {
 		sql += lmof
 	}
 	if err := db.QueryAll(ctx, &result, sql, args...); err != nil {
@@ -1231,6 +1412,18 @@ func (db Database) ListComicExternal(ctx context.Context, params model.ListParam
 	return result, nil
 }
 
+func comicExternalSelect() string {
+	sql := "SELECT w." + model.DBGenericID
+	sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+	sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicGenericRID
+	sql += ", w." + model.DBWebsiteGenericWebsiteID + ", w." + model.DBComicExternalRelativeURL
+	sql += ", w." + model.DBComicExternalOfficial
+	sql += ", l." + model.DBWebsiteDomain + " AS website_domain"
+	sql += " FROM " + model.DBComicExternal + " w JOIN " + model.DBWebsite + " l"
+	sql += " ON w." + model.DBWebsiteGenericWebsiteID + " = l." + model.DBGenericID
+	return sql
+}
+
 func (db Database) CountComicExternal(ctx context.Context, conds any) (int, error) {
 	return db.GenericCount(ctx, model.DBComicExternal, conds)
 }
@@ -1277,21 +1470,19 @@ func (db Database) AddComicCategory(ctx context.Context, data model.AddComicCate
 			Code:     *data.CategoryCode,
 		})
 	}
-	cols, vals, args := SetInsert(map[string]any{
+	cols, vals, args := db.dialect.SetInsert(map[string]any{
 		model.DBComicGenericComicID:       comicID,
 		model.DBCategoryGenericCategoryID: categoryID,
 	})
 	sql := "INSERT INTO " + model.DBComicCategory + " (" + cols + ") VALUES (" + vals + ")"
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBCategoryGenericCategoryID
-		sql += ", l." + model.DBCategoryTypeID + " AS category_type_id"
-		sql += ", l." + model.DBCategoryCode + " AS category_code"
-		sql += " FROM data w JOIN " + model.DBCategory + " l"
-		sql += " ON w." + model.DBCategoryGenericCategoryID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBComicGenericComicID + ", w." + model.DBCategoryGenericCategoryID
+		sel += ", l." + model.DBCategoryTypeID + " AS category_type_id"
+		sel += ", l." + model.DBCategoryCode + " AS category_code"
+		sel += " FROM data w JOIN " + model.DBCategory + " l"
+		sel += " ON w." + model.DBCategoryGenericCategoryID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBComicCategory, sql, sel, args...); err != nil {
 			return comicCategorySetError(err)
 		}
 	} else {
@@ -1305,7 +1496,7 @@ func (db Database) AddComicCategory(ctx context.Context, data model.AddComicCate
 func (db Database) GetComicCategory(ctx context.Context, conds any) (*model.ComicCategory, error) {
 	var result model.ComicCategory
 	args := []any{}
-	cond := SetWhere(conds, &args)
+	cond := db.dialect.SetWhere(conds, &args)
 	sql := "SELECT * FROM (SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
 	sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBCategoryGenericCategoryID
 	sql += ", l." + model.DBCategoryTypeID + " AS category_type_id"
@@ -1339,19 +1530,17 @@ func (db Database) UpdateComicCategory(ctx context.Context, data model.SetComicC
 		})
 	}
 	data0[model.DBGenericUpdatedAt] = time.Now().UTC()
-	sets, args := SetUpdate(data0)
-	cond := SetWhere([]any{conds, SetUpdateWhere(data0)}, &args)
+	sets, args := db.dialect.SetUpdate(data0)
+	cond := db.dialect.SetWhere([]any{conds, db.dialect.SetUpdateWhere(data0)}, &args)
 	sql := "UPDATE " + model.DBComicCategory + " SET " + sets + " WHERE " + cond
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBCategoryGenericCategoryID
-		sql += ", l." + model.DBCategoryTypeID + " AS category_type_id"
-		sql += ", l." + model.DBCategoryCode + " AS category_code"
-		sql += " FROM data w JOIN " + model.DBCategory + " l"
-		sql += " ON w." + model.DBCategoryGenericCategoryID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBComicGenericComicID + ", w." + model.DBCategoryGenericCategoryID
+		sel += ", l." + model.DBCategoryTypeID + " AS category_type_id"
+		sel += ", l." + model.DBCategoryCode + " AS category_code"
+		sel += " FROM data w JOIN " + model.DBCategory + " l"
+		sel += " ON w." + model.DBCategoryGenericCategoryID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBComicCategory, sql, sel, args...); err != nil {
 			return comicCategorySetError(err)
 		}
 	} else {
@@ -1364,18 +1553,16 @@ func (db Database) UpdateComicCategory(ctx context.Context, data model.SetComicC
 
 func (db Database) DeleteComicCategory(ctx context.Context, conds any, v *model.ComicCategory) error {
 	args := []any{}
-	cond := SetWhere(conds, &args)
+	cond := db.dialect.SetWhere(conds, &args)
 	sql := "DELETE FROM " + model.DBComicCategory + " WHERE " + cond
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBCategoryGenericCategoryID
-		sql += ", l." + model.DBCategoryTypeID + " AS category_type_id"
-		sql += ", l." + model.DBCategoryCode + " AS category_code"
-		sql += " FROM data w JOIN " + model.DBCategory + " l"
-		sql += " ON w." + model.DBCategoryGenericCategoryID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBComicGenericComicID + ", w." + model.DBCategoryGenericCategoryID
+		sel += ", l." + model.DBCategoryTypeID + " AS category_type_id"
+		sel += ", l." + model.DBCategoryCode + " AS category_code"
+		sel += " FROM data w JOIN " + model.DBCategory + " l"
+		sel += " ON w." + model.DBCategoryGenericCategoryID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBComicCategory, sql, sel, args...); err != nil {
 			return err
 		}
 	} else {
@@ -1389,24 +1576,18 @@ func (db Database) DeleteComicCategory(ctx context.Context, conds any, v *model.
 func (db Database) ListComicCategory(ctx context.Context, params model.ListParams) ([]*model.ComicCategory, error) {
 	result := []*model.ComicCategory{}
 	args := []any{}
-	sql := "SELECT * FROM (SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-	sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBCategoryGenericCategoryID
-	sql += ", l." + model.DBCategoryTypeID + " AS category_type_id"
-	sql += ", l." + model.DBCategoryCode + " AS category_code"
-	sql += " FROM " + model.DBComicCategory + " w JOIN " + model.DBCategory + " l"
-	sql += " ON w." + model.DBCategoryGenericCategoryID + " = l." + model.DBGenericID
-	sql += ")"
-	if cond := SetWhere(params.Conditions, &args); cond != "" {
+	sql := "SELECT * FROM (" + comicCategorySelect() + ")"
+	if cond := db.dialect.SetWhere(params.Conditions, &args); cond != "" {
 		sql += " WHERE " + cond
 	}
 	if len(params.OrderBys) < 1 {
 		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBCategoryGenericCategoryID})
 	}
-	sql += " ORDER BY " + SetOrderBys(params.OrderBys, &args)
+	sql += " ORDER BY " + db.dialect.SetOrderBys(params.OrderBys, &args)
 	if params.Pagination == nil {
 		params.Pagination = &model.Pagination{Page: 1, Limit: model.ComicCategoryPaginationDef}
 	}
-	if lmof := SetPagination(*params.Pagination, &args); lmof != "" {
+	if lmof := db.dialect.SetPagination(*params.Pagination, &args); lmof != "" {
 		sql += lmof
 	}
 	if err := db.QueryAll(ctx, &result, sql, args...); err != nil {
@@ -1415,6 +1596,17 @@ func (db Database) ListComicCategory(ctx context.Context, params model.ListParam
 	return result, nil
 }
 
+func comicCategorySelect() string {
+	sql := "SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+	sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBCategoryGenericCategoryID
+	sql += ", l." + model.DBCategoryTypeID + " AS category_type_id"
+	sql += ", l." + model.DBCategoryCode + " AS category_code"
+	sql += " FROM " + model.DBComicCategory + " w JOIN " + model.DBCategory + " l"
+	sql += " ON w." + model.DBCategoryGenericCategoryID + " = l." + model.DBGenericID
+	sql += " AND l." + model.DBGenericDeletedAt + " IS NULL"
+	return sql
+}
+
 func (db Database) CountComicCategory(ctx context.Context, conds any) (int, error) {
 	return db.GenericCount(ctx, model.DBComicCategory, conds)
 }
@@ -1456,21 +1648,19 @@ func (db Database) AddComicTag(ctx context.Context, data model.AddComicTag, v *m
 			Code:     *data.TagCode,
 		})
 	}
-	cols, vals, args := SetInsert(map[string]any{
+	cols, vals, args := db.dialect.SetInsert(map[string]any{
 		model.DBComicGenericComicID: comicID,
 		model.DBTagGenericTagID:     tagID,
 	})
 	sql := "INSERT INTO " + model.DBComicTag + " (" + cols + ") VALUES (" + vals + ")"
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBTagGenericTagID
-		sql += ", l." + model.DBTagTypeID + " AS tag_type_id"
-		sql += ", l." + model.DBTagCode + " AS tag_code"
-		sql += " FROM data w JOIN " + model.DBTag + " l"
-		sql += " ON w." + model.DBTagGenericTagID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBComicGenericComicID + ", w." + model.DBTagGenericTagID
+		sel += ", l." + model.DBTagTypeID + " AS tag_type_id"
+		sel += ", l." + model.DBTagCode + " AS tag_code"
+		sel += " FROM data w JOIN " + model.DBTag + " l"
+		sel += " ON w." + model.DBTagGenericTagID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBComicTag, sql, sel, args...); err != nil {
 			return comicTagSetError(err)
 		}
 	} else {
@@ -1484,7 +1674,7 @@ func (db Database) AddComicTag(ctx context.Context, data model.AddComicTag, v *m
 func (db Database) GetComicTag(ctx context.Context, conds any) (*model.ComicTag, error) {
 	var result model.ComicTag
 	args := []any{}
-	cond := SetWhere(conds, &args)
+	cond := db.dialect.SetWhere(conds, &args)
 	sql := "SELECT * FROM (SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
 	sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBTagGenericTagID
 	sql += ", l." + model.DBTagTypeID + " AS tag_type_id"
@@ -1518,19 +1708,17 @@ func (db Database) UpdateComicTag(ctx context.Context, data model.SetComicTag, c
 		})
 	}
 	data0[model.DBGenericUpdatedAt] = time.Now().UTC()
-	sets, args := SetUpdate(data0)
-	cond := SetWhere([]any{conds, SetUpdateWhere(data0)}, &args)
+	sets, args := db.dialect.SetUpdate(data0)
+	cond := db.dialect.SetWhere([]any{conds, db.dialect.SetUpdateWhere(data0)}, &args)
 	sql := "UPDATE " + model.DBComicTag + " SET " + sets + " WHERE " + cond
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBTagGenericTagID
-		sql += ", l." + model.DBTagTypeID + " AS tag_type_id"
-		sql += ", l." + model.DBTagCode + " AS tag_code"
-		sql += " FROM data w JOIN " + model.DBTag + " l"
-		sql += " ON w." + model.DBTagGenericTagID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBComicGenericComicID + ", w." + model.DBTagGenericTagID
+		sel += ", l." + model.DBTagTypeID + " AS tag_type_id"
+		sel += ", l." + model.DBTagCode + " AS tag_code"
+		sel += " FROM data w JOIN " + model.DBTag + " l"
+		sel += " ON w." + model.DBTagGenericTagID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBComicTag, sql, sel, args...); err != nil {
 			return comicTagSetError(err)
 		}
 	} else {
@@ -1543,18 +1731,16 @@ func (db Database) UpdateComicTag(ctx context.Context, data model.SetComicTag, c
 
 func (db Database) DeleteComicTag(ctx context.Context, conds any, v *model.ComicTag) error {
 	args := []any{}
-	cond := SetWhere(conds, &args)
+	cond := db.dialect.SetWhere(conds, &args)
 	sql := "DELETE FROM " + model.DBComicTag + " WHERE " + cond
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBTagGenericTagID
-		sql += ", l." + model.DBTagTypeID + " AS tag_type_id"
-		sql += ", l." + model.DBTagCode + " AS tag_code"
-		sql += " FROM data w JOIN " + model.DBTag + " l"
-		sql += " ON w." + model.DBTagGenericTagID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBComicGenericComicID + ", w." + model.DBTagGenericTagID
+		sel += ", l." + model.DBTagTypeID + " AS tag_type_id"
+		sel += ", l." + model.DBTagCode + " AS tag_code"
+		sel += " FROM data w JOIN " + model.DBTag + " l"
+		sel += " ON w." + model.DBTagGenericTagID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBComicTag, sql, sel, args...); err != nil {
 			return err
 		}
 	} else {
@@ -1568,24 +1754,18 @@ func (db Database) DeleteComicTag(ctx context.Context, conds any, v *model.Comic
 func (db Database) ListComicTag(ctx context.Context, params model.ListParams) ([]*model.ComicTag, error) {
 	result := []*model.ComicTag{}
 	args := []any{}
-	sql := "SELECT * FROM (SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-	sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBTagGenericTagID
-	sql += ", l." + model.DBTagTypeID + " AS tag_type_id"
-	sql += ", l." + model.DBTagCode + " AS tag_code"
-	sql += " FROM " + model.DBComicTag + " w JOIN " + model.DBTag + " l"
-	sql += " ON w." + model.DBTagGenericTagID + " = l." + model.DBGenericID
-	sql += ")"
-	if cond := SetWhere(params.Conditions, &args); cond != "" {
+	sql := "SELECT * FROM (" + comicTagSelect() + ")"
+	if cond := db.dialect.SetWhere(params.Conditions, &args); cond != "" {
 		sql += " WHERE " + cond
 	}
 	if len(params.OrderBys) < 1 {
 		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBTagGenericTagID})
 	}
-	sql += " ORDER BY " + SetOrderBys(params.OrderBys, &args)
+	sql += " ORDER BY " + db.dialect.SetOrderBys(params.OrderBys, &args)
 	if params.Pagination == nil {
 		params.Pagination = &model.Pagination{Page: 1, Limit: model.ComicTagPaginationDef}
 	}
-	if lmof := SetPagination(*params.Pagination, &args); lmof != "" {
+	if lmof := db.dialect.SetPagination(*params.Pagination, &args); lmof != "" {
 		sql += lmof
 	}
 	if err := db.QueryAll(ctx, &result, sql, args...); err != nil {
@@ -1594,6 +1774,17 @@ func (db Database) ListComicTag(ctx context.Context, params model.ListParams) ([
 	return result, nil
 }
 
+func comicTagSelect() string {
+	sql := "SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+	sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBTagGenericTagID
+	sql += ", l." + model.DBTagTypeID + " AS tag_type_id"
+	sql += ", l." + model.DBTagCode + " AS tag_code"
+	sql += " FROM " + model.DBComicTag + " w JOIN " + model.DBTag + " l"
+	sql += " ON w." + model.DBTagGenericTagID + " = l." + model.DBGenericID
+	sql += " AND l." + model.DBGenericDeletedAt + " IS NULL"
+	return sql
+}
+
 func (db Database) CountComicTag(ctx context.Context, conds any) (int, error) {
 	return db.GenericCount(ctx, model.DBComicTag, conds)
 }
@@ -1713,21 +1904,19 @@ func (db Database) AddComicRelation(ctx context.Context, data model.AddComicRela
 		ctx = ttx
 		defer db.ContextTransactionRollback(context.WithoutCancel(ctx))
 	}
-	cols, vals, args := SetInsert(map[string]any{
+	cols, vals, args := db.dialect.SetInsert(map[string]any{
 		model.DBComicRelationTypeID:   typeID,
 		model.DBComicRelationParentID: parentID,
 		model.DBComicRelationChildID:  childID,
 	})
 	sql := "INSERT INTO " + model.DBComicRelation + " (" + cols + ") VALUES (" + vals + ")"
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBComicRelationParentID + ", w." + model.DBComicRelationTypeID
-		sql += ", w." + model.DBComicRelationChildID + ", l." + model.DBComicCode + " AS child_code"
-		sql += " FROM data w JOIN " + model.DBComic + " l"
-		sql += " ON w." + model.DBComicRelationChildID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBComicRelationParentID + ", w." + model.DBComicRelationTypeID
+		sel += ", w." + model.DBComicRelationChildID + ", l." + model.DBComicCode + " AS child_code"
+		sel += " FROM data w JOIN " + model.DBComic + " l"
+		sel += " ON w." + model.DBComicRelationChildID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBComicRelation, sql, sel, args...); err != nil {
 			return comicRelationSetError(err)
 		}
 	} else {
@@ -1759,7 +1948,7 @@ func (db Database) AddComicRelation(ctx context.Context, data model.AddComicRela
 func (db Database) GetComicRelation(ctx context.Context, conds any) (*model.ComicRelation, error) {
 	var result model.ComicRelation
 	args := []any{}
-	cond := SetWhere(conds, &args)
+	cond := db.dialect.SetWhere(conds, &args)
 	sql := "SELECT * FROM (SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
 	sql += ", w." + model.DBComicRelationParentID + ", w." + model.DBComicRelationTypeID
 	sql += ", w." + model.DBComicRelationChildID + ", l." + model.DBComicCode + " AS child_code"
@@ -1805,18 +1994,16 @@ func (db Database) UpdateComicRelation(ctx context.Context, data model.SetComicR
 		defer db.ContextTransactionRollback(context.WithoutCancel(ctx))
 	}
 	data0[model.DBGenericUpdatedAt] = time.Now().UTC()
-	sets, args := SetUpdate(data0)
-	cond := SetWhere([]any{conds, SetUpdateWhere(data0)}, &args)
+	sets, args := db.dialect.SetUpdate(data0)
+	cond := db.dialect.SetWhere([]any{conds, db.dialect.SetUpdateWhere(data0)}, &args)
 	sql := "UPDATE " + model.DBComicRelation + " SET " + sets + " WHERE " + cond
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBComicRelationParentID + ", w." + model.DBComicRelationTypeID
-		sql += ", w." + model.DBComicRelationChildID + ", l." + model.DBComicCode + " AS child_code"
-		sql += " FROM data w JOIN " + model.DBComic + " l"
-		sql += " ON w." + model.DBComicRelationChildID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBComicRelationParentID + ", w." + model.DBComicRelationTypeID
+		sel += ", w." + model.DBComicRelationChildID + ", l." + model.DBComicCode + " AS child_code"
+		sel += " FROM data w JOIN " + model.DBComic + " l"
+		sel += " ON w." + model.DBComicRelationChildID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBComicRelation, sql, sel, args...); err != nil {
 			return comicRelationSetError(err)
 		}
 	} else {
@@ -1847,17 +2034,15 @@ func (db Database) UpdateComicRelation(ctx context.Context, data model.SetComicR
 
 func (db Database) DeleteComicRelation(ctx context.Context, conds any, v *model.ComicRelation) error {
 	args := []any{}
-	cond := SetWhere(conds, &args)
+	cond := db.dialect.SetWhere(conds, &args)
 	sql := "DELETE FROM " + model.DBComicRelation + " WHERE " + cond
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBComicRelationParentID + ", w." + model.DBComicRelationTypeID
-		sql += ", w." + model.DBComicRelationChildID + ", l." + model.DBComicCode + " AS child_code"
-		sql += " FROM data w JOIN " + model.DBComic + " l"
-		sql += " ON w." + model.DBComicRelationChildID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBComicRelationParentID + ", w." + model.DBComicRelationTypeID
+		sel += ", w." + model.DBComicRelationChildID + ", l." + model.DBComicCode + " AS child_code"
+		sel += " FROM data w JOIN " + model.DBComic + " l"
+		sel += " ON w." + model.DBComicRelationChildID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBComicRelation, sql, sel, args...); err != nil {
 			return err
 		}
 	} else {
@@ -1871,23 +2056,18 @@ func (db Database) DeleteComicRelation(ctx context.Context, conds any, v *model.
 func (db Database) ListComicRelation(ctx context.Context, params model.ListParams) ([]*model.ComicRelation, error) {
 	result := []*model.ComicRelation{}
 	args := []any{}
-	sql := "SELECT * FROM (SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-	sql += ", w." + model.DBComicRelationParentID + ", w." + model.DBComicRelationTypeID
-	sql += ", w." + model.DBComicRelationChildID + ", l." + model.DBComicCode + " AS child_code"
-	sql += " FROM " + model.DBComicRelation + " w JOIN " + model.DBComic + " l"
-	sql += " ON w." + model.DBComicRelationChildID + " = l." + model.DBGenericID
-	sql += ")"
-	if cond := SetWhere(params.Conditions, &args); cond != "" {
+	sql := "SELECT * FROM (" + comicRelationSelect() + ")"
+	if cond := db.dialect.SetWhere(params.Conditions, &args); cond != "" {
 		sql += " WHERE " + cond
 	}
 	if len(params.OrderBys) < 1 {
 		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBComicRelationChildID})
 	}
-	sql += " ORDER BY " + SetOrderBys(params.OrderBys, &args)
+	sql += " ORDER BY " + db.dialect.SetOrderBys(params.OrderBys, &args)
 	if params.Pagination == nil {
 		params.Pagination = &model.Pagination{Page: 1, Limit: model.ComicRelationPaginationDef}
 	}
-	if lmof := SetPagination(*params.Pagination, &args); lmof != "" {
+	if lmof := db.dialect.SetPagination(*params.Pagination, &args); lmof != "" {
 		sql += lmof
 	}
 	if err := db.QueryAll(ctx, &result, sql, args...); err != nil {
@@ -1896,6 +2076,16 @@ func (db Database) ListComicRelation(ctx context.Context, params model.ListParam
 	return result, nil
 }
 
+func comicRelationSelect() string {
+	sql := "SELECT w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+	sql += ", w." + model.DBComicRelationParentID + ", w." + model.DBComicRelationTypeID
+	sql += ", w." + model.DBComicRelationChildID + ", l." + model.DBComicCode + " AS child_code"
+	sql += " FROM " + model.DBComicRelation + " w JOIN " + model.DBComic + " l"
+	sql += " ON w." + model.DBComicRelationChildID + " = l." + model.DBGenericID
+	sql += " AND l." + model.DBGenericDeletedAt + " IS NULL"
+	return sql
+}
+
 func (db Database) CountComicRelation(ctx context.Context, conds any) (int, error) {
 	return db.GenericCount(ctx, model.DBComicRelation, conds)
 }
@@ -1989,18 +2179,22 @@ func (db Database) DeleteComicChapter(ctx context.Context, conds any, v *model.C
 	return db.GenericDelete(ctx, model.DBComicChapter, conds, v)
 }
 
-func (db Database) ListComicChapter(ctx context.Context, params model.ListParams) ([]*model.ComicChapter, error) {
+func (db Database) ListComicChapter(ctx context.Context, params model.ListParams) ([]*model.ComicChapter, []any, error) {
 	result := []*model.ComicChapter{}
 	if len(params.OrderBys) < 1 {
 		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBComicChapterReleasedAt})
 	}
+	params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID})
 	if params.Pagination == nil {
 		params.Pagination = &model.Pagination{Page: 1, Limit: model.ComicChapterPaginationDef}
 	}
+	if err := setListCursor(&params); err != nil {
+		return nil, nil, err
+	}
 	if err := db.GenericList(ctx, model.DBComicChapter, params, &result); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return result, nil
+	return result, listNextCursor(params, result), nil
 }
 
 func (db Database) CountComicChapter(ctx context.Context, conds any) (int, error) {
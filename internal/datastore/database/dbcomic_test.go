@@ -0,0 +1,260 @@
+package database
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/logger"
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+	"github.com/mahmudindes/orenocomic-donoengine/internal/utila"
+)
+
+// BenchmarkGetComicDetail compares the single query of GetComicDetail with the
+// fan-out of list queries it replaced. The single query aggregates on
+// PostgreSQL only, so it runs against the PostgreSQL database of
+// DONOENGINE_DATASTORE_DATABASE_URL and is skipped without one.
+func BenchmarkGetComicDetail(b *testing.B) {
+	ctx := context.Background()
+	cfg := Config{
+		URL:         os.Getenv("DONOENGINE_DATASTORE_DATABASE_URL"),
+		Provider:    os.Getenv("DONOENGINE_DATASTORE_DATABASE_PROVIDER"),
+		AutoMigrate: true,
+	}
+	if cfg.URL == "" {
+		b.Skip("DONOENGINE_DATASTORE_DATABASE_URL is not set")
+	}
+	db, err := New(ctx, cfg, logger.New())
+	if err != nil {
+		b.Fatalf("New: %v", err)
+	}
+	b.Cleanup(func() { db.Close() })
+	if db.dialect != DialectPostgres {
+		b.Skip("GetComicDetail takes the fan-out path on " + cfg.Provider)
+	}
+
+	comic := seedComicDetail(b, ctx, db)
+	conds := model.DBConditionalKV{Key: model.DBGenericID, Value: comic.ID}
+
+	b.Run("query", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := db.GetComicDetail(ctx, conds); err != nil {
+				b.Fatalf("GetComicDetail: %v", err)
+			}
+		}
+	})
+	b.Run("fanout", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := getComicDetailFanOut(ctx, db, conds); err != nil {
+				b.Fatalf("fan-out: %v", err)
+			}
+		}
+	})
+}
+
+// seedComicDetail adds a comic with every kind of child, the rows are named
+// uniquely and removed on cleanup so a shared database is left as it was.
+func seedComicDetail(b testing.TB, ctx context.Context, db *Database) *model.Comic {
+	b.Helper()
+	key := utila.RandomString(utila.RandomStringLowercase, 8)
+	cleanup := map[string][]uint{}
+	b.Cleanup(func() {
+		for _, t := range []string{
+			model.DBComic, model.DBCategory, model.DBTag, model.DBCategoryType,
+			model.DBTagType, model.DBLanguage, model.DBWebsite,
+		} {
+			for _, id := range cleanup[t] {
+				if err := db.Exec(context.Background(), "DELETE FROM "+t+" WHERE id = $1", id); err != nil {
+					b.Errorf("cleanup %s: %v", t, err)
+				}
+			}
+		}
+	})
+	language, website := new(model.Language), new(model.Website)
+	if err := db.AddLanguage(ctx, model.AddLanguage{IETF: "x-" + key, Name: "Bench"}, language); err != nil {
+		b.Fatalf("AddLanguage: %v", err)
+	}
+	if err := db.AddWebsite(ctx, model.AddWebsite{Domain: key + ".example.com", Name: "Bench"}, website); err != nil {
+		b.Fatalf("AddWebsite: %v", err)
+	}
+	categoryType, tagType := new(model.CategoryType), new(model.TagType)
+	if err := db.AddCategoryType(ctx, model.AddCategoryType{Code: key, Name: "Bench"}, categoryType); err != nil {
+		b.Fatalf("AddCategoryType: %v", err)
+	}
+	if err := db.AddTagType(ctx, model.AddTagType{Code: key, Name: "Bench"}, tagType); err != nil {
+		b.Fatalf("AddTagType: %v", err)
+	}
+	cleanup[model.DBLanguage] = []uint{language.ID}
+	cleanup[model.DBWebsite] = []uint{website.ID}
+	cleanup[model.DBCategoryType] = []uint{categoryType.ID}
+	cleanup[model.DBTagType] = []uint{tagType.ID}
+	comic := new(model.Comic)
+	if err := db.AddComic(ctx, model.AddComic{LanguageID: &language.ID}, comic); err != nil {
+		b.Fatalf("AddComic: %v", err)
+	}
+	cleanup[model.DBComic] = []uint{comic.ID}
+
+	for i := 0; i < 5; i++ {
+		n := strconv.Itoa(i)
+		relativeURL := "/comic/" + n
+		if err := db.AddComicTitle(ctx, model.AddComicTitle{
+			ComicID: &comic.ID, LanguageID: &language.ID, Title: "Title " + n,
+		}, new(model.ComicTitle)); err != nil {
+			b.Fatalf("AddComicTitle: %v", err)
+		}
+		if err := db.AddComicCover(ctx, model.AddComicCover{
+			ComicID: &comic.ID, WebsiteID: &website.ID, RelativeURL: "/cover/" + n,
+		}, new(model.ComicCover)); err != nil {
+			b.Fatalf("AddComicCover: %v", err)
+		}
+		if err := db.AddComicExternal(ctx, model.AddComicExternal{
+			ComicID: &comic.ID, WebsiteID: &website.ID, RelativeURL: &relativeURL,
+		}, new(model.ComicExternal)); err != nil {
+			b.Fatalf("AddComicExternal: %v", err)
+		}
+		category, tag := new(model.Category), new(model.Tag)
+		if err := db.AddCategory(ctx, model.AddCategory{TypeID: &categoryType.ID, Code: "bench" + n, Name: n}, category); err != nil {
+			b.Fatalf("AddCategory: %v", err)
+		}
+		cleanup[model.DBCategory] = append(cleanup[model.DBCategory], category.ID)
+		if err := db.AddComicCategory(ctx, model.AddComicCategory{ComicID: &comic.ID, CategoryID: &category.ID}, new(model.ComicCategory)); err != nil {
+			b.Fatalf("AddComicCategory: %v", err)
+		}
+		if err := db.AddTag(ctx, model.AddTag{TypeID: &tagType.ID, Code: "bench" + n, Name: n}, tag); err != nil {
+			b.Fatalf("AddTag: %v", err)
+		}
+		cleanup[model.DBTag] = append(cleanup[model.DBTag], tag.ID)
+		if err := db.AddComicTag(ctx, model.AddComicTag{ComicID: &comic.ID, TagID: &tag.ID}, new(model.ComicTag)); err != nil {
+			b.Fatalf("AddComicTag: %v", err)
+		}
+	}
+	for i := 0; i < 50; i++ {
+		if err := db.AddComicChapter(ctx, model.AddComicChapter{
+			ComicID: &comic.ID, Chapter: strconv.Itoa(i), ReleasedAt: time.Now(),
+		}, new(model.ComicChapter)); err != nil {
+			b.Fatalf("AddComicChapter: %v", err)
+		}
+	}
+	return comic
+}
+
+// getComicDetailFanOut loads the comic detail the way the service did before
+// GetComicDetail, a query for each child list.
+func getComicDetailFanOut(ctx context.Context, db *Database, conds any) (*model.Comic, error) {
+	result, err := db.GetComic(ctx, conds)
+	if err != nil {
+		return nil, err
+	}
+	params := func() model.ListParams {
+		return model.ListParams{
+			Conditions: model.DBConditionalKV{Key: model.DBComicGenericComicID, Value: result.ID},
+			Pagination: &model.Pagination{},
+		}
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() (err error) {
+		result.Titles, err = db.ListComicTitle(gctx, params())
+		return err
+	})
+	g.Go(func() (err error) {
+		result.Covers, err = db.ListComicCover(gctx, params())
+		return err
+	})
+	g.Go(func() (err error) {
+		result.Synopses, err = db.ListComicSynopsis(gctx, params())
+		return err
+	})
+	g.Go(func() (err error) {
+		result.Chapters, _, err = db.ListComicChapter(gctx, params())
+		return err
+	})
+	g.Go(func() (err error) {
+		result.Externals, err = db.ListComicExternal(gctx, params())
+		return err
+	})
+	g.Go(func() error {
+		categories, err := db.ListComicCategory(gctx, params())
+		if err != nil {
+			return err
+		}
+		result.Categories = []*model.Category{}
+		if len(categories) < 1 {
+			return nil
+		}
+		conditions := []any{model.DBLogicalOR{}}
+		for _, category := range categories {
+			conditions = append(conditions, model.DBConditionalKV{Key: model.DBGenericID, Value: category.CategoryID})
+		}
+		result.Categories, _, err = db.ListCategory(gctx, model.ListParams{
+			Conditions: conditions,
+			Pagination: &model.Pagination{},
+		})
+		return err
+	})
+	g.Go(func() error {
+		tags, err := db.ListComicTag(gctx, params())
+		if err != nil {
+			return err
+		}
+		result.Tags = []*model.Tag{}
+		if len(tags) < 1 {
+			return nil
+		}
+		conditions := []any{model.DBLogicalOR{}}
+		for _, tag := range tags {
+			conditions = append(conditions, model.DBConditionalKV{Key: model.DBGenericID, Value: tag.TagID})
+		}
+		result.Tags, _, err = db.ListTag(gctx, model.ListParams{
+			Conditions: conditions,
+			Pagination: &model.Pagination{},
+		})
+		return err
+	})
+	g.Go(func() (err error) {
+		result.Relations, err = db.ListComicRelation(gctx, model.ListParams{
+			Conditions: model.DBConditionalKV{Key: model.DBComicRelationParentID, Value: result.ID},
+			Pagination: &model.Pagination{},
+		})
+		return err
+	})
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func TestGetComicDetailFanOut(t *testing.T) {
+	ctx := context.Background()
+	db := newSQLite(t)
+	comic := seedComicDetail(t, ctx, db)
+	conds := model.DBConditionalKV{Key: model.DBGenericID, Value: comic.ID}
+
+	query, err := db.GetComicDetail(ctx, conds)
+	if err != nil {
+		t.Fatalf("GetComicDetail: %v", err)
+	}
+	fanOut, err := getComicDetailFanOut(ctx, db, conds)
+	if err != nil {
+		t.Fatalf("fan-out: %v", err)
+	}
+	for _, c := range []struct {
+		name       string
+		got, other int
+	}{
+		{"titles", len(query.Titles), len(fanOut.Titles)},
+		{"covers", len(query.Covers), len(fanOut.Covers)},
+		{"chapters", len(query.Chapters), len(fanOut.Chapters)},
+		{"externals", len(query.Externals), len(fanOut.Externals)},
+		{"categories", len(query.Categories), len(fanOut.Categories)},
+		{"tags", len(query.Tags), len(fanOut.Tags)},
+	} {
+		if c.got == 0 || c.got != c.other {
+			t.Errorf("%s: query loaded %d, fan-out %d", c.name, c.got, c.other)
+		}
+	}
+}
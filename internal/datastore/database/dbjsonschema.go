@@ -0,0 +1,52 @@
+package database
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+const (
+	NameErrJSONSchemaKey = "json_schema_name_key"
+)
+
+func (db Database) AddJSONSchema(ctx context.Context, data model.AddJSONSchema, v *model.JSONSchema) error {
+	if err := db.GenericAdd(ctx, model.DBJSONSchema, map[string]any{
+		model.DBJSONSchemaName:   data.Name,
+		model.DBJSONSchemaSchema: data.Schema,
+	}, v); err != nil {
+		return jsonSchemaSetError(err)
+	}
+	return nil
+}
+
+func (db Database) GetJSONSchema(ctx context.Context, conds any) (*model.JSONSchema, error) {
+	var result model.JSONSchema
+	if err := db.GenericGet(ctx, model.DBJSONSchema, conds, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (db Database) UpdateJSONSchema(ctx context.Context, data model.SetJSONSchema, conds any, v *model.JSONSchema) error {
+	data0 := map[string]any{}
+	if data.Schema != nil {
+		data0[model.DBJSONSchemaSchema] = data.Schema
+	}
+	return db.GenericUpdate(ctx, model.DBJSONSchema, data0, conds, v)
+}
+
+func (db Database) DeleteJSONSchema(ctx context.Context, conds any, v *model.JSONSchema) error {
+	return db.GenericDelete(ctx, model.DBJSONSchema, conds, v)
+}
+
+func jsonSchemaSetError(err error) error {
+	var errDatabase model.DatabaseError
+	if errors.As(err, &errDatabase) {
+		if errDatabase.Code == CodeErrExists && errDatabase.Name == NameErrJSONSchemaKey {
+			return model.GenericError("same name already exists")
+		}
+	}
+	return err
+}
@@ -47,18 +47,22 @@ func (db Database) DeleteLanguage(ctx context.Context, conds any, v *model.Langu
 	return db.GenericDelete(ctx, model.DBLanguage, conds, v)
 }
 
-func (db Database) ListLanguage(ctx context.Context, params model.ListParams) ([]*model.Language, error) {
+func (db Database) ListLanguage(ctx context.Context, params model.ListParams) ([]*model.Language, []any, error) {
 	result := []*model.Language{}
 	if len(params.OrderBys) < 1 {
 		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBLanguageIETF})
 	}
+	params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID})
 	if params.Pagination == nil {
 		params.Pagination = &model.Pagination{Page: 1, Limit: model.LanguagePaginationDef}
 	}
+	if err := setListCursor(&params); err != nil {
+		return nil, nil, err
+	}
 	if err := db.GenericList(ctx, model.DBLanguage, params, &result); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return result, nil
+	return result, listNextCursor(params, result), nil
 }
 
 func (db Database) CountLanguage(ctx context.Context, conds any) (int, error) {
@@ -86,22 +86,20 @@ func (db Database) AddTag(ctx context.Context, data model.AddTag, v *model.Tag)
 	case data.TypeCode != nil:
 		typeID = model.DBTagTypeCodeToID(*data.TypeCode)
 	}
-	cols, vals, args := SetInsert(map[string]any{
+	cols, vals, args := db.dialect.SetInsert(map[string]any{
 		model.DBTagTypeID: typeID,
 		model.DBTagCode:   data.Code,
 		model.DBTagName:   data.Name,
 	})
 	sql := "INSERT INTO " + model.DBTag + " (" + cols + ") VALUES (" + vals + ")"
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericID
-		sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBTagTypeID + ", w." + model.DBTagCode + ", w." + model.DBTagName
-		sql += ", l." + model.DBTagTypeCode + " AS type_code"
-		sql += " FROM data w JOIN " + model.DBTagType + " l"
-		sql += " ON w." + model.DBTagTypeID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericID
+		sel += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBTagTypeID + ", w." + model.DBTagCode + ", w." + model.DBTagName
+		sel += ", l." + model.DBTagTypeCode + " AS type_code"
+		sel += " FROM data w JOIN " + model.DBTagType + " l"
+		sel += " ON w." + model.DBTagTypeID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBTag, sql, sel, args...); err != nil {
 			return tagSetError(err)
 		}
 	} else {
@@ -115,14 +113,8 @@ func (db Database) AddTag(ctx context.Context, data model.AddTag, v *model.Tag)
 func (db Database) GetTag(ctx context.Context, conds any) (*model.Tag, error) {
 	var result model.Tag
 	args := []any{}
-	cond := SetWhere(conds, &args)
-	sql := "SELECT * FROM (SELECT w." + model.DBGenericID
-	sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-	sql += ", w." + model.DBTagTypeID + ", w." + model.DBTagCode + ", w." + model.DBTagName
-	sql += ", l." + model.DBTagTypeCode + " AS type_code"
-	sql += " FROM " + model.DBTag + " w JOIN " + model.DBTagType + " l"
-	sql += " ON w." + model.DBTagTypeID + " = l." + model.DBGenericID
-	sql += ")"
+	cond := db.dialect.SetWhere(conds, &args)
+	sql := "SELECT * FROM (" + tagSelect() + ")"
 	sql += " WHERE " + cond
 	if err := db.QueryOne(ctx, &result, sql, args...); err != nil {
 		return nil, err
@@ -145,19 +137,17 @@ func (db Database) UpdateTag(ctx context.Context, data model.SetTag, conds any,
 		data0[model.DBCategoryName] = data.Name
 	}
 	data0[model.DBGenericUpdatedAt] = time.Now().UTC()
-	sets, args := SetUpdate(data0)
-	cond := SetWhere([]any{conds, SetUpdateWhere(data0)}, &args)
+	sets, args := db.dialect.SetUpdate(data0)
+	cond := db.dialect.SetWhere(softDeleteConds([]any{conds, db.dialect.SetUpdateWhere(data0)}), &args)
 	sql := "UPDATE " + model.DBTag + " SET " + sets + " WHERE " + cond
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericID
-		sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBTagTypeID + ", w." + model.DBTagCode + ", w." + model.DBTagName
-		sql += ", l." + model.DBTagTypeCode + " AS type_code"
-		sql += " FROM data w JOIN " + model.DBTagType + " l"
-		sql += " ON w." + model.DBTagTypeID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericID
+		sel += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBTagTypeID + ", w." + model.DBTagCode + ", w." + model.DBTagName
+		sel += ", l." + model.DBTagTypeCode + " AS type_code"
+		sel += " FROM data w JOIN " + model.DBTagType + " l"
+		sel += " ON w." + model.DBTagTypeID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBTag, sql, sel, args...); err != nil {
 			return tagSetError(err)
 		}
 	} else {
@@ -169,19 +159,17 @@ func (db Database) UpdateTag(ctx context.Context, data model.SetTag, conds any,
 }
 
 func (db Database) DeleteTag(ctx context.Context, conds any, v *model.Tag) error {
-	args := []any{}
-	cond := SetWhere(conds, &args)
-	sql := "DELETE FROM " + model.DBTag + " WHERE " + cond
+	args := []any{time.Now().UTC()}
+	cond := db.dialect.SetWhere(softDeleteConds(conds), &args)
+	sql := "UPDATE " + model.DBTag + " SET " + model.DBGenericDeletedAt + " = $1 WHERE " + cond
 	if v != nil {
-		sql += " RETURNING *"
-		sql = "WITH data AS (" + sql + ")"
-		sql += " SELECT w." + model.DBGenericID
-		sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-		sql += ", w." + model.DBTagTypeID + ", w." + model.DBTagCode + ", w." + model.DBTagName
-		sql += ", l." + model.DBTagTypeCode + " AS type_code"
-		sql += " FROM data w JOIN " + model.DBTagType + " l"
-		sql += " ON w." + model.DBTagTypeID + " = l." + model.DBGenericID
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+		sel := "SELECT w." + model.DBGenericID
+		sel += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBTagTypeID + ", w." + model.DBTagCode + ", w." + model.DBTagName
+		sel += ", l." + model.DBTagTypeCode + " AS type_code"
+		sel += " FROM data w JOIN " + model.DBTagType + " l"
+		sel += " ON w." + model.DBTagTypeID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBTag, sql, sel, args...); err != nil {
 			return err
 		}
 	} else {
@@ -192,34 +180,64 @@ func (db Database) DeleteTag(ctx context.Context, conds any, v *model.Tag) error
 	return nil
 }
 
-func (db Database) ListTag(ctx context.Context, params model.ListParams) ([]*model.Tag, error) {
-	result := []*model.Tag{}
+func (db Database) RestoreTag(ctx context.Context, conds any, v *model.Tag) error {
 	args := []any{}
-	sql := "SELECT * FROM (SELECT w." + model.DBGenericID
-	sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-	sql += ", w." + model.DBTagTypeID + ", w." + model.DBTagCode + ", w." + model.DBTagName
-	sql += ", l." + model.DBTagTypeCode + " AS type_code"
-	sql += " FROM " + model.DBTag + " w JOIN " + model.DBTagType + " l"
-	sql += " ON w." + model.DBTagTypeID + " = l." + model.DBGenericID
-	sql += ")"
-	if cond := SetWhere(params.Conditions, &args); cond != "" {
-		sql += " WHERE " + cond
+	cond := db.restoreCond(model.DBTag, conds, &args)
+	sql := "UPDATE " + model.DBTag + " SET " + model.DBGenericDeletedAt + " = NULL WHERE " + cond
+	if v != nil {
+		sel := "SELECT w." + model.DBGenericID
+		sel += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sel += ", w." + model.DBTagTypeID + ", w." + model.DBTagCode + ", w." + model.DBTagName
+		sel += ", l." + model.DBTagTypeCode + " AS type_code"
+		sel += " FROM data w JOIN " + model.DBTagType + " l"
+		sel += " ON w." + model.DBTagTypeID + " = l." + model.DBGenericID
+		if err := db.QueryOneReturning(ctx, v, model.DBTag, sql, sel, args...); err != nil {
+			return tagSetError(err)
+		}
+	} else {
+		if err := db.Exec(ctx, sql, args...); err != nil {
+			return tagSetError(err)
+		}
 	}
+	return nil
+}
+
+func (db Database) ListTag(ctx context.Context, params model.ListParams) ([]*model.Tag, []any, error) {
+	result := []*model.Tag{}
+	args := []any{}
 	if len(params.OrderBys) < 1 {
 		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBTagCode})
 	}
 	params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID})
-	sql += " ORDER BY " + SetOrderBys(params.OrderBys, &args)
 	if params.Pagination == nil {
 		params.Pagination = &model.Pagination{Page: 1, Limit: model.TagPaginationDef}
 	}
-	if lmof := SetPagination(*params.Pagination, &args); lmof != "" {
+	if err := setListCursor(&params); err != nil {
+		return nil, nil, err
+	}
+	sql := "SELECT * FROM (" + tagSelect() + ")"
+	if cond := db.dialect.SetWhere(params.Conditions, &args); cond != "" {
+		sql += " WHERE " + cond
+	}
+	sql += " ORDER BY " + db.dialect.SetOrderBys(params.OrderBys, &args)
+	if lmof := db.dialect.SetPagination(*params.Pagination, &args); lmof != "" {
 		sql += lmof
 	}
 	if err := db.QueryAll(ctx, &result, sql, args...); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return result, nil
+	return result, listNextCursor(params, result), nil
+}
+
+func tagSelect() string {
+	sql := "SELECT w." + model.DBGenericID
+	sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+	sql += ", w." + model.DBTagTypeID + ", w." + model.DBTagCode + ", w." + model.DBTagName
+	sql += ", l." + model.DBTagTypeCode + " AS type_code"
+	sql += " FROM " + model.DBTag + " w JOIN " + model.DBTagType + " l"
+	sql += " ON w." + model.DBTagTypeID + " = l." + model.DBGenericID
+	sql += " WHERE w." + model.DBGenericDeletedAt + " IS NULL"
+	return sql
 }
 
 func (db Database) CountTag(ctx context.Context, conds any) (int, error) {
@@ -0,0 +1,81 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+func (db Database) ListTrash(ctx context.Context, params model.ListParams) ([]*model.Trash, error) {
+	result := []*model.Trash{}
+	args := []any{}
+	sql := "SELECT * FROM (" + trashSelect() + ")"
+	if cond := db.dialect.SetWhere(params.Conditions, &args); cond != "" {
+		sql += " WHERE " + cond
+	}
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericDeletedAt, Sort: "desc"})
+	}
+	params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBTrashType})
+	params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID})
+	sql += " ORDER BY " + db.dialect.SetOrderBys(params.OrderBys, &args)
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.TrashPaginationDef}
+	}
+	if lmof := db.dialect.SetPagination(*params.Pagination, &args); lmof != "" {
+		sql += lmof
+	}
+	if err := db.QueryAll(ctx, &result, sql, args...); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (db Database) CountTrash(ctx context.Context, conds any) (int, error) {
+	var dst int
+	args := []any{}
+	sql := "SELECT COUNT(*) FROM (" + trashSelect() + ")"
+	if cond := db.dialect.SetWhere(conds, &args); cond != "" {
+		sql += " WHERE " + cond
+	}
+	if err := db.QueryOne(ctx, &dst, sql, args...); err != nil {
+		return -1, err
+	}
+	return dst, nil
+}
+
+func (db Database) PurgeTrash(ctx context.Context, before time.Time) (int, error) {
+	total := 0
+	for _, t := range softDeleteTables {
+		var dst []int
+		sql := "DELETE FROM " + t
+		sql += " WHERE " + model.DBGenericDeletedAt + " < $1 RETURNING 1"
+		if err := db.QueryAll(ctx, &dst, sql, before); err != nil {
+			return total, err
+		}
+		total += len(dst)
+	}
+	return total, nil
+}
+
+func trashSelect() string {
+	sql := ""
+	for _, t := range []struct{ name, table, typeID string }{
+		{model.TrashTypeComic, model.DBComic, "CAST(NULL AS bigint)"},
+		{model.TrashTypeCategory, model.DBCategory, model.DBCategoryTypeID},
+		{model.TrashTypeTag, model.DBTag, model.DBTagTypeID},
+	} {
+		if sql != "" {
+			sql += " UNION ALL "
+		}
+		sql += "SELECT '" + t.name + "' AS " + model.DBTrashType
+		sql += ", " + model.DBGenericID + ", " + model.DBTrashCode
+		sql += ", " + t.typeID + " AS " + model.DBTrashTypeID
+		sql += ", " + model.DBGenericCreatedAt + ", " + model.DBGenericUpdatedAt
+		sql += ", " + model.DBGenericDeletedAt
+		sql += " FROM " + t.table
+		sql += " WHERE " + model.DBGenericDeletedAt + " IS NOT NULL"
+	}
+	return sql
+}
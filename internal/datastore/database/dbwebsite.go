@@ -47,18 +47,22 @@ func (db Database) DeleteWebsite(ctx context.Context, conds any, v *model.Websit
 	return db.GenericDelete(ctx, model.DBWebsite, conds, v)
 }
 
-func (db Database) ListWebsite(ctx context.Context, params model.ListParams) ([]*model.Website, error) {
+func (db Database) ListWebsite(ctx context.Context, params model.ListParams) ([]*model.Website, []any, error) {
 	result := []*model.Website{}
 	if len(params.OrderBys) < 1 {
 		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBWebsiteDomain})
 	}
+	params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID})
 	if params.Pagination == nil {
 		params.Pagination = &model.Pagination{Page: 1, Limit: model.WebsitePaginationDef}
 	}
+	if err := setListCursor(&params); err != nil {
+		return nil, nil, err
+	}
 	if err := db.GenericList(ctx, model.DBWebsite, params, &result); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return result, nil
+	return result, listNextCursor(params, result), nil
 }
 
 func (db Database) CountWebsite(ctx context.Context, conds any) (int, error) {
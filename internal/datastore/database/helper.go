@@ -2,13 +2,21 @@ package database
 
 import (
 	"context"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/georgysavva/scany/v2/dbscan"
+
 	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
 )
 
+var softDeleteTables = []string{model.DBComic, model.DBCategory, model.DBTag}
+
 func (db Database) GenericAdd(ctx context.Context, t string, data map[string]any, v any) error {
-	cols, vals, args := SetInsert(data)
+	cols, vals, args := db.dialect.SetInsert(data)
 	sql := "INSERT INTO " + t + " (" + cols + ") VALUES (" + vals + ")"
 	if v != nil {
 		sql += ` RETURNING *`
@@ -24,7 +32,10 @@ func (db Database) GenericAdd(ctx context.Context, t string, data map[string]any
 }
 
 func (db Database) BatchAdd(ctx context.Context, t string, data []map[string]any, v any) error {
-	cols, valx, args := SetBulkInsert(data)
+	if db.dialect == DialectSQLite {
+		return db.batchAddRows(ctx, t, data, v)
+	}
+	cols, valx, args := db.dialect.SetBulkInsert(data)
 	sql := "INSERT INTO " + t + " (" + cols + ") VALUES"
 	for i, vals := range valx {
 		if i > 0 {
@@ -45,17 +56,81 @@ func (db Database) BatchAdd(ctx context.Context, t string, data []map[string]any
 	return nil
 }
 
+// batchAddRows inserts the rows one by one, a multi row VALUES list of SQLite
+// has no DEFAULT for the columns a row leaves out.
+func (db Database) batchAddRows(ctx context.Context, t string, data []map[string]any, v any) error {
+	ctx, err := db.ContextTransactionBegin(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.ContextTransactionRollback(context.WithoutCancel(ctx))
+
+	rowids := []int64{}
+	for _, data := range data {
+		var rowid int64
+		cols, vals, args := db.dialect.SetInsert(data)
+		sql := "INSERT INTO " + t + " (" + cols + ") VALUES (" + vals + ") RETURNING rowid"
+		if err := db.QueryOne(ctx, &rowid, sql, args...); err != nil {
+			return err
+		}
+		rowids = append(rowids, rowid)
+	}
+	if v != nil {
+		sql := "SELECT * FROM " + t + " WHERE rowid IN (SELECT value FROM json_each($1)) ORDER BY rowid"
+		if err := db.QueryAll(ctx, v, sql, rowids); err != nil {
+			return err
+		}
+	}
+	return db.ContextTransactionCommit(ctx)
+}
+
+// QueryOneReturning runs the data modifying sql on t and scans the row it
+// changed through sel, a select in which the row is named data.
+func (db Database) QueryOneReturning(ctx context.Context, dst any, t, sql, sel string, args ...any) error {
+	if db.dialect != DialectSQLite {
+		return db.QueryOne(ctx, dst, "WITH data AS ("+sql+" RETURNING *) "+sel, args...)
+	}
+
+	// SQLite has no RETURNING inside a WITH, the row is selected again by its
+	// rowid after the write, or before it for a delete.
+	ctx, err := db.ContextTransactionBegin(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.ContextTransactionRollback(context.WithoutCancel(ctx))
+
+	if cond, ok := strings.CutPrefix(sql, "DELETE FROM "+t+" "); ok {
+		if err := db.QueryOne(ctx, dst, "WITH data AS (SELECT * FROM "+t+" "+cond+") "+sel, args...); err != nil {
+			return err
+		}
+		if err := db.Exec(ctx, sql, args...); err != nil {
+			return err
+		}
+		return db.ContextTransactionCommit(ctx)
+	}
+
+	rowids := []int64{}
+	if err := db.QueryAll(ctx, &rowids, sql+" RETURNING rowid", args...); err != nil {
+		return err
+	}
+	data := "SELECT * FROM " + t + " WHERE rowid IN (SELECT value FROM json_each($1))"
+	if err := db.QueryOne(ctx, dst, "WITH data AS ("+data+") "+sel, rowids); err != nil {
+		return err
+	}
+	return db.ContextTransactionCommit(ctx)
+}
+
 func (db Database) GenericGet(ctx context.Context, t string, conds any, v any) error {
 	args := []any{}
-	cond := SetWhere(conds, &args)
+	cond := db.dialect.SetWhere(softDeleteTableConds(t, conds), &args)
 	sql := "SELECT * FROM " + t + " WHERE " + cond
 	return db.QueryOne(ctx, v, sql, args...)
 }
 
 func (db Database) GenericUpdate(ctx context.Context, t string, data map[string]any, conds any, v any) error {
 	data[model.DBGenericUpdatedAt] = time.Now().UTC()
-	sets, args := SetUpdate(data)
-	cond := SetWhere([]any{conds, SetUpdateWhere(data)}, &args)
+	sets, args := db.dialect.SetUpdate(data)
+	cond := db.dialect.SetWhere(softDeleteTableConds(t, []any{conds, db.dialect.SetUpdateWhere(data)}), &args)
 	sql := "UPDATE " + t + " SET " + sets + " WHERE " + cond
 	if v != nil {
 		sql += ` RETURNING *`
@@ -72,8 +147,13 @@ func (db Database) GenericUpdate(ctx context.Context, t string, data map[string]
 
 func (db Database) GenericDelete(ctx context.Context, t string, conds any, v any) error {
 	args := []any{}
-	cond := SetWhere(conds, &args)
+	cond := db.dialect.SetWhere(conds, &args)
 	sql := "DELETE FROM " + t + " WHERE " + cond
+	if slices.Contains(softDeleteTables, t) {
+		args = []any{time.Now().UTC()}
+		cond = db.dialect.SetWhere(softDeleteConds(conds), &args)
+		sql = "UPDATE " + t + " SET " + model.DBGenericDeletedAt + " = $1 WHERE " + cond
+	}
 	if v != nil {
 		sql += ` RETURNING *`
 		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
@@ -90,14 +170,14 @@ func (db Database) GenericDelete(ctx context.Context, t string, conds any, v any
 func (db Database) GenericList(ctx context.Context, t string, params model.ListParams, v any) error {
 	args := []any{}
 	sql := "SELECT * FROM " + t
-	if cond := SetWhere(params.Conditions, &args); cond != "" {
+	if cond := db.dialect.SetWhere(softDeleteTableConds(t, params.Conditions), &args); cond != "" {
 		sql += " WHERE " + cond
 	}
-	if odbs := SetOrderBys(params.OrderBys, &args); odbs != "" {
+	if odbs := db.dialect.SetOrderBys(params.OrderBys, &args); odbs != "" {
 		sql += " ORDER BY " + odbs
 	}
 	if params.Pagination != nil {
-		sql += SetPagination(*params.Pagination, &args)
+		sql += db.dialect.SetPagination(*params.Pagination, &args)
 	}
 	return db.QueryAll(ctx, v, sql, args...)
 }
@@ -106,7 +186,7 @@ func (db Database) GenericCount(ctx context.Context, t string, conds any) (int,
 	var dst int
 	args := []any{}
 	sql := "SELECT COUNT(*) FROM " + t
-	if cond := SetWhere(conds, &args); cond != "" {
+	if cond := db.dialect.SetWhere(softDeleteTableConds(t, conds), &args); cond != "" {
 		sql += " WHERE " + cond
 	}
 	if err := db.QueryOne(ctx, &dst, sql, args...); err != nil {
@@ -119,7 +199,7 @@ func (db Database) GenericExists(ctx context.Context, t string, conds any) (bool
 	var dst bool
 	args := []any{}
 	sql := "SELECT EXISTS(SELECT 1 FROM " + t
-	if cond := SetWhere(conds, &args); cond != "" {
+	if cond := db.dialect.SetWhere(softDeleteTableConds(t, conds), &args); cond != "" {
 		sql += " WHERE " + cond
 	}
 	sql += ")"
@@ -128,3 +208,123 @@ func (db Database) GenericExists(ctx context.Context, t string, conds any) (bool
 	}
 	return dst, nil
 }
+
+func softDeleteConds(conds any) any {
+	return []any{
+		model.DBLogicalAND{},
+		conds,
+		model.DBConditionalKV{Key: model.DBGenericDeletedAt, Value: model.DBIsNull{}},
+	}
+}
+
+func softDeleteTableConds(t string, conds any) any {
+	if slices.Contains(softDeleteTables, t) {
+		return softDeleteConds(conds)
+	}
+	return conds
+}
+
+func trashConds(conds any) any {
+	return []any{
+		model.DBLogicalAND{},
+		conds,
+		model.DBConditionalKV{Key: model.DBGenericDeletedAt, Value: model.DBIsNotNull{}},
+	}
+}
+
+// restoreCond matches the most recently deleted row only, the trash may hold
+// several rows with the same code.
+func (db Database) restoreCond(t string, conds any, args *[]any) string {
+	sql := "SELECT " + model.DBGenericID + " FROM " + t
+	sql += " WHERE " + db.dialect.SetWhere(trashConds(conds), args)
+	sql += " ORDER BY " + model.DBGenericDeletedAt + " DESC, " + model.DBGenericID + " DESC LIMIT 1"
+	return model.DBGenericID + " = (" + sql + ")"
+}
+
+func setListCursor(params *model.ListParams) error {
+	pagination := params.Pagination
+	if pagination == nil || pagination.Cursor == nil {
+		return nil
+	}
+	// The first value is the key of the ordering the cursor was made for.
+	cursor := pagination.Cursor
+	if len(cursor) != len(params.OrderBys)+1 || cursor[0] != params.OrderBys.CursorKey() {
+		return model.GenericError("pagination cursor does not match order by")
+	}
+	params.Conditions = []any{
+		model.DBLogicalAND{},
+		params.Conditions,
+		model.DBCursor{OrderBys: params.OrderBys, Values: cursor[1:]},
+	}
+	return nil
+}
+
+// listNextCursor is the cursor of the page after result, nil when it is the
+// last page.
+func listNextCursor[T any](params model.ListParams, result []*T) []any {
+	pagination := params.Pagination
+	if pagination == nil || pagination.Limit < 1 || len(result) < pagination.Limit {
+		return nil
+	}
+	fields := dbFields(reflect.ValueOf(result[len(result)-1]))
+	cursor := []any{params.OrderBys.CursorKey()}
+	for _, ob := range params.OrderBys {
+		name, ok := ob.Field.(string)
+		if !ok {
+			return nil
+		}
+		field, ok := fields[name]
+		if !ok {
+			return nil
+		}
+		if field.Kind() == reflect.Pointer {
+			if field.IsNil() {
+				cursor = append(cursor, nil)
+				continue
+			}
+			field = field.Elem()
+		}
+		cursor = append(cursor, field.Interface())
+	}
+	return cursor
+}
+
+func jsonRows[T any](data []byte) ([]*T, error) {
+	rows := []map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return nil, err
+	}
+	result := make([]*T, 0, len(rows))
+	for _, row := range rows {
+		v := new(T)
+		fields := dbFields(reflect.ValueOf(v))
+		for name, raw := range row {
+			field, ok := fields[name]
+			if !ok {
+				continue
+			}
+			if err := json.Unmarshal(raw, field.Addr().Interface()); err != nil {
+				return nil, err
+			}
+		}
+		result = append(result, v)
+	}
+	return result, nil
+}
+
+func dbFields(v reflect.Value) map[string]reflect.Value {
+	v = reflect.Indirect(v)
+	fields := map[string]reflect.Value{}
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		name := field.Tag.Get("db")
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = dbscan.SnakeCaseMapper(field.Name)
+		}
+		fields[name] = v.Field(i)
+	}
+	return fields
+}
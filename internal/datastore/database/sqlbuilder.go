@@ -9,10 +9,19 @@ import (
 	"github.com/mahmudindes/orenocomic-donoengine/internal/utila"
 )
 
-func SetValue(val any, args *[]any) string {
+// Dialect is the SQL flavour statements are built for. Statements are written
+// for PostgreSQL, SQLite gets an equivalent where it lacks the construct.
+type Dialect int
+
+const (
+	DialectPostgres Dialect = iota
+	DialectSQLite
+)
+
+func (d Dialect) SetValue(val any, args *[]any) string {
 	switch v := val.(type) {
 	case model.DBQueryValue:
-		cond := SetWhere(v.Conditions, args)
+		cond := d.SetWhere(v.Conditions, args)
 		*args = append(*args, v.ZeroValue)
 		subs := "SELECT " + v.Expression + " FROM " + v.Table + " WHERE " + cond
 		return "(SELECT COALESCE((" + subs + "), $" + strconv.Itoa(len(*args)) + "))"
@@ -22,7 +31,7 @@ func SetValue(val any, args *[]any) string {
 	}
 }
 
-func SetInsert(data map[string]any) (cols string, vals string, args []any) {
+func (d Dialect) SetInsert(data map[string]any) (cols string, vals string, args []any) {
 	for key, val := range data {
 		if utila.NilData(val) {
 			continue
@@ -33,12 +42,12 @@ func SetInsert(data map[string]any) (cols string, vals string, args []any) {
 			vals += ", "
 		}
 		cols += key
-		vals += SetValue(val, &args)
+		vals += d.SetValue(val, &args)
 	}
 	return
 }
 
-func SetBulkInsert(data []map[string]any) (cols string, valx []string, args []any) {
+func (d Dialect) SetBulkInsert(data []map[string]any) (cols string, valx []string, args []any) {
 	colvals := map[string][]any{}
 	for i, data := range data {
 		keys := []string{}
@@ -71,7 +80,7 @@ func SetBulkInsert(data []map[string]any) (cols string, valx []string, args []an
 		for i, val := range cval {
 			sarg := "DEFAULT"
 			if val != nil {
-				sarg = SetValue(val, &args)
+				sarg = d.SetValue(val, &args)
 			}
 			if len(valx) < i+1 {
 				valx = append(valx, sarg)
@@ -83,7 +92,7 @@ func SetBulkInsert(data []map[string]any) (cols string, valx []string, args []an
 	return
 }
 
-func SetUpdate(data map[string]any) (sets string, args []any) {
+func (d Dialect) SetUpdate(data map[string]any) (sets string, args []any) {
 	for key, val := range data {
 		if sets != "" {
 			sets += ", "
@@ -92,24 +101,31 @@ func SetUpdate(data map[string]any) (sets string, args []any) {
 			sets += key + " = NULL"
 			continue
 		}
-		sets += key + " = " + SetValue(val, &args)
+		if val, ok := val.(model.DBJSONMerge); ok {
+			sets += key + " = " + d.SetJSONMerge(key, val.Value, &args)
+			continue
+		}
+		sets += key + " = " + d.SetValue(val, &args)
 	}
 	return
 }
 
-func SetUpdateWhere(data map[string]any) (cond map[string]any) {
+func (d Dialect) SetUpdateWhere(data map[string]any) (cond map[string]any) {
 	cond = make(map[string]any)
 	for key, val := range data {
 		if utila.NilData(val) {
 			cond[key] = model.DBIsNotNull{}
 			continue
 		}
+		if _, ok := val.(model.DBJSONMerge); ok {
+			continue
+		}
 		cond[key] = model.DBIsNotDistinctFrom{Value: val}
 	}
 	return
 }
 
-func SetWhere(conds any, args *[]any) (cond string) {
+func (d Dialect) SetWhere(conds any, args *[]any) (cond string) {
 	switch conds := conds.(type) {
 	case []any:
 		lop := "OR"
@@ -120,10 +136,14 @@ func SetWhere(conds any, args *[]any) (cond string) {
 			case model.DBLogicalOR:
 				lop = "OR"
 			default:
-				conx := SetWhere(conds, args)
+				conx := d.SetWhere(conds, args)
 				if conx == "" {
 					continue
 				}
+				switch conds.(type) {
+				case []any, map[string]any:
+					conx = "(" + conx + ")"
+				}
 				if cond != "" {
 					cond += " " + lop + " "
 				}
@@ -135,7 +155,7 @@ func SetWhere(conds any, args *[]any) (cond string) {
 			if utila.NilData(val) {
 				continue
 			}
-			conx := SetWhere(model.DBConditionalKV{Key: key, Value: val}, args)
+			conx := d.SetWhere(model.DBConditionalKV{Key: key, Value: val}, args)
 			if conx == "" {
 				continue
 			}
@@ -144,14 +164,42 @@ func SetWhere(conds any, args *[]any) (cond string) {
 			}
 			cond += conx
 		}
+	case model.DBNot:
+		if conx := d.SetWhere(conds.Conditions, args); conx != "" {
+			cond = "NOT (" + conx + ")"
+		}
+	case model.DBCursor:
+		cond = d.SetCursor(conds, args)
 	case model.DBConditionalKV:
 		switch val := conds.Value.(type) {
 		case model.DBIsDistinctFrom:
 			*args = append(*args, val.Value)
-			cond += conds.Key + " IS DISTINCT FROM $" + strconv.Itoa(len(*args))
+			cond += conds.Key + d.isDistinctFrom(false) + "$" + strconv.Itoa(len(*args))
 		case model.DBIsNotDistinctFrom:
 			*args = append(*args, val.Value)
-			cond += conds.Key + " IS NOT DISTINCT FROM $" + strconv.Itoa(len(*args))
+			cond += conds.Key + d.isDistinctFrom(true) + "$" + strconv.Itoa(len(*args))
+		case model.DBGreaterThan:
+			cond += conds.Key + " > " + d.SetValue(val.Value, args)
+		case model.DBGreaterOrEqual:
+			cond += conds.Key + " >= " + d.SetValue(val.Value, args)
+		case model.DBLessThan:
+			cond += conds.Key + " < " + d.SetValue(val.Value, args)
+		case model.DBLessOrEqual:
+			cond += conds.Key + " <= " + d.SetValue(val.Value, args)
+		case model.DBBetween:
+			cond += conds.Key + " BETWEEN " + d.SetValue(val.From, args) + " AND " + d.SetValue(val.To, args)
+		case model.DBIn:
+			if d == DialectSQLite {
+				cond += conds.Key + " IN (SELECT value FROM json_each(" + d.SetValue(val.Value, args) + "))"
+				break
+			}
+			cond += conds.Key + " = ANY(" + d.SetValue(val.Value, args) + ")"
+		case model.DBNotIn:
+			if d == DialectSQLite {
+				cond += conds.Key + " NOT IN (SELECT value FROM json_each(" + d.SetValue(val.Value, args) + "))"
+				break
+			}
+			cond += conds.Key + " <> ALL(" + d.SetValue(val.Value, args) + ")"
 		case model.DBIsNull:
 			cond += conds.Key + " IS NULL"
 		case model.DBIsNotNull:
@@ -160,17 +208,87 @@ func SetWhere(conds any, args *[]any) (cond string) {
 			cond += conds.Key + " IS " + strconv.FormatBool(bool(val))
 		case model.DBBooleanIsNot:
 			cond += conds.Key + " IS NOT " + strconv.FormatBool(bool(val))
+		case model.DBJSONContains:
+			if d == DialectSQLite {
+				cond += d.setJSONContains(conds.Key, "$", val.Value, args)
+				break
+			}
+			cond += conds.Key + " @> " + d.SetValue(val.Value, args)
 		case model.DBInsensitiveLike:
 			*args = append(*args, string(val))
+			if d == DialectSQLite {
+				// LIKE of SQLite ignores the case of ASCII letters only.
+				cond += conds.Key + " LIKE $" + strconv.Itoa(len(*args)) + ` ESCAPE '\'`
+				break
+			}
 			cond += conds.Key + " ILIKE $" + strconv.Itoa(len(*args))
+		case model.DBTextSearch:
+			*args = append(*args, string(val))
+			n := strconv.Itoa(len(*args))
+			if d == DialectSQLite {
+				cond += "instr(lower(" + conds.Key + "), lower($" + n + ")) > 0"
+				break
+			}
+			cond += "(" + conds.Key + " % $" + n
+			cond += " OR to_tsvector('simple', " + conds.Key + ") @@ plainto_tsquery('simple', $" + n + "))"
 		default:
-			cond += conds.Key + " = " + SetValue(val, args)
+			cond += conds.Key + " = " + d.SetValue(val, args)
 		}
 	}
 	return
 }
 
-func SetOrderBy(m model.OrderBy, args *[]any) (ob string) {
+func (d Dialect) SetTextSearchRank(conds any, args *[]any) (rank string) {
+	terms := 0
+	switch conds := conds.(type) {
+	case []any:
+		for _, conds := range conds {
+			if conx := d.SetTextSearchRank(conds, args); conx != "" {
+				if rank != "" {
+					rank += ", "
+				}
+				rank += conx
+				terms++
+			}
+		}
+	case map[string]any:
+		for key, val := range conds {
+			if conx := d.SetTextSearchRank(model.DBConditionalKV{Key: key, Value: val}, args); conx != "" {
+				if rank != "" {
+					rank += ", "
+				}
+				rank += conx
+				terms++
+			}
+		}
+	case model.DBConditionalKV:
+		if val, ok := conds.Value.(model.DBTextSearch); ok {
+			*args = append(*args, string(val))
+			n := strconv.Itoa(len(*args))
+			if d == DialectSQLite {
+				// The share of the text the query covers.
+				rank += "(CASE WHEN instr(lower(" + conds.Key + "), lower($" + n + ")) > 0"
+				rank += " THEN length($" + n + ") * 1.0 / length(" + conds.Key + ") ELSE 0 END)"
+				return
+			}
+			rank += "similarity(" + conds.Key + ", $" + n + ")"
+			rank += ", ts_rank(to_tsvector('simple', " + conds.Key + "), plainto_tsquery('simple', $" + n + "))"
+		}
+	}
+	switch {
+	case rank == "":
+	case d == DialectSQLite:
+		// MAX of a single argument is the aggregate function in SQLite.
+		if terms > 1 {
+			rank = "MAX(" + rank + ")"
+		}
+	default:
+		rank = "GREATEST(" + rank + ")"
+	}
+	return
+}
+
+func (d Dialect) SetOrderBy(m model.OrderBy, args *[]any) (ob string) {
 	if m.Field == "" {
 		return
 	}
@@ -191,11 +309,12 @@ func SetOrderBy(m model.OrderBy, args *[]any) (ob string) {
 		}
 	}
 
-	if m.Null != "" {
-		switch strings.ToLower(m.Null) {
-		case "f", "first":
+	// SQLite puts NULLs the other way around, the default of PostgreSQL is
+	// spelled out so both sort the same.
+	if m.Null != "" || d == DialectSQLite {
+		if nullsFirst(m) {
 			ob += " NULLS FIRST"
-		case "l", "last":
+		} else {
 			ob += " NULLS LAST"
 		}
 	}
@@ -203,18 +322,34 @@ func SetOrderBy(m model.OrderBy, args *[]any) (ob string) {
 	return
 }
 
-func SetOrderBys(m model.OrderBys, args *[]any) (obs string) {
+// nullsFirst reports whether NULLs come first in the ordering, they are larger
+// than any value unless the ordering says otherwise.
+func nullsFirst(m model.OrderBy) bool {
+	switch strings.ToLower(m.Null) {
+	case "f", "first":
+		return true
+	case "l", "last":
+		return false
+	}
+	switch strings.ToLower(m.Sort) {
+	case "d", "desc", "descend", "descending":
+		return true
+	}
+	return false
+}
+
+func (d Dialect) SetOrderBys(m model.OrderBys, args *[]any) (obs string) {
 	for _, ob := range m {
 		if obs != "" {
 			obs += ", "
 		}
 
-		obs += SetOrderBy(ob, args)
+		obs += d.SetOrderBy(ob, args)
 	}
 	return
 }
 
-func SetPagination(m model.Pagination, args *[]any) (lo string) {
+func (d Dialect) SetPagination(m model.Pagination, args *[]any) (lo string) {
 	if m.Limit < 1 {
 		return
 	}
@@ -222,6 +357,10 @@ func SetPagination(m model.Pagination, args *[]any) (lo string) {
 	*args = append(*args, m.Limit)
 	lo += " LIMIT $" + strconv.Itoa(len(*args))
 
+	if m.Cursor != nil {
+		return
+	}
+
 	offset := m.Limit * (m.Page - 1)
 	if offset > 0 {
 		*args = append(*args, offset)
@@ -230,3 +369,130 @@ func SetPagination(m model.Pagination, args *[]any) (lo string) {
 
 	return
 }
+
+func (d Dialect) SetCursor(m model.DBCursor, args *[]any) (cur string) {
+	if len(m.Values) != len(m.OrderBys) {
+		return
+	}
+
+	for i, ob := range m.OrderBys {
+		field, ok := ob.Field.(string)
+		if !ok {
+			return ""
+		}
+
+		conx := ""
+		for j, ob := range m.OrderBys[:i] {
+			conx += ob.Field.(string) + d.isDistinctFrom(true) + d.SetValue(m.Values[j], args) + " AND "
+		}
+
+		op := " > "
+		switch strings.ToLower(ob.Sort) {
+		case "d", "desc", "descend", "descending":
+			op = " < "
+		}
+		switch nullsFirst := nullsFirst(ob); {
+		case m.Values[i] == nil && nullsFirst:
+			conx += field + " IS NOT NULL"
+		case m.Values[i] == nil:
+			conx += "FALSE"
+		case nullsFirst:
+			conx += field + op + d.SetValue(m.Values[i], args)
+		default:
+			conx += "(" + field + op + d.SetValue(m.Values[i], args) + " OR " + field + " IS NULL)"
+		}
+
+		if cur != "" {
+			cur += " OR "
+		}
+		cur += "(" + conx + ")"
+	}
+
+	if cur != "" {
+		cur = "(" + cur + ")"
+	}
+
+	return
+}
+
+// SetDistinctOn keeps one row per value of key, it returns what follows SELECT
+// and what ends the statement.
+func (d Dialect) SetDistinctOn(key string) (distinct string, groupBy string) {
+	if d == DialectSQLite {
+		// The bare columns of a group are taken from one of its rows.
+		return "", " GROUP BY " + key
+	}
+	return "DISTINCT ON (" + key + ") ", ""
+}
+
+// SetJSONMerge sets the top level keys of the object in val into the JSON
+// object of key, a key set to null is removed.
+func (d Dialect) SetJSONMerge(key string, val any, args *[]any) string {
+	if d != DialectSQLite {
+		return "jsonb_strip_nulls(COALESCE(" + key + ", '{}') || " + d.SetValue(val, args) + ")"
+	}
+	// json_patch merges nested objects as well, the merged keys are removed
+	// first so they are replaced like in PostgreSQL.
+	obj := "COALESCE(" + key + ", '{}')"
+	if val, ok := val.(map[string]any); ok && len(val) > 0 {
+		paths := ""
+		for _, k := range sortedKeys(val) {
+			paths += ", " + d.SetValue(jsonPath("$", k), args)
+		}
+		obj = "json_remove(" + obj + paths + ")"
+	}
+	return "json_patch(" + obj + ", " + d.SetValue(val, args) + ")"
+}
+
+// setJSONContains matches the JSON of key holding val at path, the same as
+// @> of PostgreSQL does.
+func (d Dialect) setJSONContains(key, path string, val any, args *[]any) string {
+	switch val := val.(type) {
+	case map[string]any:
+		cond := "json_type(" + key + ", " + d.SetValue(path, args) + ") = 'object'"
+		for _, k := range sortedKeys(val) {
+			cond += " AND " + d.setJSONContains(key, jsonPath(path, k), val[k], args)
+		}
+		return "(" + cond + ")"
+	case []any:
+		cond := "json_type(" + key + ", " + d.SetValue(path, args) + ") = 'array'"
+		for _, v := range val {
+			cond += " AND EXISTS (SELECT 1 FROM json_each(" + key + ", " + d.SetValue(path, args) + ")"
+			switch v.(type) {
+			case map[string]any, []any:
+				cond += " WHERE json(value) = json(" + d.SetValue(v, args) + "))"
+			default:
+				cond += " WHERE value = " + d.SetValue(v, args) + ")"
+			}
+		}
+		return "(" + cond + ")"
+	case nil:
+		return "json_type(" + key + ", " + d.SetValue(path, args) + ") = 'null'"
+	}
+	return "json_extract(" + key + ", " + d.SetValue(path, args) + ") = " + d.SetValue(val, args)
+}
+
+func (d Dialect) isDistinctFrom(not bool) string {
+	switch {
+	case d == DialectSQLite && not:
+		return " IS "
+	case d == DialectSQLite:
+		return " IS NOT "
+	case not:
+		return " IS NOT DISTINCT FROM "
+	}
+	return " IS DISTINCT FROM "
+}
+
+func jsonPath(path, key string) string {
+	return path + `."` + key + `"`
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,118 @@
+package database
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+func TestSetWhere(t *testing.T) {
+	ids := []uint{1, 2, 3}
+	for _, c := range []struct {
+		name     string
+		conds    any
+		postgres string
+		sqlite   string
+		args     []any
+	}{
+		{"in", model.DBConditionalKV{Key: "id", Value: model.DBIn{Value: ids}},
+			"id = ANY($1)", "id IN (SELECT value FROM json_each($1))", []any{ids}},
+		{"not in", model.DBConditionalKV{Key: "id", Value: model.DBNotIn{Value: ids}},
+			"id <> ALL($1)", "id NOT IN (SELECT value FROM json_each($1))", []any{ids}},
+		{"greater than", model.DBConditionalKV{Key: "id", Value: model.DBGreaterThan{Value: 1}},
+			"id > $1", "id > $1", []any{1}},
+		{"greater or equal", model.DBConditionalKV{Key: "id", Value: model.DBGreaterOrEqual{Value: 1}},
+			"id >= $1", "id >= $1", []any{1}},
+		{"less than", model.DBConditionalKV{Key: "id", Value: model.DBLessThan{Value: 3}},
+			"id < $1", "id < $1", []any{3}},
+		{"less or equal", model.DBConditionalKV{Key: "id", Value: model.DBLessOrEqual{Value: 3}},
+			"id <= $1", "id <= $1", []any{3}},
+		{"between", model.DBConditionalKV{Key: "id", Value: model.DBBetween{From: 1, To: 3}},
+			"id BETWEEN $1 AND $2", "id BETWEEN $1 AND $2", []any{1, 3}},
+		{"not", model.DBNot{Conditions: model.DBConditionalKV{Key: "id", Value: model.DBIn{Value: ids}}},
+			"NOT (id = ANY($1))", "NOT (id IN (SELECT value FROM json_each($1)))", []any{ids}},
+		{"not empty", model.DBNot{Conditions: []any{}}, "", "", nil},
+		{"combined", []any{
+			model.DBLogicalAND{},
+			model.DBConditionalKV{Key: "id", Value: model.DBGreaterThan{Value: 1}},
+			model.DBNot{Conditions: []any{
+				model.DBConditionalKV{Key: "code", Value: "a"},
+				model.DBConditionalKV{Key: "code", Value: model.DBBetween{From: "b", To: "c"}},
+			}},
+		}, "id > $1 AND NOT (code = $2 OR code BETWEEN $3 AND $4)",
+			"id > $1 AND NOT (code = $2 OR code BETWEEN $3 AND $4)", []any{1, "a", "b", "c"}},
+	} {
+		for _, d := range []struct {
+			dialect Dialect
+			want    string
+		}{{DialectPostgres, c.postgres}, {DialectSQLite, c.sqlite}} {
+			var args []any
+			if got := d.dialect.SetWhere(c.conds, &args); got != d.want {
+				t.Errorf("SetWhere %s (dialect %d) = %q, expected %q", c.name, d.dialect, got, d.want)
+			}
+			if !reflect.DeepEqual(args, c.args) {
+				t.Errorf("SetWhere %s (dialect %d) args = %v, expected %v", c.name, d.dialect, args, c.args)
+			}
+		}
+	}
+}
+
+func TestSetWhereSQLite(t *testing.T) {
+	ctx := context.Background()
+	db := newSQLite(t)
+	ids := []uint{}
+	for _, ietf := range []string{"en", "id", "ja", "ko"} {
+		language := new(model.Language)
+		if err := db.AddLanguage(ctx, model.AddLanguage{IETF: ietf, Name: ietf}, language); err != nil {
+			t.Fatalf("AddLanguage %s: %v", ietf, err)
+		}
+		ids = append(ids, language.ID)
+	}
+
+	ietfsOf := func(languages []*model.Language) []string {
+		result := []string{}
+		for _, language := range languages {
+			result = append(result, language.IETF)
+		}
+		return result
+	}
+	for _, c := range []struct {
+		name  string
+		conds any
+		want  []string
+	}{
+		{"in", model.DBConditionalKV{Key: model.DBGenericID, Value: model.DBIn{Value: []uint{ids[0], ids[2]}}},
+			[]string{"en", "ja"}},
+		{"in empty", model.DBConditionalKV{Key: model.DBGenericID, Value: model.DBIn{Value: []uint{}}},
+			[]string{}},
+		{"not in", model.DBConditionalKV{Key: model.DBGenericID, Value: model.DBNotIn{Value: []uint{ids[0], ids[2]}}},
+			[]string{"id", "ko"}},
+		{"not in empty", model.DBConditionalKV{Key: model.DBGenericID, Value: model.DBNotIn{Value: []uint{}}},
+			[]string{"en", "id", "ja", "ko"}},
+		{"greater than", model.DBConditionalKV{Key: model.DBGenericID, Value: model.DBGreaterThan{Value: ids[1]}},
+			[]string{"ja", "ko"}},
+		{"less than", model.DBConditionalKV{Key: model.DBGenericID, Value: model.DBLessThan{Value: ids[1]}},
+			[]string{"en"}},
+		{"between", model.DBConditionalKV{Key: model.DBLanguageIETF, Value: model.DBBetween{From: "id", To: "ja"}},
+			[]string{"id", "ja"}},
+		{"not", model.DBNot{Conditions: []any{
+			model.DBConditionalKV{Key: model.DBLanguageIETF, Value: "en"},
+			model.DBConditionalKV{Key: model.DBGenericID, Value: model.DBGreaterOrEqual{Value: ids[3]}},
+		}}, []string{"id", "ja"}},
+	} {
+		languages, _, err := db.ListLanguage(ctx, model.ListParams{
+			Conditions: c.conds,
+			OrderBys:   model.OrderBys{{Field: model.DBLanguageIETF}},
+		})
+		if err != nil {
+			t.Errorf("ListLanguage %s: %v", c.name, err)
+			continue
+		}
+		if got := ietfsOf(languages); !slices.Equal(got, c.want) {
+			t.Errorf("ListLanguage %s = %v, expected %v", c.name, got, c.want)
+		}
+	}
+}
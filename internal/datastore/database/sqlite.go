@@ -0,0 +1,288 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/georgysavva/scany/v2/dbscan"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	donoengine "github.com/mahmudindes/orenocomic-donoengine"
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+// Each connection keeps its main database in memory and attaches the file as
+// the schema the tables are qualified with.
+const sqliteDSN = "file::memory:?_time_format=sqlite&_txlock=immediate" +
+	"&_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)"
+
+var sqliteScanAPI = func() *dbscan.API {
+	api, err := dbscan.NewAPI(dbscan.WithScannableTypes((*sql.Scanner)(nil)))
+	if err != nil {
+		panic(err)
+	}
+	return api
+}()
+
+var sqliteTimeFormats = []string{
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02T15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+type (
+	sqliteConnector struct {
+		driver *sqlite.Driver
+	}
+
+	sqliteQuerier interface {
+		ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+		QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	}
+
+	// sqliteTX is a transaction, or a savepoint of one when nested.
+	sqliteTX struct {
+		tx    *sql.Tx
+		depth int
+		done  bool
+	}
+
+	sqliteRows struct {
+		*sql.Rows
+	}
+
+	sqliteJSON struct{ dst any }
+	sqliteTime struct{ dst any }
+)
+
+func openSQLite(file string) *sql.DB {
+	drv := &sqlite.Driver{}
+	drv.RegisterConnectionHook(func(conn sqlite.ExecQuerierContext, _ string) error {
+		ctx := context.Background()
+		attach := "ATTACH DATABASE $1 AS " + donoengine.ID
+		if _, err := conn.ExecContext(ctx, attach, []driver.NamedValue{{Ordinal: 1, Value: file}}); err != nil {
+			return err
+		}
+		_, err := conn.ExecContext(ctx, "PRAGMA "+donoengine.ID+".journal_mode = WAL", nil)
+		return err
+	})
+	return sql.OpenDB(sqliteConnector{driver: drv})
+}
+
+func (c sqliteConnector) Connect(context.Context) (driver.Conn, error) {
+	return c.driver.Open(sqliteDSN)
+}
+func (c sqliteConnector) Driver() driver.Driver { return c.driver }
+
+func (db Database) sqliteClient(ctx context.Context) sqliteQuerier {
+	if tx, ok := ctx.Value(ctxTXClient{}).(*sqliteTX); ok {
+		return tx.tx
+	}
+	return db.sqlite
+}
+
+func (db Database) sqliteQuery(ctx context.Context, sql string, args []any) (sqliteRows, error) {
+	rows, err := db.sqliteClient(ctx).QueryContext(ctx, sql, sqliteArgs(args)...)
+	if err != nil {
+		return sqliteRows{}, databaseError(err)
+	}
+	return sqliteRows{rows}, nil
+}
+
+func (db Database) sqliteTransactionBegin(ctx context.Context) (context.Context, error) {
+	if tx, ok := ctx.Value(ctxTXClient{}).(*sqliteTX); ok {
+		sp := &sqliteTX{tx: tx.tx, depth: tx.depth + 1}
+		if _, err := sp.tx.ExecContext(ctx, "SAVEPOINT "+sp.savepoint()); err != nil {
+			return nil, databaseError(err)
+		}
+		return context.WithValue(ctx, ctxTXClient{}, sp), nil
+	}
+
+	tx, err := db.sqlite.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, databaseError(err)
+	}
+	return context.WithValue(ctx, ctxTXClient{}, &sqliteTX{tx: tx}), nil
+}
+
+func (tx *sqliteTX) savepoint() string {
+	return "sp" + strconv.Itoa(tx.depth)
+}
+
+func (tx *sqliteTX) commit(ctx context.Context) error {
+	if tx.done {
+		return sql.ErrTxDone
+	}
+	tx.done = true
+	if tx.depth > 0 {
+		_, err := tx.tx.ExecContext(ctx, "RELEASE "+tx.savepoint())
+		return databaseError(err)
+	}
+	return databaseError(tx.tx.Commit())
+}
+
+func (tx *sqliteTX) rollback(ctx context.Context) error {
+	if tx.done {
+		return sql.ErrTxDone
+	}
+	tx.done = true
+	if tx.depth > 0 {
+		if _, err := tx.tx.ExecContext(ctx, "ROLLBACK TO "+tx.savepoint()); err != nil {
+			return err
+		}
+		_, err := tx.tx.ExecContext(ctx, "RELEASE "+tx.savepoint())
+		return err
+	}
+	return tx.tx.Rollback()
+}
+
+// sqliteArgs binds what the driver has no type for as JSON, and times in UTC
+// so the stored text sorts in time order.
+func sqliteArgs(args []any) []any {
+	result := make([]any, len(args))
+	for i, arg := range args {
+		result[i] = arg
+		val := reflect.ValueOf(arg)
+		for val.Kind() == reflect.Pointer && !val.IsNil() {
+			val = val.Elem()
+		}
+		switch {
+		case !val.IsValid(), val.Kind() == reflect.Pointer:
+			result[i] = nil
+		case val.Type() == reflect.TypeOf(time.Time{}):
+			result[i] = val.Interface().(time.Time).UTC()
+		case val.Kind() == reflect.Map, val.Kind() == reflect.Slice && val.Type().Elem().Kind() != reflect.Uint8:
+			if val.IsNil() {
+				result[i] = nil
+				continue
+			}
+			data, err := json.Marshal(val.Interface())
+			if err != nil {
+				continue
+			}
+			result[i] = string(data)
+		}
+	}
+	return result
+}
+
+// Scan decodes the JSON and time columns SQLite stores as text.
+func (r sqliteRows) Scan(dest ...any) error {
+	for i, dst := range dest {
+		if _, ok := dst.(sql.Scanner); ok {
+			continue
+		}
+		typ := reflect.TypeOf(dst)
+		for typ.Kind() == reflect.Pointer {
+			typ = typ.Elem()
+		}
+		switch {
+		case typ == reflect.TypeOf(time.Time{}):
+			dest[i] = sqliteTime{dst: dst}
+		case typ.Kind() == reflect.Map, typ.Kind() == reflect.Slice && typ.Elem().Kind() != reflect.Uint8:
+			dest[i] = sqliteJSON{dst: dst}
+		}
+	}
+	return r.Rows.Scan(dest...)
+}
+
+func (s sqliteJSON) Scan(src any) error {
+	var data []byte
+	switch src := src.(type) {
+	case nil:
+		reflect.ValueOf(s.dst).Elem().SetZero()
+		return nil
+	case string:
+		data = []byte(src)
+	case []byte:
+		data = src
+	default:
+		return errors.New("cannot scan " + reflect.TypeOf(src).String() + " as json")
+	}
+	return json.Unmarshal(data, s.dst)
+}
+
+func (s sqliteTime) Scan(src any) error {
+	val := reflect.ValueOf(s.dst).Elem()
+	if src == nil {
+		val.SetZero()
+		return nil
+	}
+
+	var t time.Time
+	switch src := src.(type) {
+	case time.Time:
+		t = src
+	case string:
+		var err error
+		for _, layout := range sqliteTimeFormats {
+			if t, err = time.Parse(layout, strings.TrimSuffix(src, "Z")); err == nil {
+				break
+			}
+		}
+		if err != nil {
+			return err
+		}
+	default:
+		return errors.New("cannot scan " + reflect.TypeOf(src).String() + " as time")
+	}
+	for val.Kind() == reflect.Pointer {
+		val.Set(reflect.New(val.Type().Elem()))
+		val = val.Elem()
+	}
+	val.Set(reflect.ValueOf(t))
+	return nil
+}
+
+// sqliteError maps the constraint errors of SQLite to the ones of PostgreSQL.
+// A unique constraint is named after its table and columns, a foreign key is
+// named by the trigger checking it.
+func sqliteError(err *sqlite.Error) error {
+	msg := err.Error()
+	if i := strings.LastIndex(msg, " ("); i > 0 {
+		msg = msg[:i]
+	}
+	_, msg, _ = strings.Cut(msg, ": ")
+
+	switch err.Code() {
+	case sqlite3.SQLITE_CONSTRAINT_CHECK:
+		name := strings.TrimPrefix(msg, "CHECK constraint failed: ")
+		return model.WrappedError(model.DatabaseError{
+			Name: name,
+			Code: CodeErrValidation,
+			Err:  err,
+		}, "database validation failed")
+	case sqlite3.SQLITE_CONSTRAINT_UNIQUE, sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY:
+		cols := strings.Split(strings.TrimPrefix(msg, "UNIQUE constraint failed: "), ", ")
+		if index, ok := strings.CutPrefix(cols[0], "index '"); ok {
+			return model.DatabaseError{Name: strings.TrimSuffix(index, "'"), Code: CodeErrExists, Err: err}
+		}
+		table, _, _ := strings.Cut(cols[0], ".")
+		name := table + "_pkey"
+		if err.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE {
+			name = table
+			for _, col := range cols {
+				_, col, _ = strings.Cut(col, ".")
+				name += "_" + col
+			}
+			name += "_key"
+		}
+		return model.DatabaseError{Name: name, Code: CodeErrExists, Err: err}
+	case sqlite3.SQLITE_CONSTRAINT_TRIGGER:
+		return model.DatabaseError{Name: msg, Code: CodeErrForeign, Err: err}
+	case sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY:
+		return model.DatabaseError{Code: CodeErrForeign, Err: err}
+	default:
+		return model.DatabaseError{Code: strconv.Itoa(err.Code()), Err: err}
+	}
+}
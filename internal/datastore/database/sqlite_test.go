@@ -0,0 +1,432 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/logger"
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+func newSQLite(t *testing.T) *Database {
+	t.Helper()
+	db, err := New(context.Background(), Config{
+		URL:         filepath.Join(t.TempDir(), "donoengine.db"),
+		Provider:    "sqlite",
+		AutoMigrate: true,
+	}, logger.New())
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func sqliteFixture(t *testing.T, db *Database) (*model.Language, *model.Website) {
+	t.Helper()
+	ctx := context.Background()
+	language, website := new(model.Language), new(model.Website)
+	if err := db.AddLanguage(ctx, model.AddLanguage{IETF: "en", Name: "English"}, language); err != nil {
+		t.Fatalf("AddLanguage: %v", err)
+	}
+	if err := db.AddWebsite(ctx, model.AddWebsite{Domain: "example.com", Name: "Example"}, website); err != nil {
+		t.Fatalf("AddWebsite: %v", err)
+	}
+	return language, website
+}
+
+func TestSQLiteGeneric(t *testing.T) {
+	ctx := context.Background()
+	db := newSQLite(t)
+	language, _ := sqliteFixture(t, db)
+
+	if language.ID == 0 || language.IETF != "en" || language.CreatedAt.IsZero() {
+		t.Fatalf("AddLanguage returned %+v", language)
+	}
+	got, err := db.GetLanguage(ctx, model.DBConditionalKV{Key: model.DBLanguageIETF, Value: "en"})
+	if err != nil {
+		t.Fatalf("GetLanguage: %v", err)
+	}
+	if got.ID != language.ID || !got.CreatedAt.Equal(language.CreatedAt) {
+		t.Errorf("GetLanguage = %+v, expected %+v", got, language)
+	}
+
+	name := "British English"
+	updated := new(model.Language)
+	conds := model.DBConditionalKV{Key: model.DBGenericID, Value: language.ID}
+	if err := db.UpdateLanguage(ctx, model.SetLanguage{Name: &name}, conds, updated); err != nil {
+		t.Fatalf("UpdateLanguage: %v", err)
+	}
+	if updated.Name != name || updated.UpdatedAt == nil {
+		t.Errorf("UpdateLanguage returned %+v", updated)
+	}
+
+	deleted := new(model.Language)
+	if err := db.AddLanguage(ctx, model.AddLanguage{IETF: "id", Name: "Indonesian"}, new(model.Language)); err != nil {
+		t.Fatalf("AddLanguage: %v", err)
+	}
+	if err := db.DeleteLanguage(ctx, model.DBConditionalKV{Key: model.DBLanguageIETF, Value: "id"}, deleted); err != nil {
+		t.Fatalf("DeleteLanguage: %v", err)
+	}
+	if deleted.IETF != "id" {
+		t.Errorf("DeleteLanguage returned %+v", deleted)
+	}
+	if _, err := db.GetLanguage(ctx, model.DBConditionalKV{Key: model.DBLanguageIETF, Value: "id"}); !errors.As(err, &model.ErrNotFound) {
+		t.Errorf("GetLanguage deleted: expected not found got %v", err)
+	}
+}
+
+func TestSQLiteConstraintError(t *testing.T) {
+	ctx := context.Background()
+	db := newSQLite(t)
+	language, website := sqliteFixture(t, db)
+	comic := new(model.Comic)
+	if err := db.AddComic(ctx, model.AddComic{LanguageID: &language.ID}, comic); err != nil {
+		t.Fatalf("AddComic: %v", err)
+	}
+	missing := uint(404)
+	rid, title := "abcd", "Title"
+
+	for _, c := range []struct {
+		name string
+		err  error
+		want string
+		code string
+	}{
+		{"unique", db.AddLanguage(ctx, model.AddLanguage{IETF: "en", Name: "English"}, new(model.Language)),
+			"same ietf already exists", ""},
+		{"foreign trigger", db.AddComic(ctx, model.AddComic{LanguageID: &missing}, nil),
+			"language does not exist", ""},
+		{"foreign not null", db.AddComicTitle(ctx, model.AddComicTitle{
+			ComicID: &comic.ID, RID: &rid, LanguageID: &missing, Title: title,
+		}, nil), "language does not exist", ""},
+		{"check", db.AddComicCover(ctx, model.AddComicCover{
+			ComicID: &comic.ID, RID: &rid, WebsiteID: &website.ID,
+		}, nil), "", CodeErrValidation},
+		{"restrict", db.DeleteLanguage(ctx, model.DBConditionalKV{Key: model.DBGenericID, Value: language.ID}, new(model.Language)),
+			"", CodeErrForeign},
+	} {
+		var errDatabase model.DatabaseError
+		switch {
+		case c.want != "":
+			if c.err == nil || c.err.Error() != c.want {
+				t.Errorf("%s: expected %q got %v", c.name, c.want, c.err)
+			}
+		case !errors.As(c.err, &errDatabase) || errDatabase.Code != c.code:
+			t.Errorf("%s: expected code %s got %v", c.name, c.code, c.err)
+		}
+	}
+
+	chapter := model.AddComicChapter{ComicID: &comic.ID, Chapter: "1", ReleasedAt: time.Now()}
+	if err := db.AddComicChapter(ctx, chapter, new(model.ComicChapter)); err != nil {
+		t.Fatalf("AddComicChapter: %v", err)
+	}
+	if err := db.AddComicChapter(ctx, chapter, new(model.ComicChapter)); err == nil || err.Error() != "same comic id + chapter + version already exists" {
+		t.Errorf("AddComicChapter without version twice: got %v", err)
+	}
+}
+
+func TestSQLiteComic(t *testing.T) {
+	ctx := context.Background()
+	db := newSQLite(t)
+	language, website := sqliteFixture(t, db)
+
+	codes := []string{"comic001", "comic002", "comic003"}
+	for i, code := range codes {
+		code, title, rid := code, "Title "+code, "t00"+string(rune('0'+i))
+		if err := db.AddComic(ctx, model.AddComic{
+			Code:        &code,
+			LanguageID:  &language.ID,
+			Additionals: map[string]any{"index": i, "source": map[string]any{"web": i == 0}},
+		}, nil); err != nil {
+			t.Fatalf("AddComic: %v", err)
+		}
+		if err := db.AddComicTitle(ctx, model.AddComicTitle{
+			ComicCode: &code, RID: &rid, LanguageID: &language.ID, Title: title,
+		}, nil); err != nil {
+			t.Fatalf("AddComicTitle: %v", err)
+		}
+	}
+	relativeURL := "/cover.png"
+	if err := db.AddComicCover(ctx, model.AddComicCover{
+		ComicCode: &codes[0], WebsiteID: &website.ID, RelativeURL: relativeURL,
+	}, nil); err != nil {
+		t.Fatalf("AddComicCover: %v", err)
+	}
+
+	codesOf := func(comics []*model.Comic) []string {
+		result := []string{}
+		for _, comic := range comics {
+			result = append(result, comic.Code)
+		}
+		return result
+	}
+	for _, c := range []struct {
+		name  string
+		conds any
+		want  []string
+	}{
+		{"in", model.DBConditionalKV{Key: model.DBComicCode, Value: model.DBIn{Value: []string{"comic001", "comic003"}}},
+			[]string{"comic001", "comic003"}},
+		{"not in", model.DBConditionalKV{Key: model.DBComicCode, Value: model.DBNotIn{Value: []string{"comic001"}}},
+			[]string{"comic002", "comic003"}},
+		{"like", model.DBConditionalKV{Key: model.DBComicCode, Value: model.DBInsensitiveLike("COMIC00_")},
+			codes},
+		{"json contains", model.DBConditionalKV{Key: model.DBComicAdditionals, Value: model.DBJSONContains{
+			Value: map[string]any{"source": map[string]any{"web": true}},
+		}}, []string{"comic001"}},
+		{"distinct from", model.DBConditionalKV{Key: model.DBComicNSFW, Value: model.DBIsNotDistinctFrom{Value: nil}},
+			codes},
+		{"search", model.DBCrossConditional{Table: model.DBComicTitle, Conditions: model.DBConditionalKV{
+			Key: model.DBComicTitleTitle, Value: model.DBTextSearch("COMIC002"),
+		}}, []string{"comic002"}},
+	} {
+		comics, _, err := db.ListComic(ctx, model.ListParams{Conditions: c.conds})
+		if err != nil {
+			t.Errorf("ListComic %s: %v", c.name, err)
+			continue
+		}
+		if got := codesOf(comics); !slices.Equal(got, c.want) {
+			t.Errorf("ListComic %s = %v, expected %v", c.name, got, c.want)
+		}
+	}
+
+	params := model.ListParams{
+		OrderBys:   model.OrderBys{{Field: model.DBComicCode, Sort: "desc"}},
+		Pagination: &model.Pagination{Page: 1, Limit: 2},
+	}
+	first, cursor, err := db.ListComic(ctx, params)
+	if err != nil {
+		t.Fatalf("ListComic page: %v", err)
+	}
+	if len(cursor) < 1 {
+		t.Fatalf("ListComic page: no next cursor for %v", codesOf(first))
+	}
+	params.Pagination.Cursor = cursor
+	next, _, err := db.ListComic(ctx, params)
+	if err != nil {
+		t.Fatalf("ListComic cursor: %v", err)
+	}
+	if got := append(codesOf(first), codesOf(next)...); !slices.Equal(got, []string{"comic003", "comic002", "comic001"}) {
+		t.Errorf("ListComic pages = %v", got)
+	}
+
+	updated := new(model.Comic)
+	conds := model.DBConditionalKV{Key: model.DBComicCode, Value: codes[0]}
+	if err := db.UpdateComic(ctx, model.SetComic{
+		Additionals: map[string]any{"index": nil, "source": map[string]any{"print": true}},
+	}, conds, updated); err != nil {
+		t.Fatalf("UpdateComic: %v", err)
+	}
+	if _, ok := updated.Additionals["index"]; ok || len(updated.Additionals["source"].(map[string]any)) != 1 {
+		t.Errorf("UpdateComic merged additionals = %v", updated.Additionals)
+	}
+	if updated.LanguageIETF == nil || *updated.LanguageIETF != "en" {
+		t.Errorf("UpdateComic language = %v", updated.LanguageIETF)
+	}
+
+	detail, err := db.GetComicDetail(ctx, conds)
+	if err != nil {
+		t.Fatalf("GetComicDetail: %v", err)
+	}
+	if len(detail.Titles) != 1 || len(detail.Covers) != 1 || detail.Covers[0].RelativeURL != relativeURL {
+		t.Errorf("GetComicDetail titles %v covers %v", detail.Titles, detail.Covers)
+	}
+	if detail.Chapters == nil || detail.Tags == nil {
+		t.Error("GetComicDetail: expected empty children")
+	}
+}
+
+func TestSQLiteSoftDelete(t *testing.T) {
+	ctx := context.Background()
+	db := newSQLite(t)
+	language, _ := sqliteFixture(t, db)
+
+	code := "comic001"
+	comic := new(model.Comic)
+	if err := db.AddComic(ctx, model.AddComic{Code: &code}, comic); err != nil {
+		t.Fatalf("AddComic: %v", err)
+	}
+	rid := "t000"
+	if err := db.AddComicTitle(ctx, model.AddComicTitle{
+		ComicID: &comic.ID, RID: &rid, LanguageID: &language.ID, Title: "Title",
+	}, nil); err != nil {
+		t.Fatalf("AddComicTitle: %v", err)
+	}
+	conds := model.DBConditionalKV{Key: model.DBComicCode, Value: code}
+	if err := db.DeleteComic(ctx, conds, new(model.Comic)); err != nil {
+		t.Fatalf("DeleteComic: %v", err)
+	}
+	if err := db.AddComic(ctx, model.AddComic{Code: &code}, nil); err != nil {
+		t.Fatalf("AddComic reusing deleted code: %v", err)
+	}
+	if err := db.RestoreComic(ctx, model.DBConditionalKV{Key: model.DBGenericID, Value: comic.ID}, nil); err == nil {
+		t.Error("RestoreComic over a live code: expected error")
+	}
+
+	trash, err := db.ListTrash(ctx, model.ListParams{})
+	if err != nil {
+		t.Fatalf("ListTrash: %v", err)
+	}
+	if len(trash) != 1 || trash[0].ID != comic.ID || trash[0].TypeID != nil {
+		t.Errorf("ListTrash = %+v", trash)
+	}
+	purged, err := db.PurgeTrash(ctx, time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatalf("PurgeTrash: %v", err)
+	}
+	if purged != 1 {
+		t.Errorf("PurgeTrash: expected 1 got %d", purged)
+	}
+	if n, err := db.CountComicTitle(ctx, model.DBConditionalKV{Key: model.DBComicGenericComicID, Value: comic.ID}); err != nil || n != 0 {
+		t.Errorf("CountComicTitle after purge = %d, %v", n, err)
+	}
+}
+
+func TestSQLiteTransaction(t *testing.T) {
+	ctx := context.Background()
+	db := newSQLite(t)
+
+	tx, err := db.ContextTransactionBegin(ctx)
+	if err != nil {
+		t.Fatalf("ContextTransactionBegin: %v", err)
+	}
+	if err := db.AddLanguage(tx, model.AddLanguage{IETF: "en", Name: "English"}, new(model.Language)); err != nil {
+		t.Fatalf("AddLanguage: %v", err)
+	}
+	nested, err := db.ContextTransactionBegin(tx)
+	if err != nil {
+		t.Fatalf("ContextTransactionBegin nested: %v", err)
+	}
+	if err := db.AddLanguage(nested, model.AddLanguage{IETF: "id", Name: "Indonesian"}, new(model.Language)); err != nil {
+		t.Fatalf("AddLanguage nested: %v", err)
+	}
+	if err := db.ContextTransactionRollback(nested); err != nil {
+		t.Fatalf("ContextTransactionRollback nested: %v", err)
+	}
+	if err := db.ContextTransactionCommit(tx); err != nil {
+		t.Fatalf("ContextTransactionCommit: %v", err)
+	}
+
+	languages, _, err := db.ListLanguage(ctx, model.ListParams{})
+	if err != nil {
+		t.Fatalf("ListLanguage: %v", err)
+	}
+	if len(languages) != 1 || languages[0].IETF != "en" {
+		t.Errorf("ListLanguage after nested rollback = %+v", languages)
+	}
+
+	batch := []*model.Language{}
+	if err := db.BatchAdd(ctx, model.DBLanguage, []map[string]any{
+		{model.DBLanguageIETF: "ja", model.DBLanguageName: "Japanese"},
+		{model.DBLanguageIETF: "ko", model.DBLanguageName: "Korean"},
+	}, &batch); err != nil {
+		t.Fatalf("BatchAdd: %v", err)
+	}
+	if len(batch) != 2 || batch[0].IETF != "ja" || batch[1].IETF != "ko" || batch[1].CreatedAt.IsZero() {
+		t.Errorf("BatchAdd = %+v", batch)
+	}
+	if err := db.BatchAdd(ctx, model.DBLanguage, []map[string]any{
+		{model.DBLanguageIETF: "zh", model.DBLanguageName: "Chinese"},
+		{model.DBLanguageIETF: "en", model.DBLanguageName: "English"},
+	}, nil); err == nil {
+		t.Error("BatchAdd duplicate: expected error")
+	}
+	if n, err := db.CountLanguage(ctx, nil); err != nil || n != 3 {
+		t.Errorf("CountLanguage after failed batch = %d, %v", n, err)
+	}
+}
+
+// sqliteNullFixture adds comics whose total chapter is partly empty.
+func sqliteNullFixture(t *testing.T, db *Database) {
+	t.Helper()
+	one, two := 1, 2
+	for i, total := range []*int{nil, &one, nil, &two, nil} {
+		code := "comic00" + strconv.Itoa(i+1)
+		if err := db.AddComic(context.Background(), model.AddComic{Code: &code, TotalChapter: total}, nil); err != nil {
+			t.Fatalf("AddComic: %v", err)
+		}
+	}
+}
+
+func TestSQLiteNullOrder(t *testing.T) {
+	ctx := context.Background()
+	db := newSQLite(t)
+	sqliteNullFixture(t, db)
+
+	for _, c := range []struct {
+		name string
+		obs  model.OrderBys
+		want []string
+	}{
+		{"ascending", model.OrderBys{{Field: model.DBComicTotalChapter}, {Field: model.DBComicCode}},
+			[]string{"comic002", "comic004", "comic001", "comic003", "comic005"}},
+		{"descending", model.OrderBys{{Field: model.DBComicTotalChapter, Sort: "desc"}, {Field: model.DBComicCode}},
+			[]string{"comic001", "comic003", "comic005", "comic004", "comic002"}},
+		{"ascending nulls first", model.OrderBys{{Field: model.DBComicTotalChapter, Null: "first"}, {Field: model.DBComicCode}},
+			[]string{"comic001", "comic003", "comic005", "comic002", "comic004"}},
+	} {
+		got := []string{}
+		for page := 1; page <= 3; page++ {
+			comics, _, err := db.ListComic(ctx, model.ListParams{
+				OrderBys:   c.obs,
+				Pagination: &model.Pagination{Page: page, Limit: 2},
+			})
+			if err != nil {
+				t.Fatalf("ListComic %s page %d: %v", c.name, page, err)
+			}
+			for _, comic := range comics {
+				got = append(got, comic.Code)
+			}
+		}
+		if !slices.Equal(got, c.want) {
+			t.Errorf("ListComic %s = %v, expected %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestSQLiteNullCursor(t *testing.T) {
+	ctx := context.Background()
+	db := newSQLite(t)
+	sqliteNullFixture(t, db)
+
+	for _, obs := range []model.OrderBys{
+		{{Field: model.DBComicTotalChapter}, {Field: model.DBComicCode}},
+		{{Field: model.DBComicTotalChapter, Sort: "desc"}, {Field: model.DBComicCode}},
+		{{Field: model.DBComicTotalChapter, Null: "first"}, {Field: model.DBComicCode, Sort: "desc"}},
+		{{Field: model.DBComicTotalChapter, Sort: "desc", Null: "last"}, {Field: model.DBComicCode}},
+	} {
+		want := []string{}
+		comics, _, err := db.ListComic(ctx, model.ListParams{OrderBys: obs})
+		if err != nil {
+			t.Fatalf("ListComic %s: %v", obs.CursorKey(), err)
+		}
+		for _, comic := range comics {
+			want = append(want, comic.Code)
+		}
+
+		got := []string{}
+		params := model.ListParams{OrderBys: obs, Pagination: &model.Pagination{Page: 1, Limit: 2}}
+		for len(got) <= len(want) {
+			comics, cursor, err := db.ListComic(ctx, params)
+			if err != nil {
+				t.Fatalf("ListComic %s cursor: %v", obs.CursorKey(), err)
+			}
+			for _, comic := range comics {
+				got = append(got, comic.Code)
+			}
+			if cursor == nil {
+				break
+			}
+			params.Pagination.Cursor = cursor
+		}
+		if len(want) != 5 || !slices.Equal(got, want) {
+			t.Errorf("ListComic %s cursor pages = %v, expected %v", obs.CursorKey(), got, want)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/mahmudindes/orenocomic-donoengine/internal/datastore/database"
 	"github.com/mahmudindes/orenocomic-donoengine/internal/datastore/redis"
+	"github.com/mahmudindes/orenocomic-donoengine/internal/logger"
 )
 
 type (
@@ -19,10 +20,10 @@ type (
 	}
 )
 
-func New(ctx context.Context, cfg Config) (datastore, error) {
+func New(ctx context.Context, cfg Config, log logger.Logger) (datastore, error) {
 	ds := datastore{}
 
-	db, err := database.New(ctx, cfg.Database)
+	db, err := database.New(ctx, cfg.Database, log.WithName("Database"))
 	if err != nil {
 		return ds, err
 	}
@@ -0,0 +1,586 @@
+package memory
+
+import (
+	"cmp"
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+	"github.com/mahmudindes/orenocomic-donoengine/internal/utila"
+)
+
+// Conditions are evaluated with SQL three-valued logic, so a comparison with
+// NULL neither matches nor, once negated, starts matching.
+type truth int8
+
+const (
+	truthNone truth = iota
+	truthFalse
+	truthTrue
+	truthNull
+)
+
+func truthOf(b bool) truth {
+	if b {
+		return truthTrue
+	}
+	return truthFalse
+}
+
+func (s *state) match(r row, conds any) bool {
+	t := s.where(r, conds)
+	return t == truthNone || t == truthTrue
+}
+
+func (s *state) where(r row, conds any) (result truth) {
+	switch conds := conds.(type) {
+	case []any:
+		and := false
+		for _, conds := range conds {
+			switch conds.(type) {
+			case model.DBLogicalAND:
+				and = true
+			case model.DBLogicalOR:
+				and = false
+			default:
+				t := s.where(r, conds)
+				if t == truthNone {
+					continue
+				}
+				if result == truthNone {
+					result = t
+					continue
+				}
+				if and {
+					result = truthAnd(result, t)
+				} else {
+					result = truthOr(result, t)
+				}
+			}
+		}
+	case map[string]any:
+		for key, val := range conds {
+			if utila.NilData(val) {
+				continue
+			}
+			t := s.where(r, model.DBConditionalKV{Key: key, Value: val})
+			if result == truthNone {
+				result = t
+				continue
+			}
+			result = truthAnd(result, t)
+		}
+	case model.DBNot:
+		switch t := s.where(r, conds.Conditions); t {
+		case truthTrue:
+			result = truthFalse
+		case truthFalse:
+			result = truthTrue
+		default:
+			result = t
+		}
+	case model.DBCursor:
+		if len(conds.Values) != len(conds.OrderBys) {
+			return truthNone
+		}
+		values := row{}
+		for i, ob := range conds.OrderBys {
+			field, ok := ob.Field.(string)
+			if !ok {
+				return truthNone
+			}
+			values[field] = normalize(conds.Values[i])
+		}
+		result = truthOf(compareRows(r, values, conds.OrderBys) > 0)
+	case model.DBConditionalKV:
+		result = s.whereKV(r[conds.Key], conds.Value)
+	}
+	return
+}
+
+func (s *state) whereKV(col any, val any) truth {
+	switch val := val.(type) {
+	case model.DBIsDistinctFrom:
+		return truthOf(distinct(col, normalize(val.Value)))
+	case model.DBIsNotDistinctFrom:
+		return truthOf(!distinct(col, normalize(val.Value)))
+	case model.DBGreaterThan:
+		return compareTruth(col, s.value(val.Value), func(c int) bool { return c > 0 })
+	case model.DBGreaterOrEqual:
+		return compareTruth(col, s.value(val.Value), func(c int) bool { return c >= 0 })
+	case model.DBLessThan:
+		return compareTruth(col, s.value(val.Value), func(c int) bool { return c < 0 })
+	case model.DBLessOrEqual:
+		return compareTruth(col, s.value(val.Value), func(c int) bool { return c <= 0 })
+	case model.DBBetween:
+		return truthAnd(
+			compareTruth(col, s.value(val.From), func(c int) bool { return c >= 0 }),
+			compareTruth(col, s.value(val.To), func(c int) bool { return c <= 0 }),
+		)
+	case model.DBIn:
+		result := truthFalse
+		values, _ := s.value(val.Value).([]any)
+		for _, v := range values {
+			result = truthOr(result, compareTruth(col, v, func(c int) bool { return c == 0 }))
+		}
+		return result
+	case model.DBNotIn:
+		result := truthTrue
+		values, _ := s.value(val.Value).([]any)
+		for _, v := range values {
+			result = truthAnd(result, compareTruth(col, v, func(c int) bool { return c != 0 }))
+		}
+		return result
+	case model.DBIsNull:
+		return truthOf(col == nil)
+	case model.DBIsNotNull:
+		return truthOf(col != nil)
+	case model.DBBooleanIs:
+		b, ok := col.(bool)
+		return truthOf(ok && b == bool(val))
+	case model.DBBooleanIsNot:
+		b, ok := col.(bool)
+		return truthOf(!ok || b != bool(val))
+	case model.DBJSONContains:
+		if col == nil {
+			return truthNull
+		}
+		return truthOf(jsonContains(col, normalizeJSON(val.Value)))
+	case model.DBInsensitiveLike:
+		text, ok := col.(string)
+		if !ok {
+			return truthNull
+		}
+		return truthOf(likePattern(string(val)).MatchString(text))
+	case model.DBTextSearch:
+		text, ok := col.(string)
+		if !ok {
+			return truthNull
+		}
+		return truthOf(textSimilarity(text, string(val)) >= textSimilarityThreshold || textMatch(text, string(val)))
+	case model.DBCrossConditional:
+		return truthNone
+	default:
+		return compareTruth(col, s.value(val), func(c int) bool { return c == 0 })
+	}
+}
+
+func truthAnd(a, b truth) truth {
+	switch {
+	case a == truthFalse || b == truthFalse:
+		return truthFalse
+	case a == truthNull || b == truthNull:
+		return truthNull
+	}
+	return truthTrue
+}
+
+func truthOr(a, b truth) truth {
+	switch {
+	case a == truthTrue || b == truthTrue:
+		return truthTrue
+	case a == truthNull || b == truthNull:
+		return truthNull
+	}
+	return truthFalse
+}
+
+func compareTruth(a, b any, fn func(c int) bool) truth {
+	if a == nil || b == nil {
+		return truthNull
+	}
+	c, ok := compare(a, b)
+	if !ok {
+		return truthFalse
+	}
+	return truthOf(fn(c))
+}
+
+func distinct(a, b any) bool {
+	if a == nil || b == nil {
+		return a != b
+	}
+	c, ok := compare(a, b)
+	return !ok || c != 0
+}
+
+// Subquery values resolve to the first matching row or fall back to the zero
+// value, the same as the COALESCE wrapped subselect.
+func (s *state) value(val any) any {
+	switch v := val.(type) {
+	case model.DBQueryValue:
+		if tb, ok := s.tables[v.Table]; ok {
+			for _, r := range tb.rows {
+				if s.where(r, v.Conditions) == truthTrue {
+					if r[v.Expression] != nil {
+						return r[v.Expression]
+					}
+					break
+				}
+			}
+		}
+		return normalize(v.ZeroValue)
+	default:
+		return normalize(val)
+	}
+}
+
+func normalize(val any) any {
+	switch v := val.(type) {
+	case nil:
+		return nil
+	case time.Time:
+		return v.UTC().Truncate(time.Microsecond)
+	case map[string]any:
+		return normalizeJSON(v)
+	}
+	rv := reflect.ValueOf(val)
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return nil
+		}
+		return normalize(rv.Elem().Interface())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		return rv.Float()
+	case reflect.String:
+		return rv.String()
+	case reflect.Bool:
+		return rv.Bool()
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			return nil
+		}
+		values := make([]any, rv.Len())
+		for i := range values {
+			values[i] = normalize(rv.Index(i).Interface())
+		}
+		return values
+	case reflect.Map:
+		if rv.IsNil() {
+			return nil
+		}
+		return normalizeJSON(val)
+	}
+	return val
+}
+
+func normalizeJSON(val any) any {
+	if utila.NilData(val) {
+		return nil
+	}
+	raw, err := json.Marshal(val)
+	if err != nil {
+		return nil
+	}
+	var result any
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil
+	}
+	return result
+}
+
+// Text parameters are cast to the column type like the database would do for
+// untyped arguments.
+func coerce(a, b any) (any, any) {
+	if sa, ok := a.(string); ok {
+		if _, ok := b.(string); !ok {
+			return coerceString(sa, b), b
+		}
+	}
+	if sb, ok := b.(string); ok {
+		if _, ok := a.(string); !ok {
+			return a, coerceString(sb, a)
+		}
+	}
+	return a, b
+}
+
+func coerceString(s string, like any) any {
+	switch like.(type) {
+	case int64:
+		if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return v
+		}
+	case float64:
+		if v, err := strconv.ParseFloat(s, 64); err == nil {
+			return v
+		}
+	case bool:
+		switch strings.ToLower(strings.TrimSpace(s)) {
+		case "t", "true", "y", "yes", "on", "1":
+			return true
+		case "f", "false", "n", "no", "off", "0":
+			return false
+		}
+	case time.Time:
+		if v, err := time.Parse(time.RFC3339Nano, s); err == nil {
+			return normalize(v)
+		}
+	}
+	return s
+}
+
+func compare(a, b any) (int, bool) {
+	a, b = coerce(a, b)
+	switch a := a.(type) {
+	case int64:
+		switch b := b.(type) {
+		case int64:
+			return cmp.Compare(a, b), true
+		case float64:
+			return cmp.Compare(float64(a), b), true
+		}
+	case float64:
+		switch b := b.(type) {
+		case int64:
+			return cmp.Compare(a, float64(b)), true
+		case float64:
+			return cmp.Compare(a, b), true
+		}
+	case string:
+		if b, ok := b.(string); ok {
+			return strings.Compare(a, b), true
+		}
+	case bool:
+		if b, ok := b.(bool); ok {
+			switch {
+			case a == b:
+				return 0, true
+			case b:
+				return -1, true
+			}
+			return 1, true
+		}
+	case time.Time:
+		if b, ok := b.(time.Time); ok {
+			return a.Compare(b), true
+		}
+	}
+	if reflect.DeepEqual(a, b) {
+		return 0, true
+	}
+	return 0, false
+}
+
+func jsonContains(a, b any) bool {
+	switch b := b.(type) {
+	case map[string]any:
+		a, ok := a.(map[string]any)
+		if !ok {
+			return false
+		}
+		for key, val := range b {
+			if _, ok := a[key]; !ok || !jsonContains(a[key], val) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		a, ok := a.([]any)
+		if !ok {
+			return false
+		}
+		for _, val := range b {
+			if !slices.ContainsFunc(a, func(v any) bool { return jsonContains(v, val) }) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func jsonMerge(dst, src any) any {
+	result := map[string]any{}
+	if dst, ok := dst.(map[string]any); ok {
+		for key, val := range dst {
+			result[key] = val
+		}
+	}
+	if src, ok := src.(map[string]any); ok {
+		for key, val := range src {
+			result[key] = val
+		}
+	}
+	return jsonStripNulls(result)
+}
+
+func jsonStripNulls(val any) any {
+	switch v := val.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for key, val := range v {
+			if val != nil {
+				result[key] = jsonStripNulls(val)
+			}
+		}
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, val := range v {
+			result[i] = jsonStripNulls(val)
+		}
+		return result
+	}
+	return val
+}
+
+func likePattern(pattern string) *regexp.Regexp {
+	expr, escape := "", false
+	for _, c := range pattern {
+		switch {
+		case escape:
+			expr += regexp.QuoteMeta(string(c))
+			escape = false
+		case c == '\\':
+			escape = true
+		case c == '%':
+			expr += ".*"
+		case c == '_':
+			expr += "."
+		default:
+			expr += regexp.QuoteMeta(string(c))
+		}
+	}
+	return regexp.MustCompile("(?is)^" + expr + "$")
+}
+
+// Text search mirrors pg_trgm similarity and the simple text search config.
+const textSimilarityThreshold = 0.3
+
+func textWords(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(c rune) bool {
+		return !unicode.IsLetter(c) && !unicode.IsDigit(c)
+	})
+}
+
+func textTrigrams(s string) map[string]bool {
+	trigrams := map[string]bool{}
+	for _, word := range textWords(s) {
+		w := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(w); i++ {
+			trigrams[string(w[i:i+3])] = true
+		}
+	}
+	return trigrams
+}
+
+func textSimilarity(a, b string) float64 {
+	ta, tb := textTrigrams(a), textTrigrams(b)
+	if len(ta) < 1 || len(tb) < 1 {
+		return 0
+	}
+	common := 0
+	for t := range tb {
+		if ta[t] {
+			common++
+		}
+	}
+	return float64(common) / float64(len(ta)+len(tb)-common)
+}
+
+func textMatch(text, query string) bool {
+	words, queries := textWords(text), textWords(query)
+	if len(queries) < 1 {
+		return false
+	}
+	for _, q := range queries {
+		if !slices.Contains(words, q) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *state) textSearchRank(r row, conds any) (rank float64) {
+	switch conds := conds.(type) {
+	case []any:
+		for _, conds := range conds {
+			rank = max(rank, s.textSearchRank(r, conds))
+		}
+	case map[string]any:
+		for key, val := range conds {
+			rank = max(rank, s.textSearchRank(r, model.DBConditionalKV{Key: key, Value: val}))
+		}
+	case model.DBConditionalKV:
+		if val, ok := conds.Value.(model.DBTextSearch); ok {
+			if text, ok := r[conds.Key].(string); ok {
+				rank = textSimilarity(text, string(val))
+			}
+		}
+	}
+	return
+}
+
+func compareRows(a, b row, obs model.OrderBys) int {
+	for _, ob := range obs {
+		field, ok := ob.Field.(string)
+		if !ok || field == "" {
+			continue
+		}
+		desc, nullsFirst := false, false
+		switch strings.ToLower(ob.Sort) {
+		case "d", "desc", "descend", "descending":
+			desc, nullsFirst = true, true
+		}
+		switch strings.ToLower(ob.Null) {
+		case "f", "first":
+			nullsFirst = true
+		case "l", "last":
+			nullsFirst = false
+		}
+		va, vb := a[field], b[field]
+		c := 0
+		switch {
+		case va == nil && vb == nil:
+		case va == nil:
+			c = 1
+			if nullsFirst {
+				c = -1
+			}
+		case vb == nil:
+			c = -1
+			if nullsFirst {
+				c = 1
+			}
+		default:
+			c, _ = compare(va, vb)
+			if desc {
+				c = -c
+			}
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func paginate(rows []row, p model.Pagination) []row {
+	if p.Limit < 1 {
+		return rows
+	}
+	if p.Cursor == nil {
+		if offset := p.Limit * (p.Page - 1); offset > 0 {
+			if offset >= len(rows) {
+				return []row{}
+			}
+			rows = rows[offset:]
+		}
+	}
+	if len(rows) > p.Limit {
+		rows = rows[:p.Limit]
+	}
+	return rows
+}
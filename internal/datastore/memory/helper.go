@@ -0,0 +1,425 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/georgysavva/scany/v2/dbscan"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+	"github.com/mahmudindes/orenocomic-donoengine/internal/utila"
+)
+
+var softDeleteTables = []string{model.DBComic, model.DBCategory, model.DBTag}
+
+// A view adds joined columns to a stored row, ok is false when an inner join
+// would drop the row.
+type view func(s *state, r row) (result row, ok bool)
+
+func (m *Memory) GenericAdd(ctx context.Context, t string, data map[string]any, vw view, v any) error {
+	return m.write(ctx, func(s *state) error {
+		r, err := s.insert(t, data)
+		if err != nil {
+			return err
+		}
+		if utila.NilData(v) {
+			return nil
+		}
+		return scanOne(s.view([]row{r}, vw), v)
+	})
+}
+
+func (m *Memory) GenericGet(ctx context.Context, t string, conds any, vw view, v any) error {
+	return m.read(ctx, func(s *state) error {
+		return scanOne(s.find(t, softDeleteTableConds(t, conds), vw), v)
+	})
+}
+
+func (m *Memory) GenericUpdate(ctx context.Context, t string, data map[string]any, conds any, vw view, v any) error {
+	return m.write(ctx, func(s *state) error {
+		rows, err := s.update(t, data, softDeleteTableConds(t, conds))
+		if err != nil {
+			return err
+		}
+		if utila.NilData(v) {
+			return nil
+		}
+		return scanOne(s.view(rows, vw), v)
+	})
+}
+
+func (m *Memory) GenericDelete(ctx context.Context, t string, conds any, vw view, v any) error {
+	return m.write(ctx, func(s *state) error {
+		var rows []row
+		var err error
+		if slices.Contains(softDeleteTables, t) {
+			rows, err = s.update(t, map[string]any{model.DBGenericDeletedAt: now()}, softDeleteConds(conds))
+		} else {
+			rows, err = s.delete(t, conds)
+		}
+		if err != nil {
+			return err
+		}
+		if utila.NilData(v) {
+			return nil
+		}
+		return scanOne(s.view(rows, vw), v)
+	})
+}
+
+func (m *Memory) GenericRestore(ctx context.Context, t string, conds any, vw view, v any) error {
+	return m.write(ctx, func(s *state) error {
+		// Only the most recently deleted row comes back, like the database.
+		rows := s.find(t, trashConds(conds), nil)
+		s.sort(rows, model.OrderBys{
+			{Field: model.DBGenericDeletedAt, Sort: "desc"},
+			{Field: model.DBGenericID, Sort: "desc"},
+		})
+		if len(rows) > 0 {
+			var err error
+			rows, err = s.update(t, map[string]any{model.DBGenericDeletedAt: nil}, model.DBConditionalKV{
+				Key:   model.DBGenericID,
+				Value: rows[0][model.DBGenericID],
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if utila.NilData(v) {
+			return nil
+		}
+		return scanOne(s.view(rows, vw), v)
+	})
+}
+
+func GenericList[T any](ctx context.Context, m *Memory, t string, params model.ListParams, vw view) ([]*T, error) {
+	var result []*T
+	if err := m.read(ctx, func(s *state) error {
+		rows := s.find(t, softDeleteTableConds(t, params.Conditions), vw)
+		s.sort(rows, params.OrderBys)
+		if params.Pagination != nil {
+			rows = paginate(rows, *params.Pagination)
+		}
+		var err error
+		result, err = scanAll[T](rows)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (m *Memory) GenericCount(ctx context.Context, t string, conds any) (int, error) {
+	var dst int
+	if err := m.read(ctx, func(s *state) error {
+		dst = len(s.find(t, softDeleteTableConds(t, conds), nil))
+		return nil
+	}); err != nil {
+		return -1, err
+	}
+	return dst, nil
+}
+
+func (m *Memory) GenericExists(ctx context.Context, t string, conds any) (bool, error) {
+	count, err := m.GenericCount(ctx, t, conds)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Microsecond)
+}
+
+func (s *state) index(t string) map[any]row {
+	ids := map[any]row{}
+	for _, r := range s.tables[t].rows {
+		ids[r[model.DBGenericID]] = r
+	}
+	return ids
+}
+
+func (s *state) view(rows []row, vw view) []row {
+	if vw == nil {
+		return rows
+	}
+	result := make([]row, 0, len(rows))
+	for _, r := range rows {
+		if r, ok := vw(s, r); ok {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+func (s *state) find(t string, conds any, vw view) []row {
+	result := []row{}
+	for _, r := range s.view(s.tables[t].rows, vw) {
+		if s.match(r, conds) {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+func (s *state) sort(rows []row, obs model.OrderBys) {
+	slices.SortStableFunc(rows, func(a, b row) int {
+		return compareRows(a, b, obs)
+	})
+}
+
+// Joined columns are copied under their alias, the joined row is returned so
+// callers can tell whether it is soft deleted.
+func (s *state) join(r row, t, column string, fields map[string]string) (row, row) {
+	var joined row
+	for _, r0 := range s.tables[t].rows {
+		if c, ok := compare(r0[model.DBGenericID], r[column]); ok && c == 0 {
+			joined = r0
+			break
+		}
+	}
+	result := cloneRow(r)
+	for from, to := range fields {
+		result[to] = nil
+		if joined != nil {
+			result[to] = joined[from]
+		}
+	}
+	return result, joined
+}
+
+func joinView(t, column string, fields map[string]string, active bool) view {
+	return func(s *state, r row) (row, bool) {
+		r, joined := s.join(r, t, column, fields)
+		if joined == nil {
+			return r, false
+		}
+		return r, !active || joined[model.DBGenericDeletedAt] == nil
+	}
+}
+
+func cloneRow(r row) row {
+	result := make(row, len(r))
+	for key, val := range r {
+		result[key] = val
+	}
+	return result
+}
+
+func (s *state) column(t, key string, val any) any {
+	if slices.Contains(schemas[t].json, key) {
+		return normalizeJSON(val)
+	}
+	return s.value(val)
+}
+
+func (s *state) insert(t string, data map[string]any) (row, error) {
+	sch, tb := schemas[t], s.tables[t]
+	r := row{}
+	for key, val := range data {
+		if utila.NilData(val) {
+			continue
+		}
+		r[key] = s.column(t, key, val)
+	}
+	if sch.identity {
+		tb.seq++
+		r[model.DBGenericID] = int64(tb.seq)
+	}
+	r[model.DBGenericCreatedAt] = now()
+	r[model.DBGenericUpdatedAt] = nil
+	if sch.softDelete {
+		r[model.DBGenericDeletedAt] = nil
+	}
+	tb.rows = append(tb.rows, r)
+	if err := s.validate(t, []row{r}); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (s *state) update(t string, data map[string]any, conds any) ([]row, error) {
+	if _, ok := data[model.DBGenericDeletedAt]; !ok {
+		data[model.DBGenericUpdatedAt] = now()
+	}
+	values := map[string]any{}
+	for key, val := range data {
+		switch val := val.(type) {
+		case model.DBJSONMerge:
+			values[key] = val
+		default:
+			values[key] = s.column(t, key, val)
+		}
+	}
+	tb := s.tables[t]
+	result := []row{}
+	for i, r := range tb.rows {
+		if !s.match(r, conds) {
+			continue
+		}
+		r = cloneRow(r)
+		for key, val := range values {
+			if val, ok := val.(model.DBJSONMerge); ok {
+				r[key] = jsonMerge(r[key], normalizeJSON(val.Value))
+				continue
+			}
+			r[key] = val
+		}
+		tb.rows[i] = r
+		result = append(result, r)
+	}
+	if err := s.validate(t, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *state) delete(t string, conds any) ([]row, error) {
+	tb := s.tables[t]
+	kept, removed := []row{}, []row{}
+	for _, r := range tb.rows {
+		if s.match(r, conds) {
+			removed = append(removed, r)
+			continue
+		}
+		kept = append(kept, r)
+	}
+	tb.rows = kept
+	if err := s.cascade(t, removed); err != nil {
+		return nil, err
+	}
+	return removed, nil
+}
+
+func softDeleteConds(conds any) any {
+	return []any{
+		model.DBLogicalAND{},
+		conds,
+		model.DBConditionalKV{Key: model.DBGenericDeletedAt, Value: model.DBIsNull{}},
+	}
+}
+
+func softDeleteTableConds(t string, conds any) any {
+	if slices.Contains(softDeleteTables, t) {
+		return softDeleteConds(conds)
+	}
+	return conds
+}
+
+func trashConds(conds any) any {
+	return []any{
+		model.DBLogicalAND{},
+		conds,
+		model.DBConditionalKV{Key: model.DBGenericDeletedAt, Value: model.DBIsNotNull{}},
+	}
+}
+
+func scanOne(rows []row, v any) error {
+	switch len(rows) {
+	case 0:
+		return model.NotFoundError(errNoRows)
+	case 1:
+		return scanRow(rows[0], v)
+	}
+	return fmt.Errorf("expected 1 row, got: %d", len(rows))
+}
+
+func scanAll[T any](rows []row) ([]*T, error) {
+	result := make([]*T, 0, len(rows))
+	for _, r := range rows {
+		v := new(T)
+		if err := scanRow(r, v); err != nil {
+			return nil, err
+		}
+		result = append(result, v)
+	}
+	return result, nil
+}
+
+func scanRow(r row, v any) error {
+	for name, field := range dbFields(reflect.ValueOf(v)) {
+		field.SetZero()
+		val, ok := r[name]
+		if !ok || val == nil {
+			continue
+		}
+		raw, err := json.Marshal(val)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(raw, field.Addr().Interface()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setListCursor(params *model.ListParams) error {
+	pagination := params.Pagination
+	if pagination == nil || pagination.Cursor == nil {
+		return nil
+	}
+	// The first value is the key of the ordering the cursor was made for.
+	cursor := pagination.Cursor
+	if len(cursor) != len(params.OrderBys)+1 || cursor[0] != params.OrderBys.CursorKey() {
+		return model.GenericError("pagination cursor does not match order by")
+	}
+	params.Conditions = []any{
+		model.DBLogicalAND{},
+		params.Conditions,
+		model.DBCursor{OrderBys: params.OrderBys, Values: cursor[1:]},
+	}
+	return nil
+}
+
+// listNextCursor is the cursor of the page after result, nil when it is the
+// last page.
+func listNextCursor[T any](params model.ListParams, result []*T) []any {
+	pagination := params.Pagination
+	if pagination == nil || pagination.Limit < 1 || len(result) < pagination.Limit {
+		return nil
+	}
+	fields := dbFields(reflect.ValueOf(result[len(result)-1]))
+	cursor := []any{params.OrderBys.CursorKey()}
+	for _, ob := range params.OrderBys {
+		name, ok := ob.Field.(string)
+		if !ok {
+			return nil
+		}
+		field, ok := fields[name]
+		if !ok {
+			return nil
+		}
+		if field.Kind() == reflect.Pointer {
+			if field.IsNil() {
+				cursor = append(cursor, nil)
+				continue
+			}
+			field = field.Elem()
+		}
+		cursor = append(cursor, field.Interface())
+	}
+	return cursor
+}
+
+func dbFields(v reflect.Value) map[string]reflect.Value {
+	v = reflect.Indirect(v)
+	fields := map[string]reflect.Value{}
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		name := field.Tag.Get("db")
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = dbscan.SnakeCaseMapper(field.Name)
+		}
+		fields[name] = v.Field(i)
+	}
+	return fields
+}
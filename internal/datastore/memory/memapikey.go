@@ -0,0 +1,51 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+func (m *Memory) AddAPIKey(ctx context.Context, data model.AddAPIKey, v *model.APIKey) error {
+	return m.GenericAdd(ctx, model.DBAPIKey, map[string]any{
+		model.DBAPIKeyName:        data.Name,
+		model.DBAPIKeyPrefix:      data.Prefix,
+		model.DBAPIKeyHash:        data.Hash,
+		model.DBAPIKeyPermissions: data.Permissions,
+		model.DBAPIKeyExpiresAt:   data.ExpiresAt,
+	}, nil, v)
+}
+
+func (m *Memory) GetAPIKey(ctx context.Context, conds any) (*model.APIKey, error) {
+	var result model.APIKey
+	if err := m.GenericGet(ctx, model.DBAPIKey, conds, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (m *Memory) TouchAPIKey(ctx context.Context, conds any, at time.Time) error {
+	return m.GenericUpdate(ctx, model.DBAPIKey, map[string]any{
+		model.DBAPIKeyLastUsedAt: at,
+	}, conds, nil, nil)
+}
+
+func (m *Memory) DeleteAPIKey(ctx context.Context, conds any, v *model.APIKey) error {
+	return m.GenericDelete(ctx, model.DBAPIKey, conds, nil, v)
+}
+
+func (m *Memory) ListAPIKey(ctx context.Context, params model.ListParams) ([]*model.APIKey, error) {
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericCreatedAt, Sort: "desc"})
+	}
+	params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID, Sort: "desc"})
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.APIKeyPaginationDef}
+	}
+	return GenericList[model.APIKey](ctx, m, model.DBAPIKey, params, nil)
+}
+
+func (m *Memory) CountAPIKey(ctx context.Context, conds any) (int, error) {
+	return m.GenericCount(ctx, model.DBAPIKey, conds)
+}
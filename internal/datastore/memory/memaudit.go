@@ -0,0 +1,33 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+func (m *Memory) AddAudit(ctx context.Context, data model.AddAudit, v *model.Audit) error {
+	return m.GenericAdd(ctx, model.DBAudit, map[string]any{
+		model.DBAuditActor:      data.Actor,
+		model.DBAuditAction:     data.Action,
+		model.DBAuditEntity:     data.Entity,
+		model.DBAuditEntityKey:  data.Key,
+		model.DBAuditDataBefore: data.Before,
+		model.DBAuditDataAfter:  data.After,
+	}, nil, v)
+}
+
+func (m *Memory) ListAudit(ctx context.Context, params model.ListParams) ([]*model.Audit, error) {
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericCreatedAt, Sort: "desc"})
+	}
+	params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID, Sort: "desc"})
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.AuditPaginationDef}
+	}
+	return GenericList[model.Audit](ctx, m, model.DBAudit, params, nil)
+}
+
+func (m *Memory) CountAudit(ctx context.Context, conds any) (int, error) {
+	return m.GenericCount(ctx, model.DBAudit, conds)
+}
@@ -0,0 +1,294 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+	"github.com/mahmudindes/orenocomic-donoengine/internal/utila"
+)
+
+func (m *Memory) AddCategoryType(ctx context.Context, data model.AddCategoryType, v *model.CategoryType) error {
+	return m.GenericAdd(ctx, model.DBCategoryType, map[string]any{
+		model.DBCategoryTypeCode: data.Code,
+		model.DBCategoryTypeName: data.Name,
+	}, nil, v)
+}
+
+func (m *Memory) GetCategoryType(ctx context.Context, conds any) (*model.CategoryType, error) {
+	var result model.CategoryType
+	if err := m.GenericGet(ctx, model.DBCategoryType, conds, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (m *Memory) UpdateCategoryType(ctx context.Context, data model.SetCategoryType, conds any, v *model.CategoryType) error {
+	data0 := map[string]any{}
+	if data.Code != nil {
+		data0[model.DBCategoryTypeCode] = data.Code
+	}
+	if data.Name != nil {
+		data0[model.DBCategoryTypeName] = data.Name
+	}
+	return m.GenericUpdate(ctx, model.DBCategoryType, data0, conds, nil, v)
+}
+
+func (m *Memory) DeleteCategoryType(ctx context.Context, conds any, v *model.CategoryType) error {
+	return m.GenericDelete(ctx, model.DBCategoryType, conds, nil, v)
+}
+
+func (m *Memory) ListCategoryType(ctx context.Context, params model.ListParams) ([]*model.CategoryType, error) {
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBCategoryTypeCode})
+	}
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.CategoryTypePaginationDef}
+	}
+	return GenericList[model.CategoryType](ctx, m, model.DBCategoryType, params, nil)
+}
+
+func (m *Memory) CountCategoryType(ctx context.Context, conds any) (int, error) {
+	return m.GenericCount(ctx, model.DBCategoryType, conds)
+}
+
+func (m *Memory) AddCategory(ctx context.Context, data model.AddCategory, v *model.Category) error {
+	var typeID any
+	switch {
+	case data.TypeID != nil:
+		typeID = data.TypeID
+	case data.TypeCode != nil:
+		typeID = model.DBCategoryTypeCodeToID(*data.TypeCode)
+	}
+	return m.GenericAdd(ctx, model.DBCategory, map[string]any{
+		model.DBCategoryTypeID: typeID,
+		model.DBCategoryCode:   data.Code,
+		model.DBCategoryName:   data.Name,
+	}, categoryView, v)
+}
+
+func (m *Memory) GetCategory(ctx context.Context, conds any) (*model.Category, error) {
+	var result model.Category
+	if err := m.GenericGet(ctx, model.DBCategory, conds, categoryView, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (m *Memory) UpdateCategory(ctx context.Context, data model.SetCategory, conds any, v *model.Category) error {
+	data0 := map[string]any{}
+	switch {
+	case data.TypeID != nil:
+		data0[model.DBCategoryTypeID] = data.TypeID
+	case data.TypeCode != nil:
+		data0[model.DBCategoryTypeID] = model.DBCategoryTypeCodeToID(*data.TypeCode)
+	}
+	if data.Code != nil {
+		data0[model.DBCategoryCode] = data.Code
+	}
+	if data.Name != nil {
+		data0[model.DBCategoryName] = data.Name
+	}
+	return m.write(ctx, func(s *state) error {
+		types := map[any]any{}
+		for _, r := range s.find(model.DBCategory, softDeleteConds(conds), nil) {
+			types[r[model.DBGenericID]] = r[model.DBCategoryTypeID]
+		}
+		rows, err := s.update(model.DBCategory, data0, softDeleteConds(conds))
+		if err != nil {
+			return err
+		}
+		// Relations only make sense within one type.
+		if data0[model.DBCategoryTypeID] != nil {
+			for _, r := range rows {
+				if c, ok := compare(types[r[model.DBGenericID]], r[model.DBCategoryTypeID]); ok && c == 0 {
+					continue
+				}
+				if _, err := s.delete(model.DBCategoryRelation, model.DBConditionalKV{
+					Key:   model.DBCategoryRelationParentID,
+					Value: r[model.DBGenericID],
+				}); err != nil {
+					return err
+				}
+			}
+		}
+		if utila.NilData(v) {
+			return nil
+		}
+		return scanOne(s.view(rows, categoryView), v)
+	})
+}
+
+func (m *Memory) DeleteCategory(ctx context.Context, conds any, v *model.Category) error {
+	return m.GenericDelete(ctx, model.DBCategory, conds, categoryView, v)
+}
+
+func (m *Memory) RestoreCategory(ctx context.Context, conds any, v *model.Category) error {
+	return m.GenericRestore(ctx, model.DBCategory, conds, categoryView, v)
+}
+
+func (m *Memory) ListCategory(ctx context.Context, params model.ListParams) ([]*model.Category, []any, error) {
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBCategoryCode})
+	}
+	params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID})
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.CategoryPaginationDef}
+	}
+	if err := setListCursor(&params); err != nil {
+		return nil, nil, err
+	}
+	result, err := GenericList[model.Category](ctx, m, model.DBCategory, params, categoryView)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, listNextCursor(params, result), nil
+}
+
+func (m *Memory) CountCategory(ctx context.Context, conds any) (int, error) {
+	return m.GenericCount(ctx, model.DBCategory, conds)
+}
+
+var categoryView = joinView(model.DBCategoryType, model.DBCategoryTypeID, map[string]string{
+	model.DBCategoryTypeCode: "type_code",
+}, false)
+
+func (m *Memory) AddCategoryRelation(ctx context.Context, data model.AddCategoryRelation, v *model.CategoryRelation) error {
+	var parentID any
+	switch {
+	case data.ParentID != nil:
+		parentID = data.ParentID
+	case data.ParentCode != nil:
+		parentID = model.DBCategorySIDToID(model.CategorySID{
+			TypeID:   data.TypeID,
+			TypeCode: data.TypeCode,
+			Code:     *data.ParentCode,
+		})
+	}
+	var childID any
+	switch {
+	case data.ChildID != nil:
+		childID = data.ChildID
+	case data.ChildCode != nil:
+		childID = model.DBCategorySIDToID(model.CategorySID{
+			TypeID:   data.TypeID,
+			TypeCode: data.TypeCode,
+			Code:     *data.ChildCode,
+		})
+	}
+	return m.write(ctx, func(s *state) error {
+		r, err := s.insert(model.DBCategoryRelation, map[string]any{
+			model.DBCategoryRelationParentID: parentID,
+			model.DBCategoryRelationChildID:  childID,
+		})
+		if err != nil {
+			return err
+		}
+		if s.relationLoop(model.DBCategoryRelation, nil, r) {
+			return model.GenericError("category relation loop detected")
+		}
+		if utila.NilData(v) {
+			return nil
+		}
+		return scanOne(s.view([]row{r}, categoryRelationView), v)
+	})
+}
+
+func (m *Memory) GetCategoryRelation(ctx context.Context, conds any) (*model.CategoryRelation, error) {
+	var result model.CategoryRelation
+	if err := m.GenericGet(ctx, model.DBCategoryRelation, conds, categoryRelationActiveView, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (m *Memory) UpdateCategoryRelation(ctx context.Context, data model.SetCategoryRelation, conds any, v *model.CategoryRelation) error {
+	data0 := map[string]any{}
+	switch {
+	case data.ParentID != nil:
+		data0[model.DBCategoryRelationParentID] = data.ParentID
+	case data.ParentCode != nil:
+		data0[model.DBCategoryRelationParentID] = model.DBCategorySIDToID(model.CategorySID{
+			TypeID:   data.TypeID,
+			TypeCode: data.TypeCode,
+			Code:     *data.ParentCode,
+		})
+	}
+	switch {
+	case data.ChildID != nil:
+		data0[model.DBCategoryRelationChildID] = data.ChildID
+	case data.ChildCode != nil:
+		data0[model.DBCategoryRelationChildID] = model.DBCategorySIDToID(model.CategorySID{
+			TypeID:   data.TypeID,
+			TypeCode: data.TypeCode,
+			Code:     *data.ChildCode,
+		})
+	}
+	return m.write(ctx, func(s *state) error {
+		rows, err := s.update(model.DBCategoryRelation, data0, conds)
+		if err != nil {
+			return err
+		}
+		for _, r := range rows {
+			if s.relationLoop(model.DBCategoryRelation, nil, r) {
+				return model.GenericError("category relation loop detected")
+			}
+		}
+		if utila.NilData(v) {
+			return nil
+		}
+		return scanOne(s.view(rows, categoryRelationView), v)
+	})
+}
+
+func (m *Memory) DeleteCategoryRelation(ctx context.Context, conds any, v *model.CategoryRelation) error {
+	return m.GenericDelete(ctx, model.DBCategoryRelation, conds, categoryRelationView, v)
+}
+
+func (m *Memory) ListCategoryRelation(ctx context.Context, params model.ListParams) ([]*model.CategoryRelation, error) {
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBCategoryRelationChildID})
+	}
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.CategoryRelationPaginationDef}
+	}
+	return GenericList[model.CategoryRelation](ctx, m, model.DBCategoryRelation, params, categoryRelationActiveView)
+}
+
+func (m *Memory) CountCategoryRelation(ctx context.Context, conds any) (int, error) {
+	return m.GenericCount(ctx, model.DBCategoryRelation, conds)
+}
+
+var (
+	categoryRelationView = joinView(model.DBCategory, model.DBCategoryRelationChildID, map[string]string{
+		model.DBCategoryCode: "child_code",
+	}, false)
+	categoryRelationActiveView = joinView(model.DBCategory, model.DBCategoryRelationChildID, map[string]string{
+		model.DBCategoryCode: "child_code",
+	}, true)
+)
+
+// A relation loops when its child is already an ancestor of its parent.
+func (s *state) relationLoop(t string, conds any, r row) bool {
+	parentKey, childKey := model.DBCategoryRelationParentID, model.DBCategoryRelationChildID
+	edges := s.find(t, conds, nil)
+	visited := map[any]bool{}
+	queue := []any{r[parentKey]}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if visited[id] {
+			continue
+		}
+		visited[id] = true
+		for _, edge := range edges {
+			if edge[childKey] != id {
+				continue
+			}
+			if edge[parentKey] == r[childKey] {
+				return true
+			}
+			queue = append(queue, edge[parentKey])
+		}
+	}
+	return false
+}
@@ -0,0 +1,1099 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+	"github.com/mahmudindes/orenocomic-donoengine/internal/utila"
+)
+
+func (m *Memory) AddComic(ctx context.Context, data model.AddComic, v *model.Comic) error {
+	var code any
+	switch {
+	case data.Code != nil:
+		code = data.Code
+	default:
+		code = utila.RandomString(utila.RandomStringGeneral, model.ComicCodeLength)
+	}
+	var languageID any
+	switch {
+	case data.LanguageID != nil:
+		languageID = data.LanguageID
+	case data.LanguageIETF != nil:
+		languageID = model.DBLanguageIETFToID(*data.LanguageIETF)
+	}
+	return m.GenericAdd(ctx, model.DBComic, map[string]any{
+		model.DBComicCode:                 code,
+		model.DBLanguageGenericLanguageID: languageID,
+		model.DBComicPublishedFrom:        data.PublishedFrom,
+		model.DBComicPublishedTo:          data.PublishedTo,
+		model.DBComicTotalChapter:         data.TotalChapter,
+		model.DBComicTotalVolume:          data.TotalVolume,
+		model.DBComicNSFW:                 data.NSFW,
+		model.DBComicNSFL:                 data.NSFL,
+		model.DBComicAdditionals:          data.Additionals,
+	}, comicView, v)
+}
+
+func (m *Memory) GetComic(ctx context.Context, conds any) (*model.Comic, error) {
+	var result model.Comic
+	if err := m.GenericGet(ctx, model.DBComic, conds, comicView, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (m *Memory) GetComicDetail(ctx context.Context, conds any) (*model.Comic, error) {
+	var result model.Comic
+	if err := m.read(ctx, func(s *state) error {
+		if err := scanOne(s.find(model.DBComic, softDeleteConds(conds), comicView), &result); err != nil {
+			return err
+		}
+		comicID := model.DBConditionalKV{Key: model.DBComicGenericComicID, Value: result.ID}
+		idObs := func(field string) model.OrderBys {
+			return model.OrderBys{{Field: field}, {Field: model.DBGenericID}}
+		}
+		var err error
+		if result.Titles, err = detailRows[model.ComicTitle](s,
+			model.DBComicTitle, comicID, comicTitleView, idObs(model.DBComicGenericRID),
+		); err != nil {
+			return err
+		}
+		if result.Covers, err = detailRows[model.ComicCover](s,
+			model.DBComicCover, comicID, comicCoverView, idObs(model.DBComicGenericRID),
+		); err != nil {
+			return err
+		}
+		if result.Synopses, err = detailRows[model.ComicSynopsis](s,
+			model.DBComicSynopsis, comicID, comicSynopsisView, idObs(model.DBComicGenericRID),
+		); err != nil {
+			return err
+		}
+		if result.Chapters, err = detailRows[model.ComicChapter](s,
+			model.DBComicChapter, comicID, nil, idObs(model.DBComicChapterReleasedAt),
+		); err != nil {
+			return err
+		}
+		if result.Externals, err = detailRows[model.ComicExternal](s,
+			model.DBComicExternal, comicID, comicExternalView, idObs(model.DBComicGenericRID),
+		); err != nil {
+			return err
+		}
+		categoryIDs := []any{}
+		for _, r := range s.find(model.DBComicCategory, comicID, nil) {
+			categoryIDs = append(categoryIDs, r[model.DBCategoryGenericCategoryID])
+		}
+		if result.Categories, err = detailRows[model.Category](s, model.DBCategory, softDeleteConds(
+			model.DBConditionalKV{Key: model.DBGenericID, Value: model.DBIn{Value: categoryIDs}},
+		), categoryView, idObs(model.DBCategoryCode)); err != nil {
+			return err
+		}
+		tagIDs := []any{}
+		for _, r := range s.find(model.DBComicTag, comicID, nil) {
+			tagIDs = append(tagIDs, r[model.DBTagGenericTagID])
+		}
+		if result.Tags, err = detailRows[model.Tag](s, model.DBTag, softDeleteConds(
+			model.DBConditionalKV{Key: model.DBGenericID, Value: model.DBIn{Value: tagIDs}},
+		), tagView, idObs(model.DBTagCode)); err != nil {
+			return err
+		}
+		if result.Relations, err = detailRows[model.ComicRelation](s, model.DBComicRelation,
+			model.DBConditionalKV{Key: model.DBComicRelationParentID, Value: result.ID},
+			comicRelationActiveView, model.OrderBys{{Field: model.DBComicRelationChildID}},
+		); err != nil {
+			return err
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func detailRows[T any](s *state, t string, conds any, vw view, obs model.OrderBys) ([]*T, error) {
+	rows := s.find(t, conds, vw)
+	s.sort(rows, obs)
+	return scanAll[T](rows)
+}
+
+func (m *Memory) UpdateComic(ctx context.Context, data model.SetComic, conds any, v *model.Comic) error {
+	data0 := map[string]any{}
+	if data.Code != nil {
+		data0[model.DBComicCode] = data.Code
+	}
+	switch {
+	case data.LanguageID != nil:
+		data0[model.DBLanguageGenericLanguageID] = data.LanguageID
+	case data.LanguageIETF != nil:
+		data0[model.DBLanguageGenericLanguageID] = model.DBLanguageIETFToID(*data.LanguageIETF)
+	}
+	if data.PublishedFrom != nil {
+		data0[model.DBComicPublishedFrom] = data.PublishedFrom
+	}
+	if data.PublishedTo != nil {
+		data0[model.DBComicPublishedTo] = data.PublishedTo
+	}
+	if data.TotalChapter != nil {
+		data0[model.DBComicTotalChapter] = data.TotalChapter
+	}
+	if data.TotalVolume != nil {
+		data0[model.DBComicTotalVolume] = data.TotalVolume
+	}
+	if data.NSFW != nil {
+		data0[model.DBComicNSFW] = data.NSFW
+	}
+	if data.NSFL != nil {
+		data0[model.DBComicNSFL] = data.NSFL
+	}
+	if data.Additionals != nil {
+		data0[model.DBComicAdditionals] = model.DBJSONMerge{Value: data.Additionals}
+	}
+	for _, null := range data.SetNull {
+		data0[null] = nil
+	}
+	return m.GenericUpdate(ctx, model.DBComic, data0, conds, comicView, v)
+}
+
+func (m *Memory) DeleteComic(ctx context.Context, conds any, v *model.Comic) error {
+	return m.GenericDelete(ctx, model.DBComic, conds, comicView, v)
+}
+
+func (m *Memory) RestoreComic(ctx context.Context, conds any, v *model.Comic) error {
+	return m.GenericRestore(ctx, model.DBComic, conds, comicView, v)
+}
+
+func (m *Memory) ListComic(ctx context.Context, params model.ListParams) ([]*model.Comic, []any, error) {
+	var result []*model.Comic
+	ccnd := comicCrossConditionals(params.Conditions)
+	_, search := ccnd["ct"]
+	if len(params.OrderBys) < 1 {
+		if search {
+			params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBComicSearchRank, Sort: "desc"})
+		}
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBComicCode})
+	}
+	params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID})
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.ComicPaginationDef}
+	}
+	if err := setListCursor(&params); err != nil {
+		return nil, nil, err
+	}
+	if err := m.read(ctx, func(s *state) error {
+		rows := []row{}
+		for _, r := range s.comicCross(ccnd) {
+			if s.match(r, params.Conditions) {
+				rows = append(rows, r)
+			}
+		}
+		s.sort(rows, params.OrderBys)
+		var err error
+		result, err = scanAll[model.Comic](paginate(rows, *params.Pagination))
+		return err
+	}); err != nil {
+		return nil, nil, err
+	}
+	return result, listNextCursor(params, result), nil
+}
+
+func (m *Memory) CountComic(ctx context.Context, conds any) (int, error) {
+	var dst int
+	ccnd := comicCrossConditionals(conds)
+	if err := m.read(ctx, func(s *state) error {
+		for _, r := range s.comicCross(ccnd) {
+			if s.match(r, conds) {
+				dst++
+			}
+		}
+		return nil
+	}); err != nil {
+		return -1, err
+	}
+	return dst, nil
+}
+
+func (m *Memory) ExistsComic(ctx context.Context, conds any) (bool, error) {
+	return m.GenericExists(ctx, model.DBComic, conds)
+}
+
+var comicView view = func(s *state, r row) (row, bool) {
+	r, _ = s.join(r, model.DBLanguage, model.DBLanguageGenericLanguageID, map[string]string{
+		model.DBLanguageIETF: model.DBLanguageGenericLanguageIETF,
+	})
+	return r, true
+}
+
+func comicCrossConditionals(conds any) map[string]model.DBCrossConditional {
+	ccnd := map[string]model.DBCrossConditional{}
+	add := func(cond model.DBCrossConditional) {
+		key := ""
+		switch cond.Table {
+		case model.DBComicTitle:
+			key = "ct"
+		case model.DBComicExternal:
+			key = "ce"
+		case model.DBComicCategory:
+			key = "cc"
+		case model.DBComicTag:
+			key = "cg"
+		default:
+			return
+		}
+		if cond.Exclude {
+			key = "x" + key
+		}
+		ccnd[key] = cond
+	}
+	switch cond := conds.(type) {
+	case model.DBCrossConditional:
+		add(cond)
+	case []any:
+		for _, cond := range cond {
+			switch cond := cond.(type) {
+			case model.DBCrossConditional:
+				add(cond)
+			}
+		}
+	}
+	return ccnd
+}
+
+// Every cross conditional narrows the comics down to those with (or without)
+// matching rows in the child table, title searches also carry a search rank.
+func (s *state) comicCross(ccnd map[string]model.DBCrossConditional) []row {
+	sets := map[string]map[any]float64{}
+	for key, val := range ccnd {
+		set := map[any]float64{}
+		switch val.Table {
+		case model.DBComicTitle:
+			for _, r := range s.find(model.DBComicTitle, val.Conditions, comicTitleView) {
+				id := r[model.DBComicGenericComicID]
+				set[id] = max(set[id], s.textSearchRank(r, val.Conditions))
+			}
+		case model.DBComicExternal:
+			for _, r := range s.find(model.DBComicExternal, val.Conditions, comicExternalView) {
+				set[r[model.DBComicGenericComicID]] = 0
+			}
+		case model.DBComicCategory:
+			set = s.comicCrossLink(model.DBComicCategory, model.DBCategoryGenericCategoryID, val, comicCategoryActiveView)
+		case model.DBComicTag:
+			set = s.comicCrossLink(model.DBComicTag, model.DBTagGenericTagID, val, comicTagActiveView)
+		}
+		sets[key] = set
+	}
+	result := []row{}
+	for _, r := range s.find(model.DBComic, softDeleteConds(nil), comicView) {
+		matched := true
+		for key, val := range ccnd {
+			rank, ok := sets[key][r[model.DBGenericID]]
+			if ok == val.Exclude {
+				matched = false
+				break
+			}
+			if key == "ct" {
+				r[model.DBComicSearchRank] = rank
+			}
+		}
+		if matched {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+func (s *state) comicCrossLink(t, column string, val model.DBCrossConditional, vw view) map[any]float64 {
+	links := map[any]map[any]bool{}
+	for _, r := range s.find(t, val.Conditions, vw) {
+		id := r[model.DBComicGenericComicID]
+		if links[id] == nil {
+			links[id] = map[any]bool{}
+		}
+		links[id][r[column]] = true
+	}
+	set := map[any]float64{}
+	for id, link := range links {
+		if val.HavingCount > 0 && len(link) < val.HavingCount {
+			continue
+		}
+		set[id] = 0
+	}
+	return set
+}
+
+func (m *Memory) AddComicTitle(ctx context.Context, data model.AddComicTitle, v *model.ComicTitle) error {
+	var comicID any
+	switch {
+	case data.ComicID != nil:
+		comicID = data.ComicID
+	case data.ComicCode != nil:
+		comicID = model.DBComicCodeToID(*data.ComicCode)
+	}
+	var rid any
+	switch {
+	case data.RID != nil:
+		rid = data.RID
+	default:
+		rid = utila.RandomString(utila.RandomStringGeneral, model.ComicGenericRIDLength)
+	}
+	var languageID any
+	switch {
+	case data.LanguageID != nil:
+		languageID = data.LanguageID
+	case data.LanguageIETF != nil:
+		languageID = model.DBLanguageIETFToID(*data.LanguageIETF)
+	}
+	return m.GenericAdd(ctx, model.DBComicTitle, map[string]any{
+		model.DBComicGenericComicID:       comicID,
+		model.DBComicGenericRID:           rid,
+		model.DBLanguageGenericLanguageID: languageID,
+		model.DBComicTitleTitle:           data.Title,
+		model.DBComicTitleSynonym:         data.Synonym,
+		model.DBComicTitleRomanized:       data.Romanized,
+	}, comicTitleView, v)
+}
+
+func (m *Memory) GetComicTitle(ctx context.Context, conds any) (*model.ComicTitle, error) {
+	var result model.ComicTitle
+	if err := m.GenericGet(ctx, model.DBComicTitle, conds, comicTitleView, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (m *Memory) UpdateComicTitle(ctx context.Context, data model.SetComicTitle, conds any, v *model.ComicTitle) error {
+	data0 := map[string]any{}
+	switch {
+	case data.ComicID != nil:
+		data0[model.DBComicGenericComicID] = data.ComicID
+	case data.ComicCode != nil:
+		data0[model.DBComicGenericComicID] = model.DBComicCodeToID(*data.ComicCode)
+	}
+	if data.RID != nil {
+		data0[model.DBComicGenericRID] = data.RID
+	}
+	switch {
+	case data.LanguageID != nil:
+		data0[model.DBLanguageGenericLanguageID] = data.LanguageID
+	case data.LanguageIETF != nil:
+		data0[model.DBLanguageGenericLanguageID] = model.DBLanguageIETFToID(*data.LanguageIETF)
+	}
+	if data.Title != nil {
+		data0[model.DBComicTitleTitle] = data.Title
+	}
+	if data.Synonym != nil {
+		data0[model.DBComicTitleSynonym] = data.Synonym
+	}
+	if data.Romanized != nil {
+		data0[model.DBComicTitleRomanized] = data.Romanized
+	}
+	for _, null := range data.SetNull {
+		data0[null] = nil
+	}
+	return m.GenericUpdate(ctx, model.DBComicTitle, data0, conds, comicTitleView, v)
+}
+
+func (m *Memory) DeleteComicTitle(ctx context.Context, conds any, v *model.ComicTitle) error {
+	return m.GenericDelete(ctx, model.DBComicTitle, conds, comicTitleView, v)
+}
+
+func (m *Memory) ListComicTitle(ctx context.Context, params model.ListParams) ([]*model.ComicTitle, error) {
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBComicGenericRID})
+	}
+	params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID})
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.ComicTitlePaginationDef}
+	}
+	return GenericList[model.ComicTitle](ctx, m, model.DBComicTitle, params, comicTitleView)
+}
+
+func (m *Memory) CountComicTitle(ctx context.Context, conds any) (int, error) {
+	return m.GenericCount(ctx, model.DBComicTitle, conds)
+}
+
+var comicTitleView = joinView(model.DBLanguage, model.DBLanguageGenericLanguageID, map[string]string{
+	model.DBLanguageIETF: model.DBLanguageGenericLanguageIETF,
+}, false)
+
+func (m *Memory) AddComicCover(ctx context.Context, data model.AddComicCover, v *model.ComicCover) error {
+	var comicID any
+	switch {
+	case data.ComicID != nil:
+		comicID = data.ComicID
+	case data.ComicCode != nil:
+		comicID = model.DBComicCodeToID(*data.ComicCode)
+	}
+	var rid any
+	switch {
+	case data.RID != nil:
+		rid = data.RID
+	default:
+		rid = utila.RandomString(utila.RandomStringGeneral, model.ComicGenericRIDLength)
+	}
+	var websiteID any
+	switch {
+	case data.WebsiteID != nil:
+		websiteID = data.WebsiteID
+	case data.WebsiteDomain != nil:
+		websiteID = model.DBWebsiteDomainToID(*data.WebsiteDomain)
+	}
+	return m.GenericAdd(ctx, model.DBComicCover, map[string]any{
+		model.DBComicGenericComicID:     comicID,
+		model.DBComicGenericRID:         rid,
+		model.DBWebsiteGenericWebsiteID: websiteID,
+		model.DBComicCoverRelativeURL:   data.RelativeURL,
+		model.DBComicCoverPriority:      data.Priority,
+	}, comicCoverView, v)
+}
+
+func (m *Memory) GetComicCover(ctx context.Context, conds any) (*model.ComicCover, error) {
+	var result model.ComicCover
+	if err := m.GenericGet(ctx, model.DBComicCover, conds, comicCoverView, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (m *Memory) UpdateComicCover(ctx context.Context, data model.SetComicCover, conds any, v *model.ComicCover) error {
+	data0 := map[string]any{}
+	switch {
+	case data.ComicID != nil:
+		data0[model.DBComicGenericComicID] = data.ComicID
+	case data.ComicCode != nil:
+		data0[model.DBComicGenericComicID] = model.DBComicCodeToID(*data.ComicCode)
+	}
+	if data.RID != nil {
+		data0[model.DBComicGenericRID] = data.RID
+	}
+	switch {
+	case data.WebsiteID != nil:
+		data0[model.DBWebsiteGenericWebsiteID] = data.WebsiteID
+	case data.WebsiteDomain != nil:
+		data0[model.DBWebsiteGenericWebsiteID] = model.DBWebsiteDomainToID(*data.WebsiteDomain)
+	}
+	if data.RelativeURL != nil {
+		data0[model.DBComicCoverRelativeURL] = data.RelativeURL
+	}
+	if data.Priority != nil {
+		data0[model.DBComicCoverPriority] = data.Priority
+	}
+	for _, null := range data.SetNull {
+		data0[null] = nil
+	}
+	return m.GenericUpdate(ctx, model.DBComicCover, data0, conds, comicCoverView, v)
+}
+
+func (m *Memory) DeleteComicCover(ctx context.Context, conds any, v *model.ComicCover) error {
+	return m.GenericDelete(ctx, model.DBComicCover, conds, comicCoverView, v)
+}
+
+func (m *Memory) ListComicCover(ctx context.Context, params model.ListParams) ([]*model.ComicCover, error) {
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBComicGenericRID})
+	}
+	params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID})
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.ComicCoverPaginationDef}
+	}
+	return GenericList[model.ComicCover](ctx, m, model.DBComicCover, params, comicCoverView)
+}
+
+func (m *Memory) CountComicCover(ctx context.Context, conds any) (int, error) {
+	return m.GenericCount(ctx, model.DBComicCover, conds)
+}
+
+var comicCoverView = joinView(model.DBWebsite, model.DBWebsiteGenericWebsiteID, map[string]string{
+	model.DBWebsiteDomain: model.DBWebsiteGenericWebsiteDomain,
+}, false)
+
+func (m *Memory) AddComicSynopsis(ctx context.Context, data model.AddComicSynopsis, v *model.ComicSynopsis) error {
+	var comicID any
+	switch {
+	case data.ComicID != nil:
+		comicID = data.ComicID
+	case data.ComicCode != nil:
+		comicID = model.DBComicCodeToID(*data.ComicCode)
+	}
+	var rid any
+	switch {
+	case data.RID != nil:
+		rid = data.RID
+	default:
+		rid = utila.RandomString(utila.RandomStringGeneral, model.ComicGenericRIDLength)
+	}
+	var languageID any
+	switch {
+	case data.LanguageID != nil:
+		languageID = data.LanguageID
+	case data.LanguageIETF != nil:
+		languageID = model.DBLanguageIETFToID(*data.LanguageIETF)
+	}
+	return m.GenericAdd(ctx, model.DBComicSynopsis, map[string]any{
+		model.DBComicGenericComicID:       comicID,
+		model.DBComicGenericRID:           rid,
+		model.DBLanguageGenericLanguageID: languageID,
+		model.DBComicSynopsisSynopsis:     data.Synopsis,
+		model.DBComicSynopsisVersion:      data.Version,
+		model.DBComicSynopsisRomanized:    data.Romanized,
+	}, comicSynopsisView, v)
+}
+
+func (m *Memory) GetComicSynopsis(ctx context.Context, conds any) (*model.ComicSynopsis, error) {
+	var result model.ComicSynopsis
+	if err := m.GenericGet(ctx, model.DBComicSynopsis, conds, comicSynopsisView, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (m *Memory) UpdateComicSynopsis(ctx context.Context, data model.SetComicSynopsis, conds any, v *model.ComicSynopsis) error {
+	data0 := map[string]any{}
+	switch {
+	case data.ComicID != nil:
+		data0[model.DBComicGenericComicID] = data.ComicID
+	case data.ComicCode != nil:
+		data0[model.DBComicGenericComicID] = model.DBComicCodeToID(*data.ComicCode)
+	}
+	if data.RID != nil {
+		data0[model.DBComicGenericRID] = data.RID
+	}
+	switch {
+	case data.LanguageID != nil:
+		data0[model.DBLanguageGenericLanguageID] = data.LanguageID
+	case data.LanguageIETF != nil:
+		data0[model.DBLanguageGenericLanguageID] = model.DBLanguageIETFToID(*data.LanguageIETF)
+	}
+	if data.Synopsis != nil {
+		data0[model.DBComicSynopsisSynopsis] = data.Synopsis
+	}
+	if data.Version != nil {
+		data0[model.DBComicSynopsisVersion] = data.Version
+	}
+	if data.Romanized != nil {
+		data0[model.DBComicSynopsisRomanized] = data.Romanized
+	}
+	for _, null := range data.SetNull {
+		data0[null] = nil
+	}
+	return m.GenericUpdate(ctx, model.DBComicSynopsis, data0, conds, comicSynopsisView, v)
+}
+
+func (m *Memory) DeleteComicSynopsis(ctx context.Context, conds any, v *model.ComicSynopsis) error {
+	return m.GenericDelete(ctx, model.DBComicSynopsis, conds, comicSynopsisView, v)
+}
+
+func (m *Memory) ListComicSynopsis(ctx context.Context, params model.ListParams) ([]*model.ComicSynopsis, error) {
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBComicGenericRID})
+	}
+	params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID})
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.ComicSynopsisPaginationDef}
+	}
+	return GenericList[model.ComicSynopsis](ctx, m, model.DBComicSynopsis, params, comicSynopsisView)
+}
+
+func (m *Memory) CountComicSynopsis(ctx context.Context, conds any) (int, error) {
+	return m.GenericCount(ctx, model.DBComicSynopsis, conds)
+}
+
+var comicSynopsisView = joinView(model.DBLanguage, model.DBLanguageGenericLanguageID, map[string]string{
+	model.DBLanguageIETF: model.DBLanguageGenericLanguageIETF,
+}, false)
+
+func (m *Memory) AddComicExternal(ctx context.Context, data model.AddComicExternal, v *model.ComicExternal) error {
+	var comicID any
+	switch {
+	case data.ComicID != nil:
+		comicID = data.ComicID
+	case data.ComicCode != nil:
+		comicID = model.DBComicCodeToID(*data.ComicCode)
+	}
+	var rid any
+	switch {
+	case data.RID != nil:
+		rid = data.RID
+	default:
+		rid = utila.RandomString(utila.RandomStringGeneral, model.ComicGenericRIDLength)
+	}
+	var websiteID any
+	switch {
+	case data.WebsiteID != nil:
+		websiteID = data.WebsiteID
+	case data.WebsiteDomain != nil:
+		websiteID = model.DBWebsiteDomainToID(*data.WebsiteDomain)
+	}
+	return m.GenericAdd(ctx, model.DBComicExternal, map[string]any{
+		model.DBComicGenericComicID:      comicID,
+		model.DBComicGenericRID:          rid,
+		model.DBWebsiteGenericWebsiteID:  websiteID,
+		model.DBComicExternalRelativeURL: data.RelativeURL,
+		model.DBComicExternalOfficial:    data.Official,
+	}, comicExternalView, v)
+}
+
+func (m *Memory) GetComicExternal(ctx context.Context, conds any) (*model.ComicExternal, error) {
+	var result model.ComicExternal
+	if err := m.GenericGet(ctx, model.DBComicExternal, conds, comicExternalView, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (m *Memory) UpdateComicExternal(ctx context.Context, data model.SetComicExternal, conds any, v *model.ComicExternal) error {
+	data0 := map[string]any{}
+	switch {
+	case data.ComicID != nil:
+		data0[model.DBComicGenericComicID] = data.ComicID
+	case data.ComicCode != nil:
+		data0[model.DBComicGenericComicID] = model.DBComicCodeToID(*data.ComicCode)
+	}
+	if data.RID != nil {
+		data0[model.DBComicGenericRID] = data.RID
+	}
+	switch {
+	case data.WebsiteID != nil:
+		data0[model.DBWebsiteGenericWebsiteID] = data.WebsiteID
+	case data.WebsiteDomain != nil:
+		data0[model.DBWebsiteGenericWebsiteID] = model.DBWebsiteDomainToID(*data.WebsiteDomain)
+	}
+	if data.RelativeURL != nil {
+		data0[model.DBComicExternalRelativeURL] = data.RelativeURL
+	}
+	if data.Official != nil {
+		data0[model.DBComicExternalOfficial] = data.Official
+	}
+	for _, null := range data.SetNull {
+		data0[null] = nil
+	}
+	return m.GenericUpdate(ctx, model.DBComicExternal, data0, conds, comicExternalView, v)
+}
+
+func (m *Memory) DeleteComicExternal(ctx context.Context, conds any, v *model.ComicExternal) error {
+	return m.GenericDelete(ctx, model.DBComicExternal, conds, comicExternalView, v)
+}
+
+func (m *Memory) ListComicExternal(ctx context.Context, params model.ListParams) ([]*model.ComicExternal, error) {
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBComicGenericRID})
+	}
+	params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID})
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.ComicExternalPaginationDef}
+	}
+	return GenericList[model.ComicExternal](ctx, m, model.DBComicExternal, params, comicExternalView)
+}
+
+func (m *Memory) CountComicExternal(ctx context.Context, conds any) (int, error) {
+	return m.GenericCount(ctx, model.DBComicExternal, conds)
+}
+
+var comicExternalView = joinView(model.DBWebsite, model.DBWebsiteGenericWebsiteID, map[string]string{
+	model.DBWebsiteDomain: model.DBWebsiteGenericWebsiteDomain,
+}, false)
+
+func (m *Memory) AddComicCategory(ctx context.Context, data model.AddComicCategory, v *model.ComicCategory) error {
+	var comicID any
+	switch {
+	case data.ComicID != nil:
+		comicID = data.ComicID
+	case data.ComicCode != nil:
+		comicID = model.DBComicCodeToID(*data.ComicCode)
+	}
+	var categoryID any
+	switch {
+	case data.CategoryID != nil:
+		categoryID = data.CategoryID
+	case data.CategoryCode != nil:
+		categoryID = model.DBCategorySIDToID(model.CategorySID{
+			TypeID:   data.CategoryTypeID,
+			TypeCode: data.CategoryTypeCode,
+			Code:     *data.CategoryCode,
+		})
+	}
+	return m.GenericAdd(ctx, model.DBComicCategory, map[string]any{
+		model.DBComicGenericComicID:       comicID,
+		model.DBCategoryGenericCategoryID: categoryID,
+	}, comicCategoryView, v)
+}
+
+func (m *Memory) GetComicCategory(ctx context.Context, conds any) (*model.ComicCategory, error) {
+	var result model.ComicCategory
+	if err := m.GenericGet(ctx, model.DBComicCategory, conds, comicCategoryView, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (m *Memory) UpdateComicCategory(ctx context.Context, data model.SetComicCategory, conds any, v *model.ComicCategory) error {
+	data0 := map[string]any{}
+	switch {
+	case data.ComicID != nil:
+		data0[model.DBComicGenericComicID] = data.ComicID
+	case data.ComicCode != nil:
+		data0[model.DBComicGenericComicID] = model.DBComicCodeToID(*data.ComicCode)
+	}
+	switch {
+	case data.CategoryID != nil:
+		data0[model.DBCategoryGenericCategoryID] = data.CategoryID
+	case data.CategoryCode != nil:
+		data0[model.DBCategoryGenericCategoryID] = model.DBCategorySIDToID(model.CategorySID{
+			TypeID:   data.CategoryTypeID,
+			TypeCode: data.CategoryTypeCode,
+			Code:     *data.CategoryCode,
+		})
+	}
+	return m.GenericUpdate(ctx, model.DBComicCategory, data0, conds, comicCategoryView, v)
+}
+
+func (m *Memory) DeleteComicCategory(ctx context.Context, conds any, v *model.ComicCategory) error {
+	return m.GenericDelete(ctx, model.DBComicCategory, conds, comicCategoryView, v)
+}
+
+func (m *Memory) ListComicCategory(ctx context.Context, params model.ListParams) ([]*model.ComicCategory, error) {
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBCategoryGenericCategoryID})
+	}
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.ComicCategoryPaginationDef}
+	}
+	return GenericList[model.ComicCategory](ctx, m, model.DBComicCategory, params, comicCategoryActiveView)
+}
+
+func (m *Memory) CountComicCategory(ctx context.Context, conds any) (int, error) {
+	return m.GenericCount(ctx, model.DBComicCategory, conds)
+}
+
+var (
+	comicCategoryView = joinView(model.DBCategory, model.DBCategoryGenericCategoryID, map[string]string{
+		model.DBCategoryTypeID: model.DBCategoryGenericCategoryTypeID,
+		model.DBCategoryCode:   model.DBCategoryGenericCategoryCode,
+	}, false)
+	comicCategoryActiveView = joinView(model.DBCategory, model.DBCategoryGenericCategoryID, map[string]string{
+		model.DBCategoryTypeID: model.DBCategoryGenericCategoryTypeID,
+		model.DBCategoryCode:   model.DBCategoryGenericCategoryCode,
+	}, true)
+)
+
+func (m *Memory) AddComicTag(ctx context.Context, data model.AddComicTag, v *model.ComicTag) error {
+	var comicID any
+	switch {
+	case data.ComicID != nil:
+		comicID = data.ComicID
+	case data.ComicCode != nil:
+		comicID = model.DBComicCodeToID(*data.ComicCode)
+	}
+	var tagID any
+	switch {
+	case data.TagID != nil:
+		tagID = data.TagID
+	case data.TagCode != nil:
+		tagID = model.DBTagSIDToID(model.TagSID{
+			TypeID:   data.TagTypeID,
+			TypeCode: data.TagTypeCode,
+			Code:     *data.TagCode,
+		})
+	}
+	return m.GenericAdd(ctx, model.DBComicTag, map[string]any{
+		model.DBComicGenericComicID: comicID,
+		model.DBTagGenericTagID:     tagID,
+	}, comicTagView, v)
+}
+
+func (m *Memory) GetComicTag(ctx context.Context, conds any) (*model.ComicTag, error) {
+	var result model.ComicTag
+	if err := m.GenericGet(ctx, model.DBComicTag, conds, comicTagView, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (m *Memory) UpdateComicTag(ctx context.Context, data model.SetComicTag, conds any, v *model.ComicTag) error {
+	data0 := map[string]any{}
+	switch {
+	case data.ComicID != nil:
+		data0[model.DBComicGenericComicID] = data.ComicID
+	case data.ComicCode != nil:
+		data0[model.DBComicGenericComicID] = model.DBComicCodeToID(*data.ComicCode)
+	}
+	switch {
+	case data.TagID != nil:
+		data0[model.DBTagGenericTagID] = data.TagID
+	case data.TagCode != nil:
+		data0[model.DBTagGenericTagID] = model.DBTagSIDToID(model.TagSID{
+			TypeID:   data.TagTypeID,
+			TypeCode: data.TagTypeCode,
+			Code:     *data.TagCode,
+		})
+	}
+	return m.GenericUpdate(ctx, model.DBComicTag, data0, conds, comicTagView, v)
+}
+
+func (m *Memory) DeleteComicTag(ctx context.Context, conds any, v *model.ComicTag) error {
+	return m.GenericDelete(ctx, model.DBComicTag, conds, comicTagView, v)
+}
+
+func (m *Memory) ListComicTag(ctx context.Context, params model.ListParams) ([]*model.ComicTag, error) {
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBTagGenericTagID})
+	}
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.ComicTagPaginationDef}
+	}
+	return GenericList[model.ComicTag](ctx, m, model.DBComicTag, params, comicTagActiveView)
+}
+
+func (m *Memory) CountComicTag(ctx context.Context, conds any) (int, error) {
+	return m.GenericCount(ctx, model.DBComicTag, conds)
+}
+
+var (
+	comicTagView = joinView(model.DBTag, model.DBTagGenericTagID, map[string]string{
+		model.DBTagTypeID: model.DBTagGenericTagTypeID,
+		model.DBTagCode:   model.DBTagGenericTagCode,
+	}, false)
+	comicTagActiveView = joinView(model.DBTag, model.DBTagGenericTagID, map[string]string{
+		model.DBTagTypeID: model.DBTagGenericTagTypeID,
+		model.DBTagCode:   model.DBTagGenericTagCode,
+	}, true)
+)
+
+func (m *Memory) AddComicRelationType(ctx context.Context, data model.AddComicRelationType, v *model.ComicRelationType) error {
+	return m.GenericAdd(ctx, model.DBComicRelationType, map[string]any{
+		model.DBComicRelationTypeCode: data.Code,
+		model.DBComicRelationTypeName: data.Name,
+	}, nil, v)
+}
+
+func (m *Memory) GetComicRelationType(ctx context.Context, conds any) (*model.ComicRelationType, error) {
+	var result model.ComicRelationType
+	if err := m.GenericGet(ctx, model.DBComicRelationType, conds, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (m *Memory) UpdateComicRelationType(ctx context.Context, data model.SetComicRelationType, conds any, v *model.ComicRelationType) error {
+	data0 := map[string]any{}
+	if data.Code != nil {
+		data0[model.DBComicRelationTypeCode] = data.Code
+	}
+	if data.Name != nil {
+		data0[model.DBComicRelationTypeName] = data.Name
+	}
+	return m.GenericUpdate(ctx, model.DBComicRelationType, data0, conds, nil, v)
+}
+
+func (m *Memory) DeleteComicRelationType(ctx context.Context, conds any, v *model.ComicRelationType) error {
+	return m.GenericDelete(ctx, model.DBComicRelationType, conds, nil, v)
+}
+
+func (m *Memory) ListComicRelationType(ctx context.Context, params model.ListParams) ([]*model.ComicRelationType, error) {
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBComicRelationTypeCode})
+	}
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.ComicRelationTypePaginationDef}
+	}
+	return GenericList[model.ComicRelationType](ctx, m, model.DBComicRelationType, params, nil)
+}
+
+func (m *Memory) CountComicRelationType(ctx context.Context, conds any) (int, error) {
+	return m.GenericCount(ctx, model.DBComicRelationType, conds)
+}
+
+func (m *Memory) AddComicRelation(ctx context.Context, data model.AddComicRelation, v *model.ComicRelation) error {
+	var typeID any
+	switch {
+	case data.TypeID != nil:
+		typeID = data.TypeID
+	case data.TypeCode != nil:
+		typeID = model.DBComicRelationTypeCodeToID(*data.TypeCode)
+	}
+	var parentID any
+	switch {
+	case data.ParentID != nil:
+		parentID = data.ParentID
+	case data.ParentCode != nil:
+		parentID = model.DBComicCodeToID(*data.ParentCode)
+	}
+	var childID any
+	switch {
+	case data.ChildID != nil:
+		childID = data.ChildID
+	case data.ChildCode != nil:
+		childID = model.DBComicCodeToID(*data.ChildCode)
+	}
+	return m.write(ctx, func(s *state) error {
+		r, err := s.insert(model.DBComicRelation, map[string]any{
+			model.DBComicRelationTypeID:   typeID,
+			model.DBComicRelationParentID: parentID,
+			model.DBComicRelationChildID:  childID,
+		})
+		if err != nil {
+			return err
+		}
+		if s.relationLoop(model.DBComicRelation, model.DBConditionalKV{
+			Key:   model.DBComicRelationTypeID,
+			Value: r[model.DBComicRelationTypeID],
+		}, r) {
+			return model.GenericError("comic relation loop detected")
+		}
+		if utila.NilData(v) {
+			return nil
+		}
+		return scanOne(s.view([]row{r}, comicRelationView), v)
+	})
+}
+
+func (m *Memory) GetComicRelation(ctx context.Context, conds any) (*model.ComicRelation, error) {
+	var result model.ComicRelation
+	if err := m.GenericGet(ctx, model.DBComicRelation, conds, comicRelationView, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (m *Memory) UpdateComicRelation(ctx context.Context, data model.SetComicRelation, conds any, v *model.ComicRelation) error {
+	data0 := map[string]any{}
+	switch {
+	case data.TypeID != nil:
+		data0[model.DBComicRelationTypeID] = data.TypeID
+	case data.TypeCode != nil:
+		data0[model.DBComicRelationTypeID] = model.DBComicRelationTypeCodeToID(*data.TypeCode)
+	}
+	switch {
+	case data.ParentID != nil:
+		data0[model.DBComicRelationParentID] = data.ParentID
+	case data.ParentCode != nil:
+		data0[model.DBComicRelationParentID] = model.DBComicCodeToID(*data.ParentCode)
+	}
+	switch {
+	case data.ChildID != nil:
+		data0[model.DBComicRelationChildID] = data.ChildID
+	case data.ChildCode != nil:
+		data0[model.DBComicRelationChildID] = model.DBComicCodeToID(*data.ChildCode)
+	}
+	return m.write(ctx, func(s *state) error {
+		rows, err := s.update(model.DBComicRelation, data0, conds)
+		if err != nil {
+			return err
+		}
+		for _, r := range rows {
+			if s.relationLoop(model.DBComicRelation, model.DBConditionalKV{
+				Key:   model.DBComicRelationTypeID,
+				Value: r[model.DBComicRelationTypeID],
+			}, r) {
+				return model.GenericError("comic relation loop detected")
+			}
+		}
+		if utila.NilData(v) {
+			return nil
+		}
+		return scanOne(s.view(rows, comicRelationView), v)
+	})
+}
+
+func (m *Memory) DeleteComicRelation(ctx context.Context, conds any, v *model.ComicRelation) error {
+	return m.GenericDelete(ctx, model.DBComicRelation, conds, comicRelationView, v)
+}
+
+func (m *Memory) ListComicRelation(ctx context.Context, params model.ListParams) ([]*model.ComicRelation, error) {
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBComicRelationChildID})
+	}
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.ComicRelationPaginationDef}
+	}
+	return GenericList[model.ComicRelation](ctx, m, model.DBComicRelation, params, comicRelationActiveView)
+}
+
+func (m *Memory) CountComicRelation(ctx context.Context, conds any) (int, error) {
+	return m.GenericCount(ctx, model.DBComicRelation, conds)
+}
+
+var (
+	comicRelationView = joinView(model.DBComic, model.DBComicRelationChildID, map[string]string{
+		model.DBComicCode: "child_code",
+	}, false)
+	comicRelationActiveView = joinView(model.DBComic, model.DBComicRelationChildID, map[string]string{
+		model.DBComicCode: "child_code",
+	}, true)
+)
+
+func (m *Memory) AddComicChapter(ctx context.Context, data model.AddComicChapter, v *model.ComicChapter) error {
+	var comicID any
+	switch {
+	case data.ComicID != nil:
+		comicID = data.ComicID
+	case data.ComicCode != nil:
+		comicID = model.DBComicCodeToID(*data.ComicCode)
+	}
+	return m.GenericAdd(ctx, model.DBComicChapter, map[string]any{
+		model.DBComicGenericComicID:    comicID,
+		model.DBComicChapterChapter:    data.Chapter,
+		model.DBComicChapterVersion:    data.Version,
+		model.DBComicChapterVolume:     data.Volume,
+		model.DBComicChapterReleasedAt: data.ReleasedAt,
+	}, nil, v)
+}
+
+func (m *Memory) GetComicChapter(ctx context.Context, conds any) (*model.ComicChapter, error) {
+	var result model.ComicChapter
+	if err := m.GenericGet(ctx, model.DBComicChapter, conds, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (m *Memory) UpdateComicChapter(ctx context.Context, data model.SetComicChapter, conds any, v *model.ComicChapter) error {
+	data0 := map[string]any{}
+	switch {
+	case data.ComicID != nil:
+		data0[model.DBComicGenericComicID] = data.ComicID
+	case data.ComicCode != nil:
+		data0[model.DBComicGenericComicID] = model.DBComicCodeToID(*data.ComicCode)
+	}
+	if data.Chapter != nil {
+		data0[model.DBComicChapterChapter] = data.Chapter
+	}
+	if data.Version != nil {
+		data0[model.DBComicChapterVersion] = data.Version
+	}
+	if data.Volume != nil {
+		data0[model.DBComicChapterVolume] = data.Volume
+	}
+	if data.ReleasedAt != nil {
+		data0[model.DBComicChapterReleasedAt] = data.ReleasedAt
+	}
+	for _, null := range data.SetNull {
+		data0[null] = nil
+	}
+	return m.GenericUpdate(ctx, model.DBComicChapter, data0, conds, nil, v)
+}
+
+func (m *Memory) DeleteComicChapter(ctx context.Context, conds any, v *model.ComicChapter) error {
+	return m.GenericDelete(ctx, model.DBComicChapter, conds, nil, v)
+}
+
+func (m *Memory) ListComicChapter(ctx context.Context, params model.ListParams) ([]*model.ComicChapter, []any, error) {
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBComicChapterReleasedAt})
+	}
+	params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID})
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.ComicChapterPaginationDef}
+	}
+	if err := setListCursor(&params); err != nil {
+		return nil, nil, err
+	}
+	result, err := GenericList[model.ComicChapter](ctx, m, model.DBComicChapter, params, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, listNextCursor(params, result), nil
+}
+
+func (m *Memory) CountComicChapter(ctx context.Context, conds any) (int, error) {
+	return m.GenericCount(ctx, model.DBComicChapter, conds)
+}
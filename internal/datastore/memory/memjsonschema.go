@@ -0,0 +1,34 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+func (m *Memory) AddJSONSchema(ctx context.Context, data model.AddJSONSchema, v *model.JSONSchema) error {
+	return m.GenericAdd(ctx, model.DBJSONSchema, map[string]any{
+		model.DBJSONSchemaName:   data.Name,
+		model.DBJSONSchemaSchema: data.Schema,
+	}, nil, v)
+}
+
+func (m *Memory) GetJSONSchema(ctx context.Context, conds any) (*model.JSONSchema, error) {
+	var result model.JSONSchema
+	if err := m.GenericGet(ctx, model.DBJSONSchema, conds, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (m *Memory) UpdateJSONSchema(ctx context.Context, data model.SetJSONSchema, conds any, v *model.JSONSchema) error {
+	data0 := map[string]any{}
+	if data.Schema != nil {
+		data0[model.DBJSONSchemaSchema] = data.Schema
+	}
+	return m.GenericUpdate(ctx, model.DBJSONSchema, data0, conds, nil, v)
+}
+
+func (m *Memory) DeleteJSONSchema(ctx context.Context, conds any, v *model.JSONSchema) error {
+	return m.GenericDelete(ctx, model.DBJSONSchema, conds, nil, v)
+}
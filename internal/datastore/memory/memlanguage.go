@@ -0,0 +1,59 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+func (m *Memory) AddLanguage(ctx context.Context, data model.AddLanguage, v *model.Language) error {
+	return m.GenericAdd(ctx, model.DBLanguage, map[string]any{
+		model.DBLanguageIETF: data.IETF,
+		model.DBLanguageName: data.Name,
+	}, nil, v)
+}
+
+func (m *Memory) GetLanguage(ctx context.Context, conds any) (*model.Language, error) {
+	var result model.Language
+	if err := m.GenericGet(ctx, model.DBLanguage, conds, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (m *Memory) UpdateLanguage(ctx context.Context, data model.SetLanguage, conds any, v *model.Language) error {
+	data0 := map[string]any{}
+	if data.IETF != nil {
+		data0[model.DBLanguageIETF] = data.IETF
+	}
+	if data.Name != nil {
+		data0[model.DBLanguageName] = data.Name
+	}
+	return m.GenericUpdate(ctx, model.DBLanguage, data0, conds, nil, v)
+}
+
+func (m *Memory) DeleteLanguage(ctx context.Context, conds any, v *model.Language) error {
+	return m.GenericDelete(ctx, model.DBLanguage, conds, nil, v)
+}
+
+func (m *Memory) ListLanguage(ctx context.Context, params model.ListParams) ([]*model.Language, []any, error) {
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBLanguageIETF})
+	}
+	params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID})
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.LanguagePaginationDef}
+	}
+	if err := setListCursor(&params); err != nil {
+		return nil, nil, err
+	}
+	result, err := GenericList[model.Language](ctx, m, model.DBLanguage, params, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, listNextCursor(params, result), nil
+}
+
+func (m *Memory) CountLanguage(ctx context.Context, conds any) (int, error) {
+	return m.GenericCount(ctx, model.DBLanguage, conds)
+}
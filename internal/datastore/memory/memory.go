@@ -0,0 +1,174 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+const (
+	CodeErrNotNull    = "23502"
+	CodeErrForeign    = "23503"
+	CodeErrExists     = "23505"
+	CodeErrValidation = "23514"
+	CodeErrConflict   = "40001"
+)
+
+var (
+	errNoRows     = errors.New("no rows in result set")
+	errTXClosed   = errors.New("tx is closed")
+	errTXConflict = errors.New("could not serialize access due to concurrent update")
+)
+
+type (
+	// Memory keeps every table in process memory and mirrors the behaviour of
+	// the SQL database closely enough to stand in for it in tests and demos.
+	Memory struct {
+		mutex *sync.RWMutex
+		state *state
+	}
+
+	state struct {
+		version uint64
+		tables  map[string]*table
+	}
+
+	table struct {
+		rows []row
+		seq  uint
+	}
+
+	row map[string]any
+)
+
+func New() *Memory {
+	s := &state{tables: map[string]*table{}}
+	for t := range schemas {
+		s.tables[t] = &table{}
+	}
+	return &Memory{mutex: new(sync.RWMutex), state: s}
+}
+
+func (m *Memory) Close() error {
+	return nil
+}
+
+func (s *state) clone() *state {
+	tables := make(map[string]*table, len(s.tables))
+	for t, tb := range s.tables {
+		tables[t] = &table{rows: slices.Clone(tb.rows), seq: tb.seq}
+	}
+	return &state{version: s.version, tables: tables}
+}
+
+type (
+	ctxTX struct{}
+
+	transaction struct {
+		parent  *transaction
+		state   *state
+		version uint64
+		closed  bool
+	}
+)
+
+func (m *Memory) current(ctx context.Context) (*state, error) {
+	if tx, ok := ctx.Value(ctxTX{}).(*transaction); ok {
+		if tx.closed {
+			return nil, errTXClosed
+		}
+		return tx.state, nil
+	}
+	return m.state, nil
+}
+
+func (m *Memory) read(ctx context.Context, fn func(s *state) error) error {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	s, err := m.current(ctx)
+	if err != nil {
+		return err
+	}
+	return fn(s)
+}
+
+// Every write runs against a copy so a failed statement leaves nothing behind.
+func (m *Memory) write(ctx context.Context, fn func(s *state) error) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	s, err := m.current(ctx)
+	if err != nil {
+		return err
+	}
+	s0 := s.clone()
+	if err := fn(s0); err != nil {
+		return err
+	}
+	s.tables = s0.tables
+	s.version++
+	return nil
+}
+
+func (m *Memory) ContextTransactionBegin(ctx context.Context) (context.Context, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if parent, ok := ctx.Value(ctxTX{}).(*transaction); ok {
+		if parent.closed {
+			return nil, errTXClosed
+		}
+		tx := &transaction{parent: parent, state: parent.state.clone()}
+		return context.WithValue(ctx, ctxTX{}, tx), nil
+	}
+
+	tx := &transaction{state: m.state.clone(), version: m.state.version}
+	return context.WithValue(ctx, ctxTX{}, tx), nil
+}
+
+func (m *Memory) ContextTransactionCommit(ctx context.Context) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	tx, ok := ctx.Value(ctxTX{}).(*transaction)
+	if !ok {
+		return errors.New("no transaction to commit")
+	}
+	if tx.closed {
+		return errTXClosed
+	}
+	tx.closed = true
+
+	if tx.parent != nil {
+		if tx.parent.closed {
+			return errTXClosed
+		}
+		tx.parent.state = tx.state
+		return nil
+	}
+
+	if m.state.version != tx.version {
+		return model.DatabaseError{Code: CodeErrConflict, Err: errTXConflict}
+	}
+	m.state = &state{version: tx.version + 1, tables: tx.state.tables}
+	return nil
+}
+
+func (m *Memory) ContextTransactionRollback(ctx context.Context) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	tx, ok := ctx.Value(ctxTX{}).(*transaction)
+	if !ok {
+		return errors.New("no transaction to rollback")
+	}
+	if tx.closed {
+		return errTXClosed
+	}
+	tx.closed = true
+	return nil
+}
@@ -0,0 +1,232 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+func TestMemoryUnique(t *testing.T) {
+	ctx := context.Background()
+	m := New()
+	if err := m.AddLanguage(ctx, model.AddLanguage{IETF: "en", Name: "English"}, nil); err != nil {
+		t.Fatalf("AddLanguage: %v", err)
+	}
+	if err := m.AddLanguage(ctx, model.AddLanguage{IETF: "en", Name: "Other"}, nil); err == nil || err.Error() != "same ietf already exists" {
+		t.Errorf("AddLanguage duplicate: got %v", err)
+	}
+	if n, _ := m.CountLanguage(ctx, nil); n != 1 {
+		t.Errorf("failed insert left %d languages", n)
+	}
+
+	code := "comic001"
+	comic := new(model.Comic)
+	if err := m.AddComic(ctx, model.AddComic{Code: &code}, comic); err != nil {
+		t.Fatalf("AddComic: %v", err)
+	}
+	chapter := model.AddComicChapter{ComicID: &comic.ID, Chapter: "1", ReleasedAt: time.Now()}
+	if err := m.AddComicChapter(ctx, chapter, nil); err != nil {
+		t.Fatalf("AddComicChapter: %v", err)
+	}
+	if err := m.AddComicChapter(ctx, chapter, nil); err == nil || err.Error() != "same comic id + chapter + version already exists" {
+		t.Errorf("AddComicChapter without version twice: got %v", err)
+	}
+
+	conds := model.DBConditionalKV{Key: model.DBComicCode, Value: code}
+	if err := m.AddComic(ctx, model.AddComic{Code: &code}, nil); err == nil || err.Error() != "same code already exists" {
+		t.Errorf("AddComic duplicate: got %v", err)
+	}
+	if err := m.DeleteComic(ctx, conds, nil); err != nil {
+		t.Fatalf("DeleteComic: %v", err)
+	}
+	if err := m.AddComic(ctx, model.AddComic{Code: &code}, nil); err != nil {
+		t.Errorf("AddComic reusing deleted code: %v", err)
+	}
+	if err := m.RestoreComic(ctx, model.DBConditionalKV{Key: model.DBGenericID, Value: comic.ID}, nil); err == nil {
+		t.Error("RestoreComic over a live code: expected error")
+	}
+}
+
+func TestMemoryForeign(t *testing.T) {
+	ctx := context.Background()
+	m := New()
+	language, website := new(model.Language), new(model.Website)
+	if err := m.AddLanguage(ctx, model.AddLanguage{IETF: "en", Name: "English"}, language); err != nil {
+		t.Fatalf("AddLanguage: %v", err)
+	}
+	if err := m.AddWebsite(ctx, model.AddWebsite{Domain: "example.com", Name: "Example"}, website); err != nil {
+		t.Fatalf("AddWebsite: %v", err)
+	}
+	comic := new(model.Comic)
+	if err := m.AddComic(ctx, model.AddComic{LanguageID: &language.ID}, comic); err != nil {
+		t.Fatalf("AddComic: %v", err)
+	}
+	if err := m.AddComicCover(ctx, model.AddComicCover{
+		ComicID: &comic.ID, WebsiteID: &website.ID, RelativeURL: "/cover.png",
+	}, nil); err != nil {
+		t.Fatalf("AddComicCover: %v", err)
+	}
+
+	missing := uint(404)
+	if err := m.AddComic(ctx, model.AddComic{LanguageID: &missing}, nil); err == nil || err.Error() != "language does not exist" {
+		t.Errorf("AddComic unknown language: got %v", err)
+	}
+
+	var errDatabase model.DatabaseError
+	err := m.DeleteLanguage(ctx, model.DBConditionalKV{Key: model.DBGenericID, Value: language.ID}, nil)
+	if !errors.As(err, &errDatabase) || errDatabase.Code != CodeErrForeign || errDatabase.Name != "comic_language_id_fkey" {
+		t.Errorf("DeleteLanguage referenced: expected restrict got %v", err)
+	}
+	if _, err := m.GetLanguage(ctx, model.DBConditionalKV{Key: model.DBGenericID, Value: language.ID}); err != nil {
+		t.Errorf("GetLanguage after restrict: %v", err)
+	}
+
+	if err := m.DeleteWebsite(ctx, model.DBConditionalKV{Key: model.DBGenericID, Value: website.ID}, nil); err != nil {
+		t.Fatalf("DeleteWebsite: %v", err)
+	}
+	if n, err := m.CountComicCover(ctx, nil); err != nil || n != 0 {
+		t.Errorf("CountComicCover after cascade = %d, %v", n, err)
+	}
+}
+
+func TestMemorySID(t *testing.T) {
+	ctx := context.Background()
+	m := New()
+	typeCode := "genre"
+	if err := m.AddCategoryType(ctx, model.AddCategoryType{Code: typeCode, Name: "Genre"}, nil); err != nil {
+		t.Fatalf("AddCategoryType: %v", err)
+	}
+	ids := map[string]uint{}
+	for _, code := range []string{"action", "shounen"} {
+		category := new(model.Category)
+		if err := m.AddCategory(ctx, model.AddCategory{TypeCode: &typeCode, Code: code, Name: code}, category); err != nil {
+			t.Fatalf("AddCategory %s: %v", code, err)
+		}
+		ids[code] = category.ID
+	}
+
+	parent, child := "action", "shounen"
+	relation := new(model.CategoryRelation)
+	if err := m.AddCategoryRelation(ctx, model.AddCategoryRelation{
+		TypeCode: &typeCode, ParentCode: &parent, ChildCode: &child,
+	}, relation); err != nil {
+		t.Fatalf("AddCategoryRelation: %v", err)
+	}
+	if relation.ParentID != ids[parent] || relation.ChildID != ids[child] || relation.ChildCode != child {
+		t.Errorf("AddCategoryRelation = %+v, expected %d -> %d", relation, ids[parent], ids[child])
+	}
+
+	unknown := "romance"
+	if err := m.AddCategoryRelation(ctx, model.AddCategoryRelation{
+		TypeCode: &typeCode, ParentCode: &unknown, ChildCode: &child,
+	}, nil); err == nil || err.Error() != "parent category does not exist" {
+		t.Errorf("AddCategoryRelation unknown parent: got %v", err)
+	}
+
+	comic := new(model.Comic)
+	if err := m.AddComic(ctx, model.AddComic{}, comic); err != nil {
+		t.Fatalf("AddComic: %v", err)
+	}
+	category := new(model.ComicCategory)
+	if err := m.AddComicCategory(ctx, model.AddComicCategory{
+		ComicCode: &comic.Code, CategoryTypeCode: &typeCode, CategoryCode: &child,
+	}, category); err != nil {
+		t.Fatalf("AddComicCategory: %v", err)
+	}
+	if category.ComicID != comic.ID || category.CategoryID != ids[child] || category.CategoryCode != child {
+		t.Errorf("AddComicCategory = %+v", category)
+	}
+
+	if err := m.DeleteCategory(ctx, model.DBConditionalKV{Key: model.DBGenericID, Value: ids[child]}, nil); err != nil {
+		t.Fatalf("DeleteCategory: %v", err)
+	}
+	if err := m.AddComicCategory(ctx, model.AddComicCategory{
+		ComicID: &comic.ID, CategoryTypeCode: &typeCode, CategoryCode: &child,
+	}, nil); err == nil {
+		t.Error("AddComicCategory by deleted code: expected error")
+	}
+}
+
+func TestMemoryTransaction(t *testing.T) {
+	ctx := context.Background()
+	m := New()
+	count := func(ctx context.Context) int {
+		t.Helper()
+		n, err := m.CountLanguage(ctx, nil)
+		if err != nil {
+			t.Fatalf("CountLanguage: %v", err)
+		}
+		return n
+	}
+
+	tx, err := m.ContextTransactionBegin(ctx)
+	if err != nil {
+		t.Fatalf("ContextTransactionBegin: %v", err)
+	}
+	if err := m.AddLanguage(tx, model.AddLanguage{IETF: "en", Name: "English"}, nil); err != nil {
+		t.Fatalf("AddLanguage: %v", err)
+	}
+	if got := count(ctx); got != 0 {
+		t.Errorf("uncommitted write visible outside: %d languages", got)
+	}
+	if err := m.ContextTransactionRollback(tx); err != nil {
+		t.Fatalf("ContextTransactionRollback: %v", err)
+	}
+	if got := count(ctx); got != 0 {
+		t.Errorf("rolled back write kept: %d languages", got)
+	}
+	if err := m.AddLanguage(tx, model.AddLanguage{IETF: "id", Name: "Indonesian"}, nil); err == nil {
+		t.Error("write on closed transaction: expected error")
+	}
+
+	tx, err = m.ContextTransactionBegin(ctx)
+	if err != nil {
+		t.Fatalf("ContextTransactionBegin: %v", err)
+	}
+	if err := m.AddLanguage(tx, model.AddLanguage{IETF: "en", Name: "English"}, nil); err != nil {
+		t.Fatalf("AddLanguage: %v", err)
+	}
+	nested, err := m.ContextTransactionBegin(tx)
+	if err != nil {
+		t.Fatalf("ContextTransactionBegin nested: %v", err)
+	}
+	if err := m.AddLanguage(nested, model.AddLanguage{IETF: "id", Name: "Indonesian"}, nil); err != nil {
+		t.Fatalf("AddLanguage nested: %v", err)
+	}
+	if err := m.ContextTransactionRollback(nested); err != nil {
+		t.Fatalf("ContextTransactionRollback nested: %v", err)
+	}
+	if got := count(tx); got != 1 {
+		t.Errorf("nested rollback: expected 1 language got %d", got)
+	}
+	if err := m.ContextTransactionCommit(tx); err != nil {
+		t.Fatalf("ContextTransactionCommit: %v", err)
+	}
+	if got := count(ctx); got != 1 {
+		t.Errorf("committed write: expected 1 language got %d", got)
+	}
+
+	tx, err = m.ContextTransactionBegin(ctx)
+	if err != nil {
+		t.Fatalf("ContextTransactionBegin: %v", err)
+	}
+	if err := m.AddLanguage(tx, model.AddLanguage{IETF: "ja", Name: "Japanese"}, nil); err != nil {
+		t.Fatalf("AddLanguage: %v", err)
+	}
+	if err := m.AddLanguage(ctx, model.AddLanguage{IETF: "ko", Name: "Korean"}, nil); err != nil {
+		t.Fatalf("AddLanguage concurrent: %v", err)
+	}
+	if got := count(tx); got != 2 {
+		t.Errorf("transaction saw a concurrent write: %d languages", got)
+	}
+	var errDatabase model.DatabaseError
+	if err := m.ContextTransactionCommit(tx); !errors.As(err, &errDatabase) || errDatabase.Code != CodeErrConflict {
+		t.Errorf("commit after concurrent write: expected conflict got %v", err)
+	}
+	if got := count(ctx); got != 2 {
+		t.Errorf("conflicting commit applied: %d languages", got)
+	}
+}
@@ -0,0 +1,125 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+func (m *Memory) AddTagType(ctx context.Context, data model.AddTagType, v *model.TagType) error {
+	return m.GenericAdd(ctx, model.DBTagType, map[string]any{
+		model.DBTagTypeCode: data.Code,
+		model.DBTagTypeName: data.Name,
+	}, nil, v)
+}
+
+func (m *Memory) GetTagType(ctx context.Context, conds any) (*model.TagType, error) {
+	var result model.TagType
+	if err := m.GenericGet(ctx, model.DBTagType, conds, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (m *Memory) UpdateTagType(ctx context.Context, data model.SetTagType, conds any, v *model.TagType) error {
+	data0 := map[string]any{}
+	if data.Code != nil {
+		data0[model.DBTagTypeCode] = data.Code
+	}
+	if data.Name != nil {
+		data0[model.DBTagTypeName] = data.Name
+	}
+	return m.GenericUpdate(ctx, model.DBTagType, data0, conds, nil, v)
+}
+
+func (m *Memory) DeleteTagType(ctx context.Context, conds any, v *model.TagType) error {
+	return m.GenericDelete(ctx, model.DBTagType, conds, nil, v)
+}
+
+func (m *Memory) ListTagType(ctx context.Context, params model.ListParams) ([]*model.TagType, error) {
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBTagTypeCode})
+	}
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.TagTypePaginationDef}
+	}
+	return GenericList[model.TagType](ctx, m, model.DBTagType, params, nil)
+}
+
+func (m *Memory) CountTagType(ctx context.Context, conds any) (int, error) {
+	return m.GenericCount(ctx, model.DBTagType, conds)
+}
+
+func (m *Memory) AddTag(ctx context.Context, data model.AddTag, v *model.Tag) error {
+	var typeID any
+	switch {
+	case data.TypeID != nil:
+		typeID = data.TypeID
+	case data.TypeCode != nil:
+		typeID = model.DBTagTypeCodeToID(*data.TypeCode)
+	}
+	return m.GenericAdd(ctx, model.DBTag, map[string]any{
+		model.DBTagTypeID: typeID,
+		model.DBTagCode:   data.Code,
+		model.DBTagName:   data.Name,
+	}, tagView, v)
+}
+
+func (m *Memory) GetTag(ctx context.Context, conds any) (*model.Tag, error) {
+	var result model.Tag
+	if err := m.GenericGet(ctx, model.DBTag, conds, tagView, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (m *Memory) UpdateTag(ctx context.Context, data model.SetTag, conds any, v *model.Tag) error {
+	data0 := map[string]any{}
+	switch {
+	case data.TypeID != nil:
+		data0[model.DBTagTypeID] = data.TypeID
+	case data.TypeCode != nil:
+		data0[model.DBTagTypeID] = model.DBTagTypeCodeToID(*data.TypeCode)
+	}
+	if data.Code != nil {
+		data0[model.DBTagCode] = data.Code
+	}
+	if data.Name != nil {
+		data0[model.DBTagName] = data.Name
+	}
+	return m.GenericUpdate(ctx, model.DBTag, data0, conds, tagView, v)
+}
+
+func (m *Memory) DeleteTag(ctx context.Context, conds any, v *model.Tag) error {
+	return m.GenericDelete(ctx, model.DBTag, conds, tagView, v)
+}
+
+func (m *Memory) RestoreTag(ctx context.Context, conds any, v *model.Tag) error {
+	return m.GenericRestore(ctx, model.DBTag, conds, tagView, v)
+}
+
+func (m *Memory) ListTag(ctx context.Context, params model.ListParams) ([]*model.Tag, []any, error) {
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBTagCode})
+	}
+	params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID})
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.TagPaginationDef}
+	}
+	if err := setListCursor(&params); err != nil {
+		return nil, nil, err
+	}
+	result, err := GenericList[model.Tag](ctx, m, model.DBTag, params, tagView)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, listNextCursor(params, result), nil
+}
+
+func (m *Memory) CountTag(ctx context.Context, conds any) (int, error) {
+	return m.GenericCount(ctx, model.DBTag, conds)
+}
+
+var tagView = joinView(model.DBTagType, model.DBTagTypeID, map[string]string{
+	model.DBTagTypeCode: "type_code",
+}, false)
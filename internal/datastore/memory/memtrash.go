@@ -0,0 +1,97 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+func (m *Memory) ListTrash(ctx context.Context, params model.ListParams) ([]*model.Trash, error) {
+	var result []*model.Trash
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericDeletedAt, Sort: "desc"})
+	}
+	params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBTrashType})
+	params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID})
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.TrashPaginationDef}
+	}
+	if err := m.read(ctx, func(s *state) error {
+		rows := []row{}
+		for _, r := range s.trash() {
+			if s.match(r, params.Conditions) {
+				rows = append(rows, r)
+			}
+		}
+		s.sort(rows, params.OrderBys)
+		var err error
+		result, err = scanAll[model.Trash](paginate(rows, *params.Pagination))
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (m *Memory) CountTrash(ctx context.Context, conds any) (int, error) {
+	var dst int
+	if err := m.read(ctx, func(s *state) error {
+		for _, r := range s.trash() {
+			if s.match(r, conds) {
+				dst++
+			}
+		}
+		return nil
+	}); err != nil {
+		return -1, err
+	}
+	return dst, nil
+}
+
+func (m *Memory) PurgeTrash(ctx context.Context, before time.Time) (int, error) {
+	total := 0
+	err := m.write(ctx, func(s *state) error {
+		for _, t := range softDeleteTables {
+			rows, err := s.delete(t, model.DBConditionalKV{
+				Key:   model.DBGenericDeletedAt,
+				Value: model.DBLessThan{Value: before},
+			})
+			if err != nil {
+				return err
+			}
+			total += len(rows)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func (s *state) trash() []row {
+	result := []row{}
+	for _, t := range []struct{ name, table, typeID string }{
+		{model.TrashTypeComic, model.DBComic, ""},
+		{model.TrashTypeCategory, model.DBCategory, model.DBCategoryTypeID},
+		{model.TrashTypeTag, model.DBTag, model.DBTagTypeID},
+	} {
+		for _, r := range s.find(t.table, trashConds(nil), nil) {
+			r0 := row{
+				model.DBTrashType:        t.name,
+				model.DBGenericID:        r[model.DBGenericID],
+				model.DBTrashCode:        r[model.DBTrashCode],
+				model.DBTrashTypeID:      nil,
+				model.DBGenericCreatedAt: r[model.DBGenericCreatedAt],
+				model.DBGenericUpdatedAt: r[model.DBGenericUpdatedAt],
+				model.DBGenericDeletedAt: r[model.DBGenericDeletedAt],
+			}
+			if t.typeID != "" {
+				r0[model.DBTrashTypeID] = r[t.typeID]
+			}
+			result = append(result, r0)
+		}
+	}
+	return result
+}
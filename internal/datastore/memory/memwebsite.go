@@ -0,0 +1,59 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+func (m *Memory) AddWebsite(ctx context.Context, data model.AddWebsite, v *model.Website) error {
+	return m.GenericAdd(ctx, model.DBWebsite, map[string]any{
+		model.DBWebsiteDomain: data.Domain,
+		model.DBWebsiteName:   data.Name,
+	}, nil, v)
+}
+
+func (m *Memory) GetWebsite(ctx context.Context, conds any) (*model.Website, error) {
+	var result model.Website
+	if err := m.GenericGet(ctx, model.DBWebsite, conds, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (m *Memory) UpdateWebsite(ctx context.Context, data model.SetWebsite, conds any, v *model.Website) error {
+	data0 := map[string]any{}
+	if data.Domain != nil {
+		data0[model.DBWebsiteDomain] = data.Domain
+	}
+	if data.Name != nil {
+		data0[model.DBWebsiteName] = data.Name
+	}
+	return m.GenericUpdate(ctx, model.DBWebsite, data0, conds, nil, v)
+}
+
+func (m *Memory) DeleteWebsite(ctx context.Context, conds any, v *model.Website) error {
+	return m.GenericDelete(ctx, model.DBWebsite, conds, nil, v)
+}
+
+func (m *Memory) ListWebsite(ctx context.Context, params model.ListParams) ([]*model.Website, []any, error) {
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBWebsiteDomain})
+	}
+	params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID})
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.WebsitePaginationDef}
+	}
+	if err := setListCursor(&params); err != nil {
+		return nil, nil, err
+	}
+	result, err := GenericList[model.Website](ctx, m, model.DBWebsite, params, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, listNextCursor(params, result), nil
+}
+
+func (m *Memory) CountWebsite(ctx context.Context, conds any) (int, error) {
+	return m.GenericCount(ctx, model.DBWebsite, conds)
+}
@@ -0,0 +1,349 @@
+package memory
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+type (
+	schema struct {
+		identity   bool
+		softDelete bool
+		required   []string
+		json       []string
+		uniques    []unique
+		foreigns   []foreign
+		checks     []check
+	}
+
+	unique struct {
+		name             string
+		columns          []string
+		nullsNotDistinct bool
+		message          string
+	}
+
+	foreign struct {
+		name, column, table string
+		cascade             bool
+		message             string
+	}
+
+	check struct {
+		name    string
+		valid   func(r row) bool
+		message string
+	}
+)
+
+// The schemas follow the constraints declared by the SQL migrations.
+var schemas = map[string]schema{
+	model.DBLanguage: {
+		identity: true,
+		required: []string{model.DBLanguageIETF, model.DBLanguageName},
+		uniques: []unique{
+			{"language_ietf_key", []string{model.DBLanguageIETF}, false, "same ietf already exists"},
+		},
+	},
+	model.DBWebsite: {
+		identity: true,
+		required: []string{model.DBWebsiteDomain, model.DBWebsiteName},
+		uniques: []unique{
+			{"website_domain_key", []string{model.DBWebsiteDomain}, false, "same domain already exists"},
+		},
+	},
+	model.DBCategoryType: {
+		identity: true,
+		required: []string{model.DBCategoryTypeCode, model.DBCategoryTypeName},
+		uniques: []unique{
+			{"category_type_code_key", []string{model.DBCategoryTypeCode}, false, "same code already exists"},
+		},
+	},
+	model.DBCategory: {
+		identity:   true,
+		softDelete: true,
+		required:   []string{model.DBCategoryTypeID, model.DBCategoryCode, model.DBCategoryName},
+		uniques: []unique{
+			{"category_type_id_code_key", []string{model.DBCategoryTypeID, model.DBCategoryCode}, false, "same type id + code already exists"},
+		},
+		foreigns: []foreign{
+			{"category_type_id_fkey", model.DBCategoryTypeID, model.DBCategoryType, false, "category type does not exist"},
+		},
+	},
+	model.DBCategoryRelation: {
+		required: []string{model.DBCategoryRelationParentID, model.DBCategoryRelationChildID},
+		uniques: []unique{
+			{"category_relation_pkey", []string{model.DBCategoryRelationParentID, model.DBCategoryRelationChildID}, false, "same child id already exists"},
+		},
+		foreigns: []foreign{
+			{"category_relation_parent_id_fkey", model.DBCategoryRelationParentID, model.DBCategory, true, "parent category does not exist"},
+			{"category_relation_child_id_fkey", model.DBCategoryRelationChildID, model.DBCategory, true, "child category does not exist"},
+		},
+		checks: []check{
+			{"category_relation_parent_id_child_id_check", differentColumns(model.DBCategoryRelationParentID, model.DBCategoryRelationChildID), "parent category and child category cannot be same"},
+		},
+	},
+	model.DBTagType: {
+		identity: true,
+		required: []string{model.DBTagTypeCode, model.DBTagTypeName},
+		uniques: []unique{
+			{"tag_type_code_key", []string{model.DBTagTypeCode}, false, "same code already exists"},
+		},
+	},
+	model.DBTag: {
+		identity:   true,
+		softDelete: true,
+		required:   []string{model.DBTagTypeID, model.DBTagCode, model.DBTagName},
+		uniques: []unique{
+			{"tag_type_id_code_key", []string{model.DBTagTypeID, model.DBTagCode}, false, "same type id + code already exists"},
+		},
+		foreigns: []foreign{
+			{"tag_type_id_fkey", model.DBTagTypeID, model.DBTagType, false, "tag type does not exist"},
+		},
+	},
+	model.DBComic: {
+		identity:   true,
+		softDelete: true,
+		required:   []string{model.DBComicCode},
+		json:       []string{model.DBComicAdditionals},
+		uniques: []unique{
+			{"comic_code_key", []string{model.DBComicCode}, false, "same code already exists"},
+		},
+		foreigns: []foreign{
+			{"comic_language_id_fkey", model.DBLanguageGenericLanguageID, model.DBLanguage, false, "language does not exist"},
+		},
+	},
+	model.DBComicTitle: {
+		identity: true,
+		required: []string{model.DBComicGenericComicID, model.DBComicGenericRID, model.DBLanguageGenericLanguageID, model.DBComicTitleTitle},
+		uniques: []unique{
+			{"comic_title_comic_id_rid_key", []string{model.DBComicGenericComicID, model.DBComicGenericRID}, false, "same comic id + rid already exists"},
+			{"comic_title_comic_id_title_key", []string{model.DBComicGenericComicID, model.DBComicTitleTitle}, false, "same comic id + title already exists"},
+		},
+		foreigns: []foreign{
+			{"comic_title_comic_id_fkey", model.DBComicGenericComicID, model.DBComic, true, "comic does not exist"},
+			{"comic_title_language_id_fkey", model.DBLanguageGenericLanguageID, model.DBLanguage, false, "language does not exist"},
+		},
+	},
+	model.DBComicCover: {
+		identity: true,
+		required: []string{model.DBComicGenericComicID, model.DBComicGenericRID, model.DBWebsiteGenericWebsiteID, model.DBComicCoverRelativeURL},
+		uniques: []unique{
+			{"comic_cover_comic_id_rid_key", []string{model.DBComicGenericComicID, model.DBComicGenericRID}, false, "same comic id + rid already exists"},
+			{"comic_cover_comic_id_website_id_relative_url_key", []string{model.DBComicGenericComicID, model.DBWebsiteGenericWebsiteID, model.DBComicCoverRelativeURL}, false, "same comic id + website id + relative url already exists"},
+		},
+		foreigns: []foreign{
+			{"comic_cover_comic_id_fkey", model.DBComicGenericComicID, model.DBComic, true, "comic does not exist"},
+			{"comic_cover_website_id_fkey", model.DBWebsiteGenericWebsiteID, model.DBWebsite, true, "website does not exist"},
+		},
+	},
+	model.DBComicSynopsis: {
+		identity: true,
+		required: []string{model.DBComicGenericComicID, model.DBComicGenericRID, model.DBLanguageGenericLanguageID, model.DBComicSynopsisSynopsis},
+		uniques: []unique{
+			{"comic_synopsis_comic_id_rid_key", []string{model.DBComicGenericComicID, model.DBComicGenericRID}, false, "same comic id + rid already exists"},
+			{"comic_synopsis_comic_id_synopsis_key", []string{model.DBComicGenericComicID, model.DBComicSynopsisSynopsis}, false, "same comic id + synopsis already exists"},
+		},
+		foreigns: []foreign{
+			{"comic_synopsis_comic_id_fkey", model.DBComicGenericComicID, model.DBComic, true, "comic does not exist"},
+			{"comic_synopsis_language_id_fkey", model.DBLanguageGenericLanguageID, model.DBLanguage, false, "language does not exist"},
+		},
+	},
+	model.DBComicExternal: {
+		identity: true,
+		required: []string{model.DBComicGenericComicID, model.DBComicGenericRID, model.DBWebsiteGenericWebsiteID},
+		uniques: []unique{
+			{"comic_external_comic_id_rid_key", []string{model.DBComicGenericComicID, model.DBComicGenericRID}, false, "same comic id + rid already exists"},
+			{"comic_external_comic_id_website_id_relative_url_key", []string{model.DBComicGenericComicID, model.DBWebsiteGenericWebsiteID, model.DBComicExternalRelativeURL}, false, "same comic id + website id + relative url already exists"},
+		},
+		foreigns: []foreign{
+			{"comic_external_comic_id_fkey", model.DBComicGenericComicID, model.DBComic, true, "comic does not exist"},
+			{"comic_external_website_id_fkey", model.DBWebsiteGenericWebsiteID, model.DBWebsite, true, "website does not exist"},
+		},
+	},
+	model.DBComicCategory: {
+		required: []string{model.DBComicGenericComicID, model.DBCategoryGenericCategoryID},
+		uniques: []unique{
+			{"comic_category_pkey", []string{model.DBComicGenericComicID, model.DBCategoryGenericCategoryID}, false, "same category id already exists"},
+		},
+		foreigns: []foreign{
+			{"comic_category_comic_id_fkey", model.DBComicGenericComicID, model.DBComic, true, "comic does not exist"},
+			{"comic_category_category_id_fkey", model.DBCategoryGenericCategoryID, model.DBCategory, true, "category does not exist"},
+		},
+	},
+	model.DBComicTag: {
+		required: []string{model.DBComicGenericComicID, model.DBTagGenericTagID},
+		uniques: []unique{
+			{"comic_tag_pkey", []string{model.DBComicGenericComicID, model.DBTagGenericTagID}, false, "same tag id already exists"},
+		},
+		foreigns: []foreign{
+			{"comic_tag_comic_id_fkey", model.DBComicGenericComicID, model.DBComic, true, "comic does not exist"},
+			{"comic_tag_tag_id_fkey", model.DBTagGenericTagID, model.DBTag, true, "tag does not exist"},
+		},
+	},
+	model.DBComicRelationType: {
+		identity: true,
+		required: []string{model.DBComicRelationTypeCode, model.DBComicRelationTypeName},
+		uniques: []unique{
+			{"comic_relation_type_code_key", []string{model.DBComicRelationTypeCode}, false, "same code already exists"},
+		},
+	},
+	model.DBComicRelation: {
+		required: []string{model.DBComicRelationTypeID, model.DBComicRelationParentID, model.DBComicRelationChildID},
+		uniques: []unique{
+			{"comic_relation_pkey", []string{model.DBComicRelationTypeID, model.DBComicRelationParentID, model.DBComicRelationChildID}, false, "same type id + child id already exists"},
+		},
+		foreigns: []foreign{
+			{"comic_relation_type_id_fkey", model.DBComicRelationTypeID, model.DBComicRelationType, false, "comic relation type does not exist"},
+			{"comic_relation_parent_id_fkey", model.DBComicRelationParentID, model.DBComic, true, "parent comic does not exist"},
+			{"comic_relation_child_id_fkey", model.DBComicRelationChildID, model.DBComic, true, "child comic does not exist"},
+		},
+		checks: []check{
+			{"comic_relation_parent_id_child_id_check", differentColumns(model.DBComicRelationParentID, model.DBComicRelationChildID), "parent comic and child comic cannot be same"},
+		},
+	},
+	model.DBComicChapter: {
+		identity: true,
+		required: []string{model.DBComicGenericComicID, model.DBComicChapterChapter, model.DBComicChapterReleasedAt},
+		uniques: []unique{
+			{"comic_chapter_comic_id_chapter_version_key", []string{model.DBComicGenericComicID, model.DBComicChapterChapter, model.DBComicChapterVersion}, true, "same comic id + chapter + version already exists"},
+		},
+		foreigns: []foreign{
+			{"comic_chapter_comic_id_fkey", model.DBComicGenericComicID, model.DBComic, true, "comic does not exist"},
+		},
+	},
+	model.DBJSONSchema: {
+		identity: true,
+		required: []string{model.DBJSONSchemaName, model.DBJSONSchemaSchema},
+		json:     []string{model.DBJSONSchemaSchema},
+		uniques: []unique{
+			{"json_schema_name_key", []string{model.DBJSONSchemaName}, false, "same name already exists"},
+		},
+	},
+	model.DBAudit: {
+		identity: true,
+		required: []string{model.DBAuditActor, model.DBAuditAction, model.DBAuditEntity, model.DBAuditEntityKey},
+		json:     []string{model.DBAuditDataBefore, model.DBAuditDataAfter},
+	},
+	model.DBAPIKey: {
+		identity: true,
+		required: []string{model.DBAPIKeyName, model.DBAPIKeyPrefix, model.DBAPIKeyHash, model.DBAPIKeyPermissions},
+		json:     []string{model.DBAPIKeyPermissions},
+		uniques: []unique{
+			{"api_key_hash_key", []string{model.DBAPIKeyHash}, false, "same hash already exists"},
+		},
+	},
+}
+
+func differentColumns(a, b string) func(r row) bool {
+	return func(r row) bool {
+		if r[a] == nil || r[b] == nil {
+			return true
+		}
+		c, ok := compare(r[a], r[b])
+		return !ok || c != 0
+	}
+}
+
+func constraintError(name, code, message string) error {
+	if message != "" {
+		return model.GenericError(message)
+	}
+	err := errors.New("violates constraint \"" + name + "\"")
+	if code == CodeErrValidation {
+		return model.WrappedError(model.DatabaseError{Name: name, Code: code, Err: err}, "database validation failed")
+	}
+	return model.DatabaseError{Name: name, Code: code, Err: err}
+}
+
+func (s *state) validate(t string, rows []row) error {
+	sch := schemas[t]
+	for _, r := range rows {
+		for _, col := range sch.required {
+			if r[col] == nil {
+				err := fmt.Errorf("null value in column %q of relation %q violates not-null constraint", col, t)
+				return model.DatabaseError{Code: CodeErrNotNull, Err: err}
+			}
+		}
+		for _, c := range sch.checks {
+			if !c.valid(r) {
+				return constraintError(c.name, CodeErrValidation, c.message)
+			}
+		}
+	}
+	for _, u := range sch.uniques {
+		seen := map[string]bool{}
+		for _, r := range s.tables[t].rows {
+			// Uniques of soft deleted tables are partial, deleted rows are out.
+			if sch.softDelete && r[model.DBGenericDeletedAt] != nil {
+				continue
+			}
+			vals := make([]any, 0, len(u.columns))
+			for _, col := range u.columns {
+				vals = append(vals, r[col])
+			}
+			if !u.nullsNotDistinct && slices.Contains(vals, nil) {
+				continue
+			}
+			key := fmt.Sprintf("%#v", vals)
+			if seen[key] {
+				return constraintError(u.name, CodeErrExists, u.message)
+			}
+			seen[key] = true
+		}
+	}
+	for _, f := range sch.foreigns {
+		ids := s.index(f.table)
+		for _, r := range rows {
+			if r[f.column] == nil {
+				continue
+			}
+			if _, ok := ids[r[f.column]]; !ok {
+				return constraintError(f.name, CodeErrForeign, f.message)
+			}
+		}
+	}
+	return nil
+}
+
+// Removing referenced rows either cascades or fails like the matching foreign
+// key would.
+func (s *state) cascade(t string, rows []row) error {
+	if len(rows) < 1 {
+		return nil
+	}
+	ids := map[any]bool{}
+	for _, r := range rows {
+		ids[r[model.DBGenericID]] = true
+	}
+	for t0, sch := range schemas {
+		for _, f := range sch.foreigns {
+			if f.table != t {
+				continue
+			}
+			tb := s.tables[t0]
+			kept, removed := []row{}, []row{}
+			for _, r := range tb.rows {
+				if r[f.column] != nil && ids[r[f.column]] {
+					removed = append(removed, r)
+					continue
+				}
+				kept = append(kept, r)
+			}
+			if len(removed) < 1 {
+				continue
+			}
+			if !f.cascade {
+				return constraintError(f.name, CodeErrForeign, "")
+			}
+			tb.rows = kept
+			if err := s.cascade(t0, removed); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
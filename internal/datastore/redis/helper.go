@@ -12,7 +12,7 @@ func (rdb Redis) GobSet(ctx context.Context, key string, v any, exp time.Duratio
 	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
 		return err
 	}
-	return rdb.Set(ctx, key, buf, exp)
+	return rdb.Set(ctx, key, buf.Bytes(), exp)
 }
 
 func (rdb Redis) GobGet(ctx context.Context, key string, v any) error {
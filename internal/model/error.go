@@ -1,10 +1,11 @@
 package model
 
 var (
-	ErrGeneric  GenericError
-	ErrNotFound notFoundError
-	ErrDatabase DatabaseError
-	ErrCache    cacheError
+	ErrGeneric      GenericError
+	ErrNotFound     notFoundError
+	ErrDatabase     DatabaseError
+	ErrCache        cacheError
+	ErrPrecondition preconditionError
 )
 
 type GenericError string
@@ -34,6 +35,14 @@ func NotFoundError(err error) error {
 	return notFoundError{err}
 }
 
+type preconditionError struct{}
+
+func (e preconditionError) Error() string { return "precondition failed" }
+
+func PreconditionError() error {
+	return preconditionError{}
+}
+
 type DatabaseError struct {
 	Name string
 	Code string
@@ -0,0 +1,70 @@
+package model
+
+import (
+	"strconv"
+	"time"
+
+	donoengine "github.com/mahmudindes/orenocomic-donoengine"
+)
+
+const (
+	APIKeyNameMax        = 48
+	APIKeyPrefixLength   = 8
+	APIKeyPaginationDef  = 10
+	APIKeyPaginationMax  = 50
+	DBAPIKey             = donoengine.ID + "." + "api_key"
+	DBAPIKeyName         = "name"
+	DBAPIKeyPrefix       = "prefix"
+	DBAPIKeyHash         = "hash"
+	DBAPIKeyPermissions  = "permissions"
+	DBAPIKeyExpiresAt    = "expires_at"
+	DBAPIKeyLastUsedAt   = "last_used_at"
+	APIKeyLastUsedPeriod = 1 * time.Minute
+)
+
+type (
+	APIKey struct {
+		ID          uint       `json:"id"`
+		Name        string     `json:"name"`
+		Prefix      string     `json:"prefix"`
+		Hash        string     `json:"-"`
+		Permissions []string   `json:"permissions"`
+		ExpiresAt   *time.Time `json:"expiresAt"`
+		LastUsedAt  *time.Time `json:"lastUsedAt"`
+		CreatedAt   time.Time  `json:"createdAt"`
+		UpdatedAt   *time.Time `json:"updatedAt"`
+	}
+
+	AddAPIKey struct {
+		Name        string
+		Prefix      string
+		Hash        string
+		Permissions []string
+		ExpiresAt   *time.Time
+	}
+)
+
+func (m AddAPIKey) Validate() error {
+	if m.Name == "" {
+		return GenericError("name cannot be empty")
+	}
+
+	if len(m.Name) > APIKeyNameMax {
+		max := strconv.FormatInt(APIKeyNameMax, 10)
+		return GenericError("name must be at most " + max + " characters long")
+	}
+
+	if len(m.Permissions) < 1 {
+		return GenericError("permissions cannot be empty")
+	}
+
+	if m.ExpiresAt != nil && !m.ExpiresAt.After(time.Now()) {
+		return GenericError("expires at must be in the future")
+	}
+
+	return nil
+}
+
+func (m APIKey) Expired() bool {
+	return m.ExpiresAt != nil && !m.ExpiresAt.After(time.Now())
+}
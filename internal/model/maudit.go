@@ -0,0 +1,64 @@
+package model
+
+import (
+	"time"
+
+	donoengine "github.com/mahmudindes/orenocomic-donoengine"
+)
+
+const (
+	AuditActionAdd               = "add"
+	AuditActionUpdate            = "update"
+	AuditActionDelete            = "delete"
+	AuditActionRestore           = "restore"
+	AuditEntityLanguage          = "language"
+	AuditEntityWebsite           = "website"
+	AuditEntityCategoryType      = "category_type"
+	AuditEntityCategory          = "category"
+	AuditEntityCategoryRelation  = "category_relation"
+	AuditEntityTagType           = "tag_type"
+	AuditEntityTag               = "tag"
+	AuditEntityComic             = "comic"
+	AuditEntityComicTitle        = "comic_title"
+	AuditEntityComicCover        = "comic_cover"
+	AuditEntityComicSynopsis     = "comic_synopsis"
+	AuditEntityComicExternal     = "comic_external"
+	AuditEntityComicCategory     = "comic_category"
+	AuditEntityComicTag          = "comic_tag"
+	AuditEntityComicRelationType = "comic_relation_type"
+	AuditEntityComicRelation     = "comic_relation"
+	AuditEntityComicChapter      = "comic_chapter"
+	AuditEntityAPIKey            = "api_key"
+	AuditEntityJSONSchema        = "json_schema"
+	AuditPaginationDef           = 10
+	AuditPaginationMax           = 50
+	DBAudit                      = donoengine.ID + "." + "audit"
+	DBAuditActor                 = "actor"
+	DBAuditAction                = "action"
+	DBAuditEntity                = "entity"
+	DBAuditEntityKey             = "entity_key"
+	DBAuditDataBefore            = "data_before"
+	DBAuditDataAfter             = "data_after"
+)
+
+type (
+	Audit struct {
+		ID         uint           `json:"id"`
+		Actor      string         `json:"actor"`
+		Action     string         `json:"action"`
+		Entity     string         `json:"entity"`
+		EntityKey  string         `json:"key"`
+		DataBefore map[string]any `json:"before"`
+		DataAfter  map[string]any `json:"after"`
+		CreatedAt  time.Time      `json:"createdAt"`
+	}
+
+	AddAudit struct {
+		Actor  string
+		Action string
+		Entity string
+		Key    string
+		Before any
+		After  any
+	}
+)
@@ -126,8 +126,9 @@ var (
 			Expression: DBGenericID,
 			ZeroValue:  0,
 			Conditions: map[string]any{
-				DBCategoryTypeID: typeID,
-				DBCategoryCode:   sid.Code,
+				DBCategoryTypeID:   typeID,
+				DBCategoryCode:     sid.Code,
+				DBGenericDeletedAt: DBIsNull{},
 			},
 		}
 	}
@@ -214,13 +215,15 @@ func init() {
 }
 
 const (
-	DBCategoryGenericCategoryID   = "category_id"
-	CategoryRelationOrderBysMax   = 3
-	CategoryRelationPaginationDef = 10
-	CategoryRelationPaginationMax = 50
-	DBCategoryRelation            = donoengine.ID + "." + "category_relation"
-	DBCategoryRelationParentID    = "parent_id"
-	DBCategoryRelationChildID     = "child_id"
+	DBCategoryGenericCategoryID     = "category_id"
+	DBCategoryGenericCategoryTypeID = "category_type_id"
+	DBCategoryGenericCategoryCode   = "category_code"
+	CategoryRelationOrderBysMax     = 3
+	CategoryRelationPaginationDef   = 10
+	CategoryRelationPaginationMax   = 50
+	DBCategoryRelation              = donoengine.ID + "." + "category_relation"
+	DBCategoryRelationParentID      = "parent_id"
+	DBCategoryRelationChildID       = "child_id"
 )
 
 var CategoryRelationOrderByAllow = []string{
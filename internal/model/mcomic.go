@@ -26,6 +26,7 @@ const (
 	DBComicNSFW          = "nsfw"
 	DBComicNSFL          = "nsfl"
 	DBComicAdditionals   = "additionals"
+	DBComicSearchRank    = "search_rank"
 )
 
 var (
@@ -56,7 +57,10 @@ var (
 			Table:      DBComic,
 			Expression: DBGenericID,
 			ZeroValue:  0,
-			Conditions: DBConditionalKV{Key: DBComicCode, Value: code},
+			Conditions: map[string]any{
+				DBComicCode:        code,
+				DBGenericDeletedAt: DBIsNull{},
+			},
 		}
 	}
 )
@@ -97,6 +101,12 @@ type (
 		NSFW          *int
 		NSFL          *int
 		Additionals   map[string]any
+		Titles        []AddComicTitle
+		Covers        []AddComicCover
+		Synopses      []AddComicSynopsis
+		Externals     []AddComicExternal
+		Categories    []AddComicCategory
+		Tags          []AddComicTag
 	}
 
 	SetComic struct {
@@ -0,0 +1,54 @@
+package model
+
+import (
+	"slices"
+	"time"
+
+	donoengine "github.com/mahmudindes/orenocomic-donoengine"
+)
+
+const (
+	JSONSchemaComicAdditionals = "comic_additionals"
+	DBJSONSchema               = donoengine.ID + "." + "json_schema"
+	DBJSONSchemaName           = "name"
+	DBJSONSchemaSchema         = "schema"
+)
+
+var JSONSchemaNames = []string{
+	JSONSchemaComicAdditionals,
+}
+
+type (
+	JSONSchema struct {
+		ID        uint           `json:"id"`
+		Name      string         `json:"name"`
+		Schema    map[string]any `json:"schema"`
+		CreatedAt time.Time      `json:"createdAt"`
+		UpdatedAt *time.Time     `json:"updatedAt"`
+	}
+
+	AddJSONSchema struct {
+		Name   string
+		Schema map[string]any
+	}
+
+	SetJSONSchema struct {
+		Schema map[string]any
+	}
+)
+
+func (m AddJSONSchema) Validate() error {
+	if !slices.Contains(JSONSchemaNames, m.Name) {
+		return GenericError("name is not a known json schema")
+	}
+
+	return (SetJSONSchema{Schema: m.Schema}).Validate()
+}
+
+func (m SetJSONSchema) Validate() error {
+	if m.Schema == nil {
+		return GenericError("schema cannot be empty")
+	}
+
+	return nil
+}
@@ -96,4 +96,7 @@ func (m SetLanguage) Validate() error {
 	return nil
 }
 
-const DBLanguageGenericLanguageID = "language_id"
+const (
+	DBLanguageGenericLanguageID   = "language_id"
+	DBLanguageGenericLanguageIETF = "language_ietf"
+)
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/mahmudindes/orenocomic-donoengine/internal/utila"
@@ -13,9 +14,19 @@ type (
 	DBIsNotDistinctFrom struct{ Value any }
 	DBIsNull            struct{}
 	DBIsNotNull         struct{}
+	DBGreaterThan       struct{ Value any }
+	DBGreaterOrEqual    struct{ Value any }
+	DBLessThan          struct{ Value any }
+	DBLessOrEqual       struct{ Value any }
+	DBBetween           struct{ From, To any }
+	DBIn                struct{ Value any }
+	DBNotIn             struct{ Value any }
 	DBBooleanIs         bool
 	DBBooleanIsNot      bool
 	DBInsensitiveLike   string
+	DBTextSearch        string
+	DBJSONContains      struct{ Value any }
+	DBJSONMerge         struct{ Value any }
 
 	DBConditionalKV struct {
 		Key   string
@@ -26,9 +37,18 @@ type (
 		ZeroValue, Conditions any
 	}
 	DBCrossConditional struct {
-		Table      string
+		Table       string
+		Conditions  any
+		Exclude     bool
+		HavingCount int
+	}
+	DBNot struct {
 		Conditions any
 	}
+	DBCursor struct {
+		OrderBys OrderBys
+		Values   []any
+	}
 )
 
 const (
@@ -36,6 +56,7 @@ const (
 	DBGenericID        = "id"
 	DBGenericCreatedAt = "created_at"
 	DBGenericUpdatedAt = "updated_at"
+	DBGenericDeletedAt = "deleted_at"
 )
 
 var (
@@ -111,9 +132,33 @@ func (obs OrderBys) Validate() error {
 	return nil
 }
 
+// CursorKey identifies the ordering a pagination cursor is made for, so the
+// cursor is not applied to a different one.
+func (obs OrderBys) CursorKey() string {
+	keys := make([]string, 0, len(obs))
+	for _, ob := range obs {
+		key := fmt.Sprint(ob.Field)
+		switch strings.ToLower(ob.Sort) {
+		case "d", "desc", "descend", "descending":
+			key += " desc"
+		default:
+			key += " asc"
+		}
+		switch strings.ToLower(ob.Null) {
+		case "f", "first":
+			key += " nulls first"
+		case "l", "last":
+			key += " nulls last"
+		}
+		keys = append(keys, key)
+	}
+	return strings.Join(keys, ",")
+}
+
 type Pagination struct {
-	Page  int
-	Limit int
+	Page   int
+	Limit  int
+	Cursor []any
 }
 
 func (p Pagination) Validate() error {
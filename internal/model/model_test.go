@@ -0,0 +1,21 @@
+package model
+
+import "testing"
+
+func TestOrderBysCursorKey(t *testing.T) {
+	tests := []struct {
+		obs  OrderBys
+		want string
+	}{
+		{nil, ""},
+		{OrderBys{{Field: "code"}, {Field: "id"}}, "code asc,id asc"},
+		{OrderBys{{Field: "code", Sort: "a"}, {Field: "id", Sort: "ascending"}}, "code asc,id asc"},
+		{OrderBys{{Field: "name", Sort: "DESC", Null: "f"}, {Field: "id"}}, "name desc nulls first,id asc"},
+		{OrderBys{{Field: "name", Null: "last"}}, "name asc nulls last"},
+	}
+	for _, tt := range tests {
+		if got := tt.obs.CursorKey(); got != tt.want {
+			t.Errorf("CursorKey(%v) = %q, want %q", tt.obs, got, tt.want)
+		}
+	}
+}
@@ -126,8 +126,9 @@ var (
 			Expression: DBGenericID,
 			ZeroValue:  0,
 			Conditions: map[string]any{
-				DBTagTypeID: typeID,
-				DBTagCode:   sid.Code,
+				DBTagTypeID:        typeID,
+				DBTagCode:          sid.Code,
+				DBGenericDeletedAt: DBIsNull{},
 			},
 		}
 	}
@@ -208,4 +209,8 @@ func (m SetTag) Validate() error {
 	return nil
 }
 
-const DBTagGenericTagID = "tag_id"
+const (
+	DBTagGenericTagID     = "tag_id"
+	DBTagGenericTagTypeID = "tag_type_id"
+	DBTagGenericTagCode   = "tag_code"
+)
@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+const (
+	TrashTypeComic     = "comic"
+	TrashTypeCategory  = "category"
+	TrashTypeTag       = "tag"
+	TrashPaginationDef = 10
+	TrashPaginationMax = 50
+	DBTrashType        = "type"
+	DBTrashCode        = "code"
+	DBTrashTypeID      = "type_id"
+)
+
+type Trash struct {
+	Type      string     `json:"type"`
+	ID        uint       `json:"id"`
+	Code      string     `json:"code"`
+	TypeID    *uint      `json:"typeID"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt *time.Time `json:"updatedAt"`
+	DeletedAt time.Time  `json:"deletedAt"`
+}
@@ -2,14 +2,24 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
 )
 
 type (
 	Service struct {
-		database database
-		oauth    oauth
+		database    database
+		oauth       oauth
+		config      Config
+		permissions map[Permission]PermissionGrant
+		jsonSchemas *jsonSchemaCache
+	}
+
+	Config struct {
+		ComicAdditionalsSchema map[string]any    `conf:"comic_additionals_schema"`
+		JSONSchemaCacheTTL     time.Duration     `conf:"json_schema_cache_ttl"`
+		Permissions            []PermissionGrant `conf:"permissions"`
 	}
 
 	database interface {
@@ -17,14 +27,14 @@ type (
 		GetLanguage(ctx context.Context, conds any) (*model.Language, error)
 		UpdateLanguage(ctx context.Context, data model.SetLanguage, conds any, v *model.Language) error
 		DeleteLanguage(ctx context.Context, conds any, v *model.Language) error
-		ListLanguage(ctx context.Context, params model.ListParams) ([]*model.Language, error)
+		ListLanguage(ctx context.Context, params model.ListParams) ([]*model.Language, []any, error)
 		CountLanguage(ctx context.Context, conds any) (int, error)
 
 		AddWebsite(ctx context.Context, data model.AddWebsite, v *model.Website) error
 		GetWebsite(ctx context.Context, conds any) (*model.Website, error)
 		UpdateWebsite(ctx context.Context, data model.SetWebsite, conds any, v *model.Website) error
 		DeleteWebsite(ctx context.Context, conds any, v *model.Website) error
-		ListWebsite(ctx context.Context, params model.ListParams) ([]*model.Website, error)
+		ListWebsite(ctx context.Context, params model.ListParams) ([]*model.Website, []any, error)
 		CountWebsite(ctx context.Context, conds any) (int, error)
 
 		AddCategoryType(ctx context.Context, data model.AddCategoryType, v *model.CategoryType) error
@@ -37,7 +47,8 @@ type (
 		GetCategory(ctx context.Context, conds any) (*model.Category, error)
 		UpdateCategory(ctx context.Context, data model.SetCategory, conds any, v *model.Category) error
 		DeleteCategory(ctx context.Context, conds any, v *model.Category) error
-		ListCategory(ctx context.Context, params model.ListParams) ([]*model.Category, error)
+		RestoreCategory(ctx context.Context, conds any, v *model.Category) error
+		ListCategory(ctx context.Context, params model.ListParams) ([]*model.Category, []any, error)
 		CountCategory(ctx context.Context, conds any) (int, error)
 		AddCategoryRelation(ctx context.Context, data model.AddCategoryRelation, v *model.CategoryRelation) error
 		GetCategoryRelation(ctx context.Context, conds any) (*model.CategoryRelation, error)
@@ -56,15 +67,18 @@ type (
 		GetTag(ctx context.Context, conds any) (*model.Tag, error)
 		UpdateTag(ctx context.Context, data model.SetTag, conds any, v *model.Tag) error
 		DeleteTag(ctx context.Context, conds any, v *model.Tag) error
-		ListTag(ctx context.Context, params model.ListParams) ([]*model.Tag, error)
+		RestoreTag(ctx context.Context, conds any, v *model.Tag) error
+		ListTag(ctx context.Context, params model.ListParams) ([]*model.Tag, []any, error)
 		CountTag(ctx context.Context, conds any) (int, error)
 
 		// Comic
 		AddComic(ctx context.Context, data model.AddComic, v *model.Comic) error
 		GetComic(ctx context.Context, conds any) (*model.Comic, error)
+		GetComicDetail(ctx context.Context, conds any) (*model.Comic, error)
 		UpdateComic(ctx context.Context, data model.SetComic, conds any, v *model.Comic) error
 		DeleteComic(ctx context.Context, conds any, v *model.Comic) error
-		ListComic(ctx context.Context, params model.ListParams) ([]*model.Comic, error)
+		RestoreComic(ctx context.Context, conds any, v *model.Comic) error
+		ListComic(ctx context.Context, params model.ListParams) ([]*model.Comic, []any, error)
 		CountComic(ctx context.Context, conds any) (int, error)
 		ExistsComic(ctx context.Context, conds any) (bool, error)
 		AddComicTitle(ctx context.Context, data model.AddComicTitle, v *model.ComicTitle) error
@@ -120,16 +134,60 @@ type (
 		GetComicChapter(ctx context.Context, conds any) (*model.ComicChapter, error)
 		UpdateComicChapter(ctx context.Context, data model.SetComicChapter, conds any, v *model.ComicChapter) error
 		DeleteComicChapter(ctx context.Context, conds any, v *model.ComicChapter) error
-		ListComicChapter(ctx context.Context, params model.ListParams) ([]*model.ComicChapter, error)
+		ListComicChapter(ctx context.Context, params model.ListParams) ([]*model.ComicChapter, []any, error)
 		CountComicChapter(ctx context.Context, conds any) (int, error)
+
+		AddJSONSchema(ctx context.Context, data model.AddJSONSchema, v *model.JSONSchema) error
+		GetJSONSchema(ctx context.Context, conds any) (*model.JSONSchema, error)
+		UpdateJSONSchema(ctx context.Context, data model.SetJSONSchema, conds any, v *model.JSONSchema) error
+		DeleteJSONSchema(ctx context.Context, conds any, v *model.JSONSchema) error
+
+		ListTrash(ctx context.Context, params model.ListParams) ([]*model.Trash, error)
+		CountTrash(ctx context.Context, conds any) (int, error)
+
+		AddAudit(ctx context.Context, data model.AddAudit, v *model.Audit) error
+		ListAudit(ctx context.Context, params model.ListParams) ([]*model.Audit, error)
+		CountAudit(ctx context.Context, conds any) (int, error)
+
+		AddAPIKey(ctx context.Context, data model.AddAPIKey, v *model.APIKey) error
+		GetAPIKey(ctx context.Context, conds any) (*model.APIKey, error)
+		TouchAPIKey(ctx context.Context, conds any, at time.Time) error
+		DeleteAPIKey(ctx context.Context, conds any, v *model.APIKey) error
+		ListAPIKey(ctx context.Context, params model.ListParams) ([]*model.APIKey, error)
+		CountAPIKey(ctx context.Context, conds any) (int, error)
+
+		ContextTransactionBegin(ctx context.Context) (context.Context, error)
+		ContextTransactionCommit(ctx context.Context) error
+		ContextTransactionRollback(ctx context.Context) error
 	}
 
 	oauth interface {
 		HasPermissionContext(ctx context.Context, permission string) bool
+		HasScopeContext(ctx context.Context, scope string) bool
 		TokenPermissionKey(s ...string) string
+		IssuerContext(ctx context.Context) string
+		SubjectContext(ctx context.Context) string
 	}
 )
 
-func New(db database, oa oauth) Service {
-	return Service{database: db, oauth: oa}
+func New(db database, oa oauth, cfg Config) Service {
+	return Service{
+		database:    db,
+		oauth:       oa,
+		config:      cfg,
+		permissions: permissionGrants(cfg.Permissions),
+		jsonSchemas: &jsonSchemaCache{entries: map[string]jsonSchemaEntry{}},
+	}
+}
+
+func (svc Service) ContextTransactionBegin(ctx context.Context) (context.Context, error) {
+	return svc.database.ContextTransactionBegin(ctx)
+}
+
+func (svc Service) ContextTransactionCommit(ctx context.Context) error {
+	return svc.database.ContextTransactionCommit(ctx)
+}
+
+func (svc Service) ContextTransactionRollback(ctx context.Context) error {
+	return svc.database.ContextTransactionRollback(ctx)
 }
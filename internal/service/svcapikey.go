@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strconv"
+	"time"
+
+	donoengine "github.com/mahmudindes/orenocomic-donoengine"
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+type (
+	ctxAPIKey    struct{}
+	ctxAPIKeyRaw struct{}
+)
+
+// AddAPIKey stores a new key and returns its secret, only its hash is kept so
+// the secret cannot be shown again.
+func (svc Service) AddAPIKey(ctx context.Context, data model.AddAPIKey, v *model.APIKey) (string, error) {
+	if !svc.permitted(ctx, PermissionAdmin) {
+		return "", permissionError(PermissionAdmin, "add api key")
+	}
+
+	if err := data.Validate(); err != nil {
+		return "", err
+	}
+	for _, p := range data.Permissions {
+		if !slices.Contains(Permissions, Permission(p)) {
+			return "", model.GenericError("permission " + p + " is not valid")
+		}
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	random := base64.RawURLEncoding.EncodeToString(raw)
+	secret := donoengine.ID + "_" + random
+	data.Prefix, data.Hash = random[:model.APIKeyPrefixLength], apiKeyHash(secret)
+
+	if v == nil {
+		v = new(model.APIKey)
+	}
+	if err := svc.audit(ctx, model.AuditActionAdd, model.AuditEntityAPIKey, func(ctx context.Context, a *model.AddAudit) error {
+		if err := svc.database.AddAPIKey(ctx, data, v); err != nil {
+			return err
+		}
+		a.Key, a.After = strconv.FormatUint(uint64(v.ID), 10), v
+		return nil
+	}); err != nil {
+		return "", err
+	}
+	return secret, nil
+}
+
+func (svc Service) DeleteAPIKeyByID(ctx context.Context, id uint) error {
+	if !svc.permitted(ctx, PermissionAdmin) {
+		return permissionError(PermissionAdmin, "delete api key")
+	}
+
+	return svc.audit(ctx, model.AuditActionDelete, model.AuditEntityAPIKey, func(ctx context.Context, a *model.AddAudit) error {
+		before := new(model.APIKey)
+		if err := svc.database.DeleteAPIKey(ctx, model.DBConditionalKV{
+			Key:   model.DBGenericID,
+			Value: id,
+		}, before); err != nil {
+			return err
+		}
+		a.Key, a.Before = strconv.FormatUint(uint64(id), 10), before
+		return nil
+	})
+}
+
+func (svc Service) ListAPIKey(ctx context.Context, params model.ListParams) ([]*model.APIKey, error) {
+	if !svc.permitted(ctx, PermissionAdmin) {
+		return nil, permissionError(PermissionAdmin, "list api key")
+	}
+
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if pagination := params.Pagination; pagination != nil {
+		if pagination.Limit > model.APIKeyPaginationMax {
+			pagination.Limit = model.APIKeyPaginationMax
+		}
+	}
+
+	return svc.database.ListAPIKey(ctx, params)
+}
+
+func (svc Service) CountAPIKey(ctx context.Context, conds any) (int, error) {
+	if !svc.permitted(ctx, PermissionAdmin) {
+		return -1, permissionError(PermissionAdmin, "count api key")
+	}
+
+	return svc.database.CountAPIKey(ctx, conds)
+}
+
+func (svc Service) ContextAPIKey(ctx context.Context, key string) context.Context {
+	ctx = context.WithValue(ctx, ctxAPIKey{}, new(model.APIKey))
+	ctx = context.WithValue(ctx, ctxAPIKeyRaw{}, key)
+	return ctx
+}
+
+func (svc Service) ProcessAPIKeyContext(ctx context.Context) (bool, error) {
+	raw, ok := ctx.Value(ctxAPIKeyRaw{}).(string)
+	if !ok {
+		return false, nil
+	}
+	dst, ok := ctx.Value(ctxAPIKey{}).(*model.APIKey)
+	if !ok {
+		return false, errors.New("api key context not exists")
+	}
+
+	key, err := svc.database.GetAPIKey(ctx, model.DBConditionalKV{
+		Key:   model.DBAPIKeyHash,
+		Value: apiKeyHash(raw),
+	})
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound) {
+			return false, model.GenericError("invalid api key")
+		}
+		return false, err
+	}
+	if key.Expired() {
+		return false, model.GenericError("expired api key")
+	}
+
+	now := time.Now().UTC()
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= model.APIKeyLastUsedPeriod {
+		if err := svc.database.TouchAPIKey(ctx, model.DBConditionalKV{
+			Key:   model.DBGenericID,
+			Value: key.ID,
+		}, now); err != nil {
+			return false, err
+		}
+		key.LastUsedAt = &now
+	}
+
+	*dst = *key
+	return true, nil
+}
+
+func (svc Service) apiKeyContext(ctx context.Context) *model.APIKey {
+	if key, ok := ctx.Value(ctxAPIKey{}).(*model.APIKey); ok && key.ID != 0 {
+		return key
+	}
+	return nil
+}
+
+func apiKeyHash(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
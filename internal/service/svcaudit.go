@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+func (svc Service) audit(ctx context.Context, action, entity string, fn func(ctx context.Context, a *model.AddAudit) error) error {
+	ctx, err := svc.database.ContextTransactionBegin(ctx)
+	if err != nil {
+		return err
+	}
+	defer svc.database.ContextTransactionRollback(context.WithoutCancel(ctx))
+
+	a := model.AddAudit{
+		Actor:  svc.actor(ctx),
+		Action: action,
+		Entity: entity,
+	}
+	if err := fn(ctx, &a); err != nil {
+		if action == model.AuditActionDelete && errors.As(err, &model.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if err := svc.database.AddAudit(ctx, a, nil); err != nil {
+		return err
+	}
+
+	return svc.database.ContextTransactionCommit(ctx)
+}
+
+func (svc Service) ListAudit(ctx context.Context, params model.ListParams) ([]*model.Audit, error) {
+	if !svc.permitted(ctx, PermissionAdmin) {
+		return nil, permissionError(PermissionAdmin, "list audit")
+	}
+
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if pagination := params.Pagination; pagination != nil {
+		if pagination.Limit > model.AuditPaginationMax {
+			pagination.Limit = model.AuditPaginationMax
+		}
+	}
+
+	return svc.database.ListAudit(ctx, params)
+}
+
+func (svc Service) CountAudit(ctx context.Context, conds any) (int, error) {
+	if !svc.permitted(ctx, PermissionAdmin) {
+		return -1, permissionError(PermissionAdmin, "count audit")
+	}
+
+	return svc.database.CountAudit(ctx, conds)
+}
+
+// actor qualifies the subject with its issuer, as the same subject from two
+// trusted issuers are not the same actor.
+func (svc Service) actor(ctx context.Context) string {
+	if key := svc.apiKeyContext(ctx); key != nil {
+		return "api_key:" + key.Name
+	}
+	sub := svc.oauth.SubjectContext(ctx)
+	if iss := svc.oauth.IssuerContext(ctx); iss != "" {
+		return iss + "#" + sub
+	}
+	return sub
+}
@@ -3,20 +3,31 @@ package service
 import (
 	"context"
 	"slices"
+	"time"
 
 	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+	"github.com/mahmudindes/orenocomic-donoengine/internal/utila"
 )
 
 func (svc Service) AddCategoryType(ctx context.Context, data model.AddCategoryType, v *model.CategoryType) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to add category type")
+	if !svc.permitted(ctx, PermissionTaxonomyWrite) {
+		return permissionError(PermissionTaxonomyWrite, "add category type")
 	}
 
 	if err := data.Validate(); err != nil {
 		return err
 	}
 
-	return svc.database.AddCategoryType(ctx, data, v)
+	if v == nil {
+		v = new(model.CategoryType)
+	}
+	return svc.audit(ctx, model.AuditActionAdd, model.AuditEntityCategoryType, func(ctx context.Context, a *model.AddAudit) error {
+		if err := svc.database.AddCategoryType(ctx, data, v); err != nil {
+			return err
+		}
+		a.Key, a.After = v.Code, v
+		return nil
+	})
 }
 
 func (svc Service) GetCategoryTypeByCode(ctx context.Context, code string) (*model.CategoryType, error) {
@@ -27,29 +38,50 @@ func (svc Service) GetCategoryTypeByCode(ctx context.Context, code string) (*mod
 }
 
 func (svc Service) UpdateCategoryTypeByCode(ctx context.Context, code string, data model.SetCategoryType, v *model.CategoryType) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to update category type")
+	if !svc.permitted(ctx, PermissionTaxonomyWrite) {
+		return permissionError(PermissionTaxonomyWrite, "update category type")
 	}
 
 	if err := data.Validate(); err != nil {
 		return err
 	}
 
-	return svc.database.UpdateCategoryType(ctx, data, model.DBConditionalKV{
+	conds := model.DBConditionalKV{
 		Key:   model.DBCategoryTypeCode,
 		Value: code,
-	}, v)
+	}
+	if v == nil {
+		v = new(model.CategoryType)
+	}
+	return svc.audit(ctx, model.AuditActionUpdate, model.AuditEntityCategoryType, func(ctx context.Context, a *model.AddAudit) error {
+		before, err := svc.database.GetCategoryType(ctx, conds)
+		if err != nil {
+			return err
+		}
+		if err := svc.database.UpdateCategoryType(ctx, data, conds, v); err != nil {
+			return err
+		}
+		a.Key, a.Before, a.After = code, before, v
+		return nil
+	})
 }
 
 func (svc Service) DeleteCategoryTypeByCode(ctx context.Context, code string) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to delete category type")
+	if !svc.permitted(ctx, PermissionTaxonomyWrite) {
+		return permissionError(PermissionTaxonomyWrite, "delete category type")
 	}
 
-	return svc.database.DeleteCategoryType(ctx, model.DBConditionalKV{
-		Key:   model.DBCategoryTypeCode,
-		Value: code,
-	}, nil)
+	return svc.audit(ctx, model.AuditActionDelete, model.AuditEntityCategoryType, func(ctx context.Context, a *model.AddAudit) error {
+		before := new(model.CategoryType)
+		if err := svc.database.DeleteCategoryType(ctx, model.DBConditionalKV{
+			Key:   model.DBCategoryTypeCode,
+			Value: code,
+		}, before); err != nil {
+			return err
+		}
+		a.Key, a.Before = code, before
+		return nil
+	})
 }
 
 func (svc Service) ListCategoryType(ctx context.Context, params model.ListParams) ([]*model.CategoryType, error) {
@@ -81,19 +113,26 @@ func (svc Service) CountCategoryType(ctx context.Context, conds any) (int, error
 }
 
 func (svc Service) AddCategory(ctx context.Context, data model.AddCategory, v *model.Category) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to add category")
+	if !svc.permitted(ctx, PermissionTaxonomyWrite) {
+		return permissionError(PermissionTaxonomyWrite, "add category")
 	}
 
 	if err := data.Validate(); err != nil {
 		return err
 	}
 
-	if v != nil {
-		v.Relations = []*model.CategoryRelation{}
+	if v == nil {
+		v = new(model.Category)
 	}
+	v.Relations = []*model.CategoryRelation{}
 
-	return svc.database.AddCategory(ctx, data, v)
+	return svc.audit(ctx, model.AuditActionAdd, model.AuditEntityCategory, func(ctx context.Context, a *model.AddAudit) error {
+		if err := svc.database.AddCategory(ctx, data, v); err != nil {
+			return err
+		}
+		a.Key, a.After = utila.Utoa(v.TypeID)+"-"+v.Code, v
+		return nil
+	})
 }
 
 func (svc Service) GetCategoryBySID(ctx context.Context, sid model.CategorySID) (*model.Category, error) {
@@ -112,21 +151,25 @@ func (svc Service) GetCategoryBySID(ctx context.Context, sid model.CategorySID)
 		return nil, err
 	}
 
+	return svc.categoryDetail(ctx, *result)
+}
+
+func (svc Service) categoryDetail(ctx context.Context, m model.Category) (*model.Category, error) {
 	relations, err := svc.ListCategoryRelation(ctx, model.ListParams{
-		Conditions: model.DBConditionalKV{Key: model.DBCategoryRelationParentID, Value: result.ID},
+		Conditions: model.DBConditionalKV{Key: model.DBCategoryRelationParentID, Value: m.ID},
 		Pagination: &model.Pagination{},
 	})
 	if err != nil {
 		return nil, err
 	}
-	result.Relations = relations
+	m.Relations = relations
 
-	return result, nil
+	return &m, nil
 }
 
 func (svc Service) UpdateCategoryBySID(ctx context.Context, sid model.CategorySID, data model.SetCategory, v *model.Category) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to update category")
+	if !svc.permitted(ctx, PermissionTaxonomyWrite) {
+		return permissionError(PermissionTaxonomyWrite, "update category")
 	}
 
 	if err := data.Validate(); err != nil {
@@ -140,30 +183,52 @@ func (svc Service) UpdateCategoryBySID(ctx context.Context, sid model.CategorySI
 	case sid.TypeCode != nil:
 		typeID = model.DBCategoryTypeCodeToID(*sid.TypeCode)
 	}
-	if err := svc.database.UpdateCategory(ctx, data, map[string]any{
+	conds := map[string]any{
 		model.DBCategoryTypeID: typeID,
 		model.DBCategoryCode:   sid.Code,
-	}, v); err != nil {
-		return err
 	}
-
-	if v != nil {
-		relations, err := svc.ListCategoryRelation(ctx, model.ListParams{
-			Conditions: model.DBConditionalKV{Key: model.DBCategoryRelationParentID, Value: v.ID},
-			Pagination: &model.Pagination{},
+	if v == nil {
+		v = new(model.Category)
+	}
+	if err := svc.audit(ctx, model.AuditActionUpdate, model.AuditEntityCategory, func(ctx context.Context, a *model.AddAudit) error {
+		before, err := svc.database.GetCategory(ctx, conds)
+		if err != nil {
+			return preconditionError(ctx, err)
+		}
+		conds, err := precondition(ctx, conds, func() (any, *time.Time, error) {
+			current, err := svc.categoryDetail(ctx, *before)
+			if err != nil {
+				return nil, nil, err
+			}
+			return current, before.UpdatedAt, nil
 		})
 		if err != nil {
 			return err
 		}
-		v.Relations = relations
+		if err := svc.database.UpdateCategory(ctx, data, conds, v); err != nil {
+			return preconditionError(ctx, err)
+		}
+		a.Key, a.Before, a.After = utila.Utoa(before.TypeID)+"-"+before.Code, before, v
+		return nil
+	}); err != nil {
+		return err
 	}
 
+	relations, err := svc.ListCategoryRelation(ctx, model.ListParams{
+		Conditions: model.DBConditionalKV{Key: model.DBCategoryRelationParentID, Value: v.ID},
+		Pagination: &model.Pagination{},
+	})
+	if err != nil {
+		return err
+	}
+	v.Relations = relations
+
 	return nil
 }
 
 func (svc Service) DeleteCategoryBySID(ctx context.Context, sid model.CategorySID) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to delete category")
+	if !svc.permitted(ctx, PermissionTaxonomyWrite) {
+		return permissionError(PermissionTaxonomyWrite, "delete category")
 	}
 
 	var typeID any
@@ -173,15 +238,77 @@ func (svc Service) DeleteCategoryBySID(ctx context.Context, sid model.CategorySI
 	case sid.TypeCode != nil:
 		typeID = model.DBCategoryTypeCodeToID(*sid.TypeCode)
 	}
-	return svc.database.DeleteCategory(ctx, map[string]any{
+	conds := map[string]any{
 		model.DBCategoryTypeID: typeID,
 		model.DBCategoryCode:   sid.Code,
-	}, nil)
+	}
+	return svc.audit(ctx, model.AuditActionDelete, model.AuditEntityCategory, func(ctx context.Context, a *model.AddAudit) error {
+		conds, err := precondition(ctx, conds, func() (any, *time.Time, error) {
+			current, err := svc.database.GetCategory(ctx, conds)
+			if err != nil {
+				return nil, nil, err
+			}
+			detail, err := svc.categoryDetail(ctx, *current)
+			if err != nil {
+				return nil, nil, err
+			}
+			return detail, current.UpdatedAt, nil
+		})
+		if err != nil {
+			return err
+		}
+		before := new(model.Category)
+		if err := svc.database.DeleteCategory(ctx, conds, before); err != nil {
+			return preconditionError(ctx, err)
+		}
+		a.Key, a.Before = utila.Utoa(before.TypeID)+"-"+before.Code, before
+		return nil
+	})
 }
 
-func (svc Service) ListCategory(ctx context.Context, params model.ListParams) ([]*model.Category, error) {
+func (svc Service) RestoreCategoryBySID(ctx context.Context, sid model.CategorySID, v *model.Category) error {
+	if !svc.permitted(ctx, PermissionTaxonomyWrite) {
+		return permissionError(PermissionTaxonomyWrite, "restore category")
+	}
+
+	var typeID any
+	switch {
+	case sid.TypeID != nil:
+		typeID = sid.TypeID
+	case sid.TypeCode != nil:
+		typeID = model.DBCategoryTypeCodeToID(*sid.TypeCode)
+	}
+	if v == nil {
+		v = new(model.Category)
+	}
+	if err := svc.audit(ctx, model.AuditActionRestore, model.AuditEntityCategory, func(ctx context.Context, a *model.AddAudit) error {
+		if err := svc.database.RestoreCategory(ctx, map[string]any{
+			model.DBCategoryTypeID: typeID,
+			model.DBCategoryCode:   sid.Code,
+		}, v); err != nil {
+			return err
+		}
+		a.Key, a.After = utila.Utoa(v.TypeID)+"-"+v.Code, v
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	relations, err := svc.ListCategoryRelation(ctx, model.ListParams{
+		Conditions: model.DBConditionalKV{Key: model.DBCategoryRelationParentID, Value: v.ID},
+		Pagination: &model.Pagination{},
+	})
+	if err != nil {
+		return err
+	}
+	v.Relations = relations
+
+	return nil
+}
+
+func (svc Service) ListCategory(ctx context.Context, params model.ListParams) ([]*model.Category, []any, error) {
 	if err := params.Validate(); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	params.OrderBys = slices.DeleteFunc(params.OrderBys, func(ob model.OrderBy) bool {
@@ -200,26 +327,25 @@ func (svc Service) ListCategory(ctx context.Context, params model.ListParams) ([
 		}
 	}
 
-	result, err := svc.database.ListCategory(ctx, params)
+	result, next, err := svc.database.ListCategory(ctx, params)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if len(result) > 0 {
-		conds := make([]any, len(result)+1)
-		conds = append(conds, model.DBLogicalOR{})
+		ids := make([]uint, 0, len(result))
 		for _, r := range result {
-			conds = append(conds, model.DBConditionalKV{
-				Key:   model.DBCategoryRelationParentID,
-				Value: r.ID,
-			})
+			ids = append(ids, r.ID)
 		}
 		relations, err := svc.database.ListCategoryRelation(ctx, model.ListParams{
-			Conditions: conds,
+			Conditions: model.DBConditionalKV{
+				Key:   model.DBCategoryRelationParentID,
+				Value: model.DBIn{Value: ids},
+			},
 			Pagination: &model.Pagination{},
 		})
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		for _, r := range result {
 			r.Relations = []*model.CategoryRelation{}
@@ -233,7 +359,7 @@ func (svc Service) ListCategory(ctx context.Context, params model.ListParams) ([
 		}
 	}
 
-	return result, nil
+	return result, next, nil
 }
 
 func (svc Service) CountCategory(ctx context.Context, conds any) (int, error) {
@@ -241,15 +367,24 @@ func (svc Service) CountCategory(ctx context.Context, conds any) (int, error) {
 }
 
 func (svc Service) AddCategoryRelation(ctx context.Context, data model.AddCategoryRelation, v *model.CategoryRelation) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to add category relation")
+	if !svc.permitted(ctx, PermissionTaxonomyWrite) {
+		return permissionError(PermissionTaxonomyWrite, "add category relation")
 	}
 
 	if err := data.Validate(); err != nil {
 		return err
 	}
 
-	return svc.database.AddCategoryRelation(ctx, data, v)
+	if v == nil {
+		v = new(model.CategoryRelation)
+	}
+	return svc.audit(ctx, model.AuditActionAdd, model.AuditEntityCategoryRelation, func(ctx context.Context, a *model.AddAudit) error {
+		if err := svc.database.AddCategoryRelation(ctx, data, v); err != nil {
+			return err
+		}
+		a.Key, a.After = utila.Utoa(v.ParentID)+"-"+utila.Utoa(v.ChildID), v
+		return nil
+	})
 }
 
 func (svc Service) GetCategoryRelationBySID(ctx context.Context, sid model.CategoryRelationSID) (*model.CategoryRelation, error) {
@@ -282,8 +417,8 @@ func (svc Service) GetCategoryRelationBySID(ctx context.Context, sid model.Categ
 }
 
 func (svc Service) UpdateCategoryRelationBySID(ctx context.Context, sid model.CategoryRelationSID, data model.SetCategoryRelation, v *model.CategoryRelation) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to update category relation")
+	if !svc.permitted(ctx, PermissionTaxonomyWrite) {
+		return permissionError(PermissionTaxonomyWrite, "update category relation")
 	}
 
 	if err := data.Validate(); err != nil {
@@ -312,15 +447,29 @@ func (svc Service) UpdateCategoryRelationBySID(ctx context.Context, sid model.Ca
 			Code:     *sid.ChildCode,
 		})
 	}
-	return svc.database.UpdateCategoryRelation(ctx, data, map[string]any{
+	conds := map[string]any{
 		model.DBCategoryRelationParentID: parentID,
 		model.DBCategoryRelationChildID:  childID,
-	}, v)
+	}
+	if v == nil {
+		v = new(model.CategoryRelation)
+	}
+	return svc.audit(ctx, model.AuditActionUpdate, model.AuditEntityCategoryRelation, func(ctx context.Context, a *model.AddAudit) error {
+		before, err := svc.database.GetCategoryRelation(ctx, conds)
+		if err != nil {
+			return err
+		}
+		if err := svc.database.UpdateCategoryRelation(ctx, data, conds, v); err != nil {
+			return err
+		}
+		a.Key, a.Before, a.After = utila.Utoa(before.ParentID)+"-"+utila.Utoa(before.ChildID), before, v
+		return nil
+	})
 }
 
 func (svc Service) DeleteCategoryRelationBySID(ctx context.Context, sid model.CategoryRelationSID) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to delete category relation")
+	if !svc.permitted(ctx, PermissionTaxonomyWrite) {
+		return permissionError(PermissionTaxonomyWrite, "delete category relation")
 	}
 
 	var parentID any
@@ -345,10 +494,17 @@ func (svc Service) DeleteCategoryRelationBySID(ctx context.Context, sid model.Ca
 			Code:     *sid.ChildCode,
 		})
 	}
-	return svc.database.DeleteCategoryRelation(ctx, map[string]any{
-		model.DBCategoryRelationParentID: parentID,
-		model.DBCategoryRelationChildID:  childID,
-	}, nil)
+	return svc.audit(ctx, model.AuditActionDelete, model.AuditEntityCategoryRelation, func(ctx context.Context, a *model.AddAudit) error {
+		before := new(model.CategoryRelation)
+		if err := svc.database.DeleteCategoryRelation(ctx, map[string]any{
+			model.DBCategoryRelationParentID: parentID,
+			model.DBCategoryRelationChildID:  childID,
+		}, before); err != nil {
+			return err
+		}
+		a.Key, a.Before = utila.Utoa(before.ParentID)+"-"+utila.Utoa(before.ChildID), before
+		return nil
+	})
 }
 
 func (svc Service) ListCategoryRelation(ctx context.Context, params model.ListParams) ([]*model.CategoryRelation, error) {
@@ -2,11 +2,15 @@ package service
 
 import (
 	"context"
+	"errors"
 	"slices"
+	"strconv"
+	"time"
 
 	"golang.org/x/sync/errgroup"
 
 	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+	"github.com/mahmudindes/orenocomic-donoengine/internal/utila"
 )
 
 //
@@ -14,365 +18,251 @@ import (
 //
 
 func (svc Service) AddComic(ctx context.Context, data model.AddComic, v *model.Comic) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to add comic")
+	if !svc.permitted(ctx, PermissionComicWrite) {
+		return permissionError(PermissionComicWrite, "add comic")
 	}
 
 	if err := data.Validate(); err != nil {
 		return err
 	}
 
-	if v != nil {
-		v.Titles = []*model.ComicTitle{}
-		v.Covers = []*model.ComicCover{}
-		v.Synopses = []*model.ComicSynopsis{}
-		v.Chapters = []*model.ComicChapter{}
-		v.Externals = []*model.ComicExternal{}
-		v.Categories = []*model.Category{}
-		v.Tags = []*model.Tag{}
-		v.Relations = []*model.ComicRelation{}
+	if err := svc.validateJSONSchema(model.JSONSchemaComicAdditionals, "additionals", data.Additionals); err != nil {
+		return err
 	}
 
-	return svc.database.AddComic(ctx, data, v)
-}
+	if v == nil {
+		v = new(model.Comic)
+	}
+	v.Titles = []*model.ComicTitle{}
+	v.Covers = []*model.ComicCover{}
+	v.Synopses = []*model.ComicSynopsis{}
+	v.Chapters = []*model.ComicChapter{}
+	v.Externals = []*model.ComicExternal{}
+	v.Categories = []*model.Category{}
+	v.Tags = []*model.Tag{}
+	v.Relations = []*model.ComicRelation{}
 
-func (svc Service) GetComicByCode(ctx context.Context, code string) (*model.Comic, error) {
-	result, err := svc.database.GetComic(ctx, model.DBConditionalKV{
-		Key:   model.DBComicCode,
-		Value: code,
-	})
+	ctx, err := svc.database.ContextTransactionBegin(ctx)
 	if err != nil {
-		return nil, err
+		return err
 	}
+	defer svc.database.ContextTransactionRollback(context.WithoutCancel(ctx))
 
-	g, gctx := errgroup.WithContext(ctx)
-	g.Go(func() error {
-		titles, err := svc.database.ListComicTitle(gctx, model.ListParams{
-			Conditions: model.DBConditionalKV{Key: model.DBComicGenericComicID, Value: result.ID},
-			Pagination: &model.Pagination{},
-		})
-		if err != nil {
-			return err
-		}
-
-		result.Titles = titles
-		return nil
-	})
-	g.Go(func() error {
-		covers, err := svc.database.ListComicCover(gctx, model.ListParams{
-			Conditions: model.DBConditionalKV{Key: model.DBComicGenericComicID, Value: result.ID},
-			Pagination: &model.Pagination{},
-		})
-		if err != nil {
-			return err
-		}
-
-		result.Covers = covers
-		return nil
-	})
-	g.Go(func() error {
-		synopses, err := svc.database.ListComicSynopsis(gctx, model.ListParams{
-			Conditions: model.DBConditionalKV{Key: model.DBComicGenericComicID, Value: result.ID},
-			Pagination: &model.Pagination{},
-		})
-		if err != nil {
+	err = svc.audit(ctx, model.AuditActionAdd, model.AuditEntityComic, func(ctx context.Context, a *model.AddAudit) error {
+		if err := svc.database.AddComic(ctx, data, v); err != nil {
 			return err
 		}
-
-		result.Synopses = synopses
+		a.Key, a.After = v.Code, v
 		return nil
 	})
-	g.Go(func() error {
-		chapters, err := svc.database.ListComicChapter(gctx, model.ListParams{
-			Conditions: model.DBConditionalKV{Key: model.DBComicGenericComicID, Value: result.ID},
-			Pagination: &model.Pagination{},
-		})
-		if err != nil {
-			return err
-		}
+	if err != nil {
+		return err
+	}
 
-		result.Chapters = chapters
-		return nil
-	})
-	g.Go(func() error {
-		externals, err := svc.database.ListComicExternal(gctx, model.ListParams{
-			Conditions: model.DBConditionalKV{Key: model.DBComicGenericComicID, Value: result.ID},
-			Pagination: &model.Pagination{},
-		})
-		if err != nil {
-			return err
-		}
+	if err := svc.addComicChildren(ctx, v.ID, data); err != nil {
+		return err
+	}
 
-		result.Externals = externals
-		return nil
-	})
-	g.Go(func() error {
-		categories0, err := svc.database.ListComicCategory(gctx, model.ListParams{
-			Conditions: model.DBConditionalKV{Key: model.DBComicGenericComicID, Value: result.ID},
-			Pagination: &model.Pagination{},
+	if len(data.Titles) > 0 || len(data.Covers) > 0 || len(data.Synopses) > 0 ||
+		len(data.Externals) > 0 || len(data.Categories) > 0 || len(data.Tags) > 0 {
+		result, err := svc.database.GetComicDetail(ctx, model.DBConditionalKV{
+			Key:   model.DBGenericID,
+			Value: v.ID,
 		})
 		if err != nil {
 			return err
 		}
+		*v = *result
+	}
 
-		if len(categories0) == 0 {
-			result.Categories = []*model.Category{}
-			return nil
-		}
-
-		conditions := make([]any, len(categories0)+2)
-		conditions = append(conditions, model.DBLogicalOR{})
-		for _, category := range categories0 {
-			conditions = append(conditions, model.DBConditionalKV{
-				Key:   model.DBGenericID,
-				Value: category.CategoryID,
-			})
-		}
+	return svc.database.ContextTransactionCommit(ctx)
+}
 
-		categories1, err := svc.database.ListCategory(gctx, model.ListParams{
-			Conditions: conditions,
-			Pagination: &model.Pagination{},
-		})
-		if err != nil {
-			return err
+func (svc Service) addComicChildren(ctx context.Context, comicID uint, data model.AddComic) error {
+	for i, child := range data.Titles {
+		child.ComicID, child.ComicCode = &comicID, nil
+		if err := svc.AddComicTitle(ctx, child, nil); err != nil {
+			return comicChildError(err, "titles", i)
 		}
-
-		result.Categories = categories1
-		return nil
-	})
-	g.Go(func() error {
-		tags0, err := svc.database.ListComicTag(gctx, model.ListParams{
-			Conditions: model.DBConditionalKV{Key: model.DBComicGenericComicID, Value: result.ID},
-			Pagination: &model.Pagination{},
-		})
-		if err != nil {
-			return err
+	}
+	for i, child := range data.Covers {
+		child.ComicID, child.ComicCode = &comicID, nil
+		if err := svc.AddComicCover(ctx, child, nil); err != nil {
+			return comicChildError(err, "covers", i)
 		}
-
-		if len(tags0) == 0 {
-			result.Tags = []*model.Tag{}
-			return nil
+	}
+	for i, child := range data.Synopses {
+		child.ComicID, child.ComicCode = &comicID, nil
+		if err := svc.AddComicSynopsis(ctx, child, nil); err != nil {
+			return comicChildError(err, "synopses", i)
 		}
-
-		conditions := make([]any, len(tags0)+2)
-		conditions = append(conditions, model.DBLogicalOR{})
-		for _, tag := range tags0 {
-			conditions = append(conditions, model.DBConditionalKV{
-				Key:   model.DBGenericID,
-				Value: tag.TagID,
-			})
+	}
+	for i, child := range data.Externals {
+		child.ComicID, child.ComicCode = &comicID, nil
+		if err := svc.AddComicExternal(ctx, child, nil); err != nil {
+			return comicChildError(err, "externals", i)
 		}
-
-		tags1, err := svc.database.ListTag(gctx, model.ListParams{
-			Conditions: conditions,
-			Pagination: &model.Pagination{},
-		})
-		if err != nil {
-			return err
+	}
+	for i, child := range data.Categories {
+		child.ComicID, child.ComicCode = &comicID, nil
+		if err := svc.AddComicCategory(ctx, child, nil); err != nil {
+			return comicChildError(err, "categories", i)
 		}
-
-		result.Tags = tags1
-		return nil
-	})
-	g.Go(func() error {
-		relations, err := svc.database.ListComicRelation(gctx, model.ListParams{
-			Conditions: model.DBConditionalKV{Key: model.DBComicRelationParentID, Value: result.ID},
-			Pagination: &model.Pagination{},
-		})
-		if err != nil {
-			return err
+	}
+	for i, child := range data.Tags {
+		child.ComicID, child.ComicCode = &comicID, nil
+		if err := svc.AddComicTag(ctx, child, nil); err != nil {
+			return comicChildError(err, "tags", i)
 		}
+	}
+	return nil
+}
 
-		result.Relations = relations
-		return nil
-	})
-	if err := g.Wait(); err != nil {
-		return nil, err
+func comicChildError(err error, field string, index int) error {
+	if errors.As(err, &model.ErrGeneric) {
+		return model.WrappedError(err, field+"["+strconv.Itoa(index)+"]: "+err.Error())
 	}
+	return err
+}
 
-	return result, nil
+func (svc Service) GetComicByCode(ctx context.Context, code string) (*model.Comic, error) {
+	return svc.database.GetComicDetail(ctx, model.DBConditionalKV{
+		Key:   model.DBComicCode,
+		Value: code,
+	})
 }
 
 func (svc Service) UpdateComicByCode(ctx context.Context, code string, data model.SetComic, v *model.Comic) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to update comic")
+	if !svc.permitted(ctx, PermissionComicWrite) {
+		return permissionError(PermissionComicWrite, "update comic")
 	}
 
 	if err := data.Validate(); err != nil {
 		return err
 	}
 
-	if err := svc.database.UpdateComic(ctx, data, model.DBConditionalKV{
+	conds := model.DBConditionalKV{
 		Key:   model.DBComicCode,
 		Value: code,
-	}, v); err != nil {
-		return err
 	}
-
-	if v != nil {
-		g, gctx := errgroup.WithContext(ctx)
-		g.Go(func() error {
-			titles, err := svc.database.ListComicTitle(gctx, model.ListParams{
-				Conditions: model.DBConditionalKV{Key: model.DBComicGenericComicID, Value: v.ID},
-				Pagination: &model.Pagination{},
-			})
-			if err != nil {
-				return err
-			}
-
-			v.Titles = titles
-			return nil
-		})
-		g.Go(func() error {
-			covers, err := svc.database.ListComicCover(gctx, model.ListParams{
-				Conditions: model.DBConditionalKV{Key: model.DBComicGenericComicID, Value: v.ID},
-				Pagination: &model.Pagination{},
-			})
-			if err != nil {
-				return err
-			}
-
-			v.Covers = covers
-			return nil
-		})
-		g.Go(func() error {
-			synopses, err := svc.database.ListComicSynopsis(gctx, model.ListParams{
-				Conditions: model.DBConditionalKV{Key: model.DBComicGenericComicID, Value: v.ID},
-				Pagination: &model.Pagination{},
-			})
+	if v == nil {
+		v = new(model.Comic)
+	}
+	if err := svc.audit(ctx, model.AuditActionUpdate, model.AuditEntityComic, func(ctx context.Context, a *model.AddAudit) error {
+		before, err := svc.database.GetComic(ctx, conds)
+		if err != nil {
+			return preconditionError(ctx, err)
+		}
+		conds, err := precondition(ctx, conds, func() (any, *time.Time, error) {
+			current, err := svc.database.GetComicDetail(ctx, conds)
 			if err != nil {
-				return err
+				return nil, nil, err
 			}
-
-			v.Synopses = synopses
-			return nil
+			return current, before.UpdatedAt, nil
 		})
-		g.Go(func() error {
-			chapters, err := svc.database.ListComicChapter(gctx, model.ListParams{
-				Conditions: model.DBConditionalKV{Key: model.DBComicGenericComicID, Value: v.ID},
-				Pagination: &model.Pagination{},
-			})
-			if err != nil {
-				return err
-			}
+		if err != nil {
+			return err
+		}
 
-			v.Chapters = chapters
-			return nil
-		})
-		g.Go(func() error {
-			externals, err := svc.database.ListComicExternal(gctx, model.ListParams{
-				Conditions: model.DBConditionalKV{Key: model.DBComicGenericComicID, Value: v.ID},
-				Pagination: &model.Pagination{},
-			})
-			if err != nil {
+		if slices.Contains(data.SetNull, model.DBComicAdditionals) {
+			if err := svc.validateJSONSchema(model.JSONSchemaComicAdditionals, "additionals", nil); err != nil {
 				return err
 			}
-
-			v.Externals = externals
-			return nil
-		})
-		g.Go(func() error {
-			categories0, err := svc.database.ListComicCategory(gctx, model.ListParams{
-				Conditions: model.DBConditionalKV{Key: model.DBComicGenericComicID, Value: v.ID},
-				Pagination: &model.Pagination{},
-			})
-			if err != nil {
+		} else if data.Additionals != nil {
+			additionals := mergeJSON(before.Additionals, data.Additionals)
+			if err := svc.validateJSONSchema(model.JSONSchemaComicAdditionals, "additionals", additionals); err != nil {
 				return err
 			}
+		}
 
-			if len(categories0) == 0 {
-				v.Categories = []*model.Category{}
-				return nil
-			}
-
-			conditions := make([]any, len(categories0)+2)
-			conditions = append(conditions, model.DBLogicalOR{})
-			for _, category := range categories0 {
-				conditions = append(conditions, model.DBConditionalKV{
-					Key:   model.DBGenericID,
-					Value: category.CategoryID,
-				})
-			}
-
-			categories1, err := svc.database.ListCategory(gctx, model.ListParams{
-				Conditions: conditions,
-				Pagination: &model.Pagination{},
-			})
-			if err != nil {
-				return err
-			}
+		if err := svc.database.UpdateComic(ctx, data, conds, v); err != nil {
+			return preconditionError(ctx, err)
+		}
+		a.Key, a.Before, a.After = code, before, v
+		return nil
+	}); err != nil {
+		return err
+	}
 
-			v.Categories = categories1
-			return nil
-		})
-		g.Go(func() error {
-			tags0, err := svc.database.ListComicTag(gctx, model.ListParams{
-				Conditions: model.DBConditionalKV{Key: model.DBComicGenericComicID, Value: v.ID},
-				Pagination: &model.Pagination{},
-			})
-			if err != nil {
-				return err
-			}
+	result, err := svc.database.GetComicDetail(ctx, model.DBConditionalKV{
+		Key:   model.DBGenericID,
+		Value: v.ID,
+	})
+	if err != nil {
+		return err
+	}
 
-			if len(tags0) == 0 {
-				v.Tags = []*model.Tag{}
-				return nil
-			}
+	*v = *result
 
-			conditions := make([]any, len(tags0)+2)
-			conditions = append(conditions, model.DBLogicalOR{})
-			for _, tag := range tags0 {
-				conditions = append(conditions, model.DBConditionalKV{
-					Key:   model.DBGenericID,
-					Value: tag.TagID,
-				})
-			}
+	return nil
+}
 
-			tags1, err := svc.database.ListTag(gctx, model.ListParams{
-				Conditions: conditions,
-				Pagination: &model.Pagination{},
-			})
-			if err != nil {
-				return err
-			}
+func (svc Service) DeleteComicByCode(ctx context.Context, code string) error {
+	if !svc.permitted(ctx, PermissionComicDelete) {
+		return permissionError(PermissionComicDelete, "delete comic")
+	}
 
-			v.Tags = tags1
-			return nil
-		})
-		g.Go(func() error {
-			relations, err := svc.database.ListComicRelation(gctx, model.ListParams{
-				Conditions: model.DBConditionalKV{Key: model.DBComicRelationParentID, Value: v.ID},
-				Pagination: &model.Pagination{},
-			})
+	conds := model.DBConditionalKV{
+		Key:   model.DBComicCode,
+		Value: code,
+	}
+	return svc.audit(ctx, model.AuditActionDelete, model.AuditEntityComic, func(ctx context.Context, a *model.AddAudit) error {
+		conds, err := precondition(ctx, conds, func() (any, *time.Time, error) {
+			current, err := svc.database.GetComicDetail(ctx, conds)
 			if err != nil {
-				return err
+				return nil, nil, err
 			}
-
-			v.Relations = relations
-			return nil
+			return current, current.UpdatedAt, nil
 		})
-		if err := g.Wait(); err != nil {
+		if err != nil {
 			return err
 		}
+		before := new(model.Comic)
+		if err := svc.database.DeleteComic(ctx, conds, before); err != nil {
+			return preconditionError(ctx, err)
+		}
+		a.Key, a.Before = code, before
+		return nil
+	})
+}
+
+func (svc Service) RestoreComicByCode(ctx context.Context, code string, v *model.Comic) error {
+	if !svc.permitted(ctx, PermissionComicDelete) {
+		return permissionError(PermissionComicDelete, "restore comic")
 	}
 
-	return nil
-}
+	if v == nil {
+		v = new(model.Comic)
+	}
+	if err := svc.audit(ctx, model.AuditActionRestore, model.AuditEntityComic, func(ctx context.Context, a *model.AddAudit) error {
+		if err := svc.database.RestoreComic(ctx, model.DBConditionalKV{
+			Key:   model.DBComicCode,
+			Value: code,
+		}, v); err != nil {
+			return err
+		}
+		a.Key, a.After = code, v
+		return nil
+	}); err != nil {
+		return err
+	}
 
-func (svc Service) DeleteComicByCode(ctx context.Context, code string) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to delete comic")
+	result, err := svc.database.GetComicDetail(ctx, model.DBConditionalKV{
+		Key:   model.DBGenericID,
+		Value: v.ID,
+	})
+	if err != nil {
+		return err
 	}
 
-	return svc.database.DeleteComic(ctx, model.DBConditionalKV{
-		Key:   model.DBComicCode,
-		Value: code,
-	}, nil)
+	*v = *result
+
+	return nil
 }
 
-func (svc Service) ListComic(ctx context.Context, params model.ListParams) ([]*model.Comic, error) {
+func (svc Service) ListComic(ctx context.Context, params model.ListParams) ([]*model.Comic, []any, error) {
 	if err := params.Validate(); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	params.OrderBys = slices.DeleteFunc(params.OrderBys, func(ob model.OrderBy) bool {
@@ -391,19 +281,19 @@ func (svc Service) ListComic(ctx context.Context, params model.ListParams) ([]*m
 		}
 	}
 
-	result, err := svc.database.ListComic(ctx, params)
+	result, next, err := svc.database.ListComic(ctx, params)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if len(result) > 0 {
-		conds := make([]any, len(result)+1)
-		conds = append(conds, model.DBLogicalOR{})
+		ids := make([]uint, 0, len(result))
 		for _, r := range result {
-			conds = append(conds, model.DBConditionalKV{
-				Key:   model.DBComicGenericComicID,
-				Value: r.ID,
-			})
+			ids = append(ids, r.ID)
+		}
+		conds := model.DBConditionalKV{
+			Key:   model.DBComicGenericComicID,
+			Value: model.DBIn{Value: ids},
 		}
 		g, gctx := errgroup.WithContext(ctx)
 		g.Go(func() error {
@@ -467,7 +357,7 @@ func (svc Service) ListComic(ctx context.Context, params model.ListParams) ([]*m
 			return nil
 		})
 		g.Go(func() error {
-			chapters, err := svc.database.ListComicChapter(gctx, model.ListParams{
+			chapters, _, err := svc.database.ListComicChapter(gctx, model.ListParams{
 				Conditions: conds,
 				Pagination: &model.Pagination{},
 			})
@@ -518,15 +408,15 @@ func (svc Service) ListComic(ctx context.Context, params model.ListParams) ([]*m
 			for _, category := range categories0 {
 				categories[category.CategoryID] = nil
 			}
-			conditions := make([]any, len(categories0)+1)
+			categoryIDs := make([]uint, 0, len(categories))
 			for id := range categories {
-				conditions = append(conditions, model.DBConditionalKV{
-					Key:   model.DBGenericID,
-					Value: id,
-				})
+				categoryIDs = append(categoryIDs, id)
 			}
-			categories1, err := svc.database.ListCategory(gctx, model.ListParams{
-				Conditions: conditions,
+			categories1, _, err := svc.database.ListCategory(gctx, model.ListParams{
+				Conditions: model.DBConditionalKV{
+					Key:   model.DBGenericID,
+					Value: model.DBIn{Value: categoryIDs},
+				},
 				Pagination: &model.Pagination{},
 			})
 			if err != nil {
@@ -559,15 +449,15 @@ func (svc Service) ListComic(ctx context.Context, params model.ListParams) ([]*m
 			for _, tag := range tags0 {
 				tags[tag.TagID] = nil
 			}
-			conditions := make([]any, len(tags0)+1)
+			tagIDs := make([]uint, 0, len(tags))
 			for id := range tags {
-				conditions = append(conditions, model.DBConditionalKV{
-					Key:   model.DBGenericID,
-					Value: id,
-				})
+				tagIDs = append(tagIDs, id)
 			}
-			tags1, err := svc.database.ListTag(gctx, model.ListParams{
-				Conditions: conditions,
+			tags1, _, err := svc.database.ListTag(gctx, model.ListParams{
+				Conditions: model.DBConditionalKV{
+					Key:   model.DBGenericID,
+					Value: model.DBIn{Value: tagIDs},
+				},
 				Pagination: &model.Pagination{},
 			})
 			if err != nil {
@@ -589,16 +479,11 @@ func (svc Service) ListComic(ctx context.Context, params model.ListParams) ([]*m
 			return nil
 		})
 		g.Go(func() error {
-			conds := make([]any, len(result)+1)
-			conds = append(conds, model.DBLogicalOR{})
-			for _, r := range result {
-				conds = append(conds, model.DBConditionalKV{
-					Key:   model.DBComicRelationParentID,
-					Value: r.ID,
-				})
-			}
 			relations, err := svc.database.ListComicRelation(gctx, model.ListParams{
-				Conditions: conds,
+				Conditions: model.DBConditionalKV{
+					Key:   model.DBComicRelationParentID,
+					Value: model.DBIn{Value: ids},
+				},
 				Pagination: &model.Pagination{},
 			})
 			if err != nil {
@@ -617,11 +502,11 @@ func (svc Service) ListComic(ctx context.Context, params model.ListParams) ([]*m
 			return nil
 		})
 		if err := g.Wait(); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
-	return result, nil
+	return result, next, nil
 }
 
 func (svc Service) CountComic(ctx context.Context, conds any) (int, error) {
@@ -638,15 +523,24 @@ func (svc Service) ExistsComicByCode(ctx context.Context, code string) (bool, er
 // Comic Title
 
 func (svc Service) AddComicTitle(ctx context.Context, data model.AddComicTitle, v *model.ComicTitle) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to add comic title")
+	if !svc.permitted(ctx, PermissionComicWrite) {
+		return permissionError(PermissionComicWrite, "add comic title")
 	}
 
 	if err := data.Validate(); err != nil {
 		return err
 	}
 
-	return svc.database.AddComicTitle(ctx, data, v)
+	if v == nil {
+		v = new(model.ComicTitle)
+	}
+	return svc.audit(ctx, model.AuditActionAdd, model.AuditEntityComicTitle, func(ctx context.Context, a *model.AddAudit) error {
+		if err := svc.database.AddComicTitle(ctx, data, v); err != nil {
+			return err
+		}
+		a.Key, a.After = utila.Utoa(v.ComicID)+"/"+v.RID, v
+		return nil
+	})
 }
 
 func (svc Service) GetComicTitleBySID(ctx context.Context, sid model.ComicGenericSID) (*model.ComicTitle, error) {
@@ -664,8 +558,8 @@ func (svc Service) GetComicTitleBySID(ctx context.Context, sid model.ComicGeneri
 }
 
 func (svc Service) UpdateComicTitleBySID(ctx context.Context, sid model.ComicGenericSID, data model.SetComicTitle, v *model.ComicTitle) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to update comic title")
+	if !svc.permitted(ctx, PermissionComicWrite) {
+		return permissionError(PermissionComicWrite, "update comic title")
 	}
 
 	if err := data.Validate(); err != nil {
@@ -679,15 +573,29 @@ func (svc Service) UpdateComicTitleBySID(ctx context.Context, sid model.ComicGen
 	case sid.ComicCode != nil:
 		comicID = model.DBComicCodeToID(*sid.ComicCode)
 	}
-	return svc.database.UpdateComicTitle(ctx, data, map[string]any{
+	conds := map[string]any{
 		model.DBComicGenericComicID: comicID,
 		model.DBComicGenericRID:     sid.RID,
-	}, v)
+	}
+	if v == nil {
+		v = new(model.ComicTitle)
+	}
+	return svc.audit(ctx, model.AuditActionUpdate, model.AuditEntityComicTitle, func(ctx context.Context, a *model.AddAudit) error {
+		before, err := svc.database.GetComicTitle(ctx, conds)
+		if err != nil {
+			return err
+		}
+		if err := svc.database.UpdateComicTitle(ctx, data, conds, v); err != nil {
+			return err
+		}
+		a.Key, a.Before, a.After = utila.Utoa(before.ComicID)+"/"+before.RID, before, v
+		return nil
+	})
 }
 
 func (svc Service) DeleteComicTitleBySID(ctx context.Context, sid model.ComicGenericSID) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to delete comic title")
+	if !svc.permitted(ctx, PermissionComicWrite) {
+		return permissionError(PermissionComicWrite, "delete comic title")
 	}
 
 	var comicID any
@@ -697,10 +605,17 @@ func (svc Service) DeleteComicTitleBySID(ctx context.Context, sid model.ComicGen
 	case sid.ComicCode != nil:
 		comicID = model.DBComicCodeToID(*sid.ComicCode)
 	}
-	return svc.database.DeleteComicTitle(ctx, map[string]any{
-		model.DBComicGenericComicID: comicID,
-		model.DBComicGenericRID:     sid.RID,
-	}, nil)
+	return svc.audit(ctx, model.AuditActionDelete, model.AuditEntityComicTitle, func(ctx context.Context, a *model.AddAudit) error {
+		before := new(model.ComicTitle)
+		if err := svc.database.DeleteComicTitle(ctx, map[string]any{
+			model.DBComicGenericComicID: comicID,
+			model.DBComicGenericRID:     sid.RID,
+		}, before); err != nil {
+			return err
+		}
+		a.Key, a.Before = utila.Utoa(before.ComicID)+"/"+before.RID, before
+		return nil
+	})
 }
 
 func (svc Service) ListComicTitle(ctx context.Context, params model.ListParams) ([]*model.ComicTitle, error) {
@@ -734,15 +649,24 @@ func (svc Service) CountComicTitle(ctx context.Context, conds any) (int, error)
 // Comic Cover
 
 func (svc Service) AddComicCover(ctx context.Context, data model.AddComicCover, v *model.ComicCover) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to add comic cover")
+	if !svc.permitted(ctx, PermissionComicWrite) {
+		return permissionError(PermissionComicWrite, "add comic cover")
 	}
 
 	if err := data.Validate(); err != nil {
 		return err
 	}
 
-	return svc.database.AddComicCover(ctx, data, v)
+	if v == nil {
+		v = new(model.ComicCover)
+	}
+	return svc.audit(ctx, model.AuditActionAdd, model.AuditEntityComicCover, func(ctx context.Context, a *model.AddAudit) error {
+		if err := svc.database.AddComicCover(ctx, data, v); err != nil {
+			return err
+		}
+		a.Key, a.After = utila.Utoa(v.ComicID)+"/"+v.RID, v
+		return nil
+	})
 }
 
 func (svc Service) GetComicCoverBySID(ctx context.Context, sid model.ComicGenericSID) (*model.ComicCover, error) {
@@ -760,8 +684,8 @@ func (svc Service) GetComicCoverBySID(ctx context.Context, sid model.ComicGeneri
 }
 
 func (svc Service) UpdateComicCoverBySID(ctx context.Context, sid model.ComicGenericSID, data model.SetComicCover, v *model.ComicCover) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to update comic cover")
+	if !svc.permitted(ctx, PermissionComicWrite) {
+		return permissionError(PermissionComicWrite, "update comic cover")
 	}
 
 	if err := data.Validate(); err != nil {
@@ -775,15 +699,29 @@ func (svc Service) UpdateComicCoverBySID(ctx context.Context, sid model.ComicGen
 	case sid.ComicCode != nil:
 		comicID = model.DBComicCodeToID(*sid.ComicCode)
 	}
-	return svc.database.UpdateComicCover(ctx, data, map[string]any{
+	conds := map[string]any{
 		model.DBComicGenericComicID: comicID,
 		model.DBComicGenericRID:     sid.RID,
-	}, v)
+	}
+	if v == nil {
+		v = new(model.ComicCover)
+	}
+	return svc.audit(ctx, model.AuditActionUpdate, model.AuditEntityComicCover, func(ctx context.Context, a *model.AddAudit) error {
+		before, err := svc.database.GetComicCover(ctx, conds)
+		if err != nil {
+			return err
+		}
+		if err := svc.database.UpdateComicCover(ctx, data, conds, v); err != nil {
+			return err
+		}
+		a.Key, a.Before, a.After = utila.Utoa(before.ComicID)+"/"+before.RID, before, v
+		return nil
+	})
 }
 
 func (svc Service) DeleteComicCoverBySID(ctx context.Context, sid model.ComicGenericSID) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to delete comic cover")
+	if !svc.permitted(ctx, PermissionComicWrite) {
+		return permissionError(PermissionComicWrite, "delete comic cover")
 	}
 
 	var comicID any
@@ -793,10 +731,17 @@ func (svc Service) DeleteComicCoverBySID(ctx context.Context, sid model.ComicGen
 	case sid.ComicCode != nil:
 		comicID = model.DBComicCodeToID(*sid.ComicCode)
 	}
-	return svc.database.DeleteComicCover(ctx, map[string]any{
-		model.DBComicGenericComicID: comicID,
-		model.DBComicGenericRID:     sid.RID,
-	}, nil)
+	return svc.audit(ctx, model.AuditActionDelete, model.AuditEntityComicCover, func(ctx context.Context, a *model.AddAudit) error {
+		before := new(model.ComicCover)
+		if err := svc.database.DeleteComicCover(ctx, map[string]any{
+			model.DBComicGenericComicID: comicID,
+			model.DBComicGenericRID:     sid.RID,
+		}, before); err != nil {
+			return err
+		}
+		a.Key, a.Before = utila.Utoa(before.ComicID)+"/"+before.RID, before
+		return nil
+	})
 }
 
 func (svc Service) ListComicCover(ctx context.Context, params model.ListParams) ([]*model.ComicCover, error) {
@@ -830,15 +775,24 @@ func (svc Service) CountComicCover(ctx context.Context, conds any) (int, error)
 // Comic Synopsis
 
 func (svc Service) AddComicSynopsis(ctx context.Context, data model.AddComicSynopsis, v *model.ComicSynopsis) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to add comic synopsis")
+	if !svc.permitted(ctx, PermissionComicWrite) {
+		return permissionError(PermissionComicWrite, "add comic synopsis")
 	}
 
 	if err := data.Validate(); err != nil {
 		return err
 	}
 
-	return svc.database.AddComicSynopsis(ctx, data, v)
+	if v == nil {
+		v = new(model.ComicSynopsis)
+	}
+	return svc.audit(ctx, model.AuditActionAdd, model.AuditEntityComicSynopsis, func(ctx context.Context, a *model.AddAudit) error {
+		if err := svc.database.AddComicSynopsis(ctx, data, v); err != nil {
+			return err
+		}
+		a.Key, a.After = utila.Utoa(v.ComicID)+"/"+v.RID, v
+		return nil
+	})
 }
 
 func (svc Service) GetComicSynopsisBySID(ctx context.Context, sid model.ComicGenericSID) (*model.ComicSynopsis, error) {
@@ -856,8 +810,8 @@ func (svc Service) GetComicSynopsisBySID(ctx context.Context, sid model.ComicGen
 }
 
 func (svc Service) UpdateComicSynopsisBySID(ctx context.Context, sid model.ComicGenericSID, data model.SetComicSynopsis, v *model.ComicSynopsis) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to update comic synopsis")
+	if !svc.permitted(ctx, PermissionComicWrite) {
+		return permissionError(PermissionComicWrite, "update comic synopsis")
 	}
 
 	if err := data.Validate(); err != nil {
@@ -871,15 +825,29 @@ func (svc Service) UpdateComicSynopsisBySID(ctx context.Context, sid model.Comic
 	case sid.ComicCode != nil:
 		comicID = model.DBComicCodeToID(*sid.ComicCode)
 	}
-	return svc.database.UpdateComicSynopsis(ctx, data, map[string]any{
+	conds := map[string]any{
 		model.DBComicGenericComicID: comicID,
 		model.DBComicGenericRID:     sid.RID,
-	}, v)
+	}
+	if v == nil {
+		v = new(model.ComicSynopsis)
+	}
+	return svc.audit(ctx, model.AuditActionUpdate, model.AuditEntityComicSynopsis, func(ctx context.Context, a *model.AddAudit) error {
+		before, err := svc.database.GetComicSynopsis(ctx, conds)
+		if err != nil {
+			return err
+		}
+		if err := svc.database.UpdateComicSynopsis(ctx, data, conds, v); err != nil {
+			return err
+		}
+		a.Key, a.Before, a.After = utila.Utoa(before.ComicID)+"/"+before.RID, before, v
+		return nil
+	})
 }
 
 func (svc Service) DeleteComicSynopsisBySID(ctx context.Context, sid model.ComicGenericSID) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to delete comic synopsis")
+	if !svc.permitted(ctx, PermissionComicWrite) {
+		return permissionError(PermissionComicWrite, "delete comic synopsis")
 	}
 
 	var comicID any
@@ -889,10 +857,17 @@ func (svc Service) DeleteComicSynopsisBySID(ctx context.Context, sid model.Comic
 	case sid.ComicCode != nil:
 		comicID = model.DBComicCodeToID(*sid.ComicCode)
 	}
-	return svc.database.DeleteComicSynopsis(ctx, map[string]any{
-		model.DBComicGenericComicID: comicID,
-		model.DBComicGenericRID:     sid.RID,
-	}, nil)
+	return svc.audit(ctx, model.AuditActionDelete, model.AuditEntityComicSynopsis, func(ctx context.Context, a *model.AddAudit) error {
+		before := new(model.ComicSynopsis)
+		if err := svc.database.DeleteComicSynopsis(ctx, map[string]any{
+			model.DBComicGenericComicID: comicID,
+			model.DBComicGenericRID:     sid.RID,
+		}, before); err != nil {
+			return err
+		}
+		a.Key, a.Before = utila.Utoa(before.ComicID)+"/"+before.RID, before
+		return nil
+	})
 }
 
 func (svc Service) ListComicSynopsis(ctx context.Context, params model.ListParams) ([]*model.ComicSynopsis, error) {
@@ -926,15 +901,24 @@ func (svc Service) CountComicSynopsis(ctx context.Context, conds any) (int, erro
 // Comic External
 
 func (svc Service) AddComicExternal(ctx context.Context, data model.AddComicExternal, v *model.ComicExternal) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to add comic external")
+	if !svc.permitted(ctx, PermissionComicWrite) {
+		return permissionError(PermissionComicWrite, "add comic external")
 	}
 
 	if err := data.Validate(); err != nil {
 		return err
 	}
 
-	return svc.database.AddComicExternal(ctx, data, v)
+	if v == nil {
+		v = new(model.ComicExternal)
+	}
+	return svc.audit(ctx, model.AuditActionAdd, model.AuditEntityComicExternal, func(ctx context.Context, a *model.AddAudit) error {
+		if err := svc.database.AddComicExternal(ctx, data, v); err != nil {
+			return err
+		}
+		a.Key, a.After = utila.Utoa(v.ComicID)+"/"+v.RID, v
+		return nil
+	})
 }
 
 func (svc Service) GetComicExternalBySID(ctx context.Context, sid model.ComicGenericSID) (*model.ComicExternal, error) {
@@ -952,8 +936,8 @@ func (svc Service) GetComicExternalBySID(ctx context.Context, sid model.ComicGen
 }
 
 func (svc Service) UpdateComicExternalBySID(ctx context.Context, sid model.ComicGenericSID, data model.SetComicExternal, v *model.ComicExternal) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to update comic external")
+	if !svc.permitted(ctx, PermissionComicWrite) {
+		return permissionError(PermissionComicWrite, "update comic external")
 	}
 
 	if err := data.Validate(); err != nil {
@@ -967,15 +951,29 @@ func (svc Service) UpdateComicExternalBySID(ctx context.Context, sid model.Comic
 	case sid.ComicCode != nil:
 		comicID = model.DBComicCodeToID(*sid.ComicCode)
 	}
-	return svc.database.UpdateComicExternal(ctx, data, map[string]any{
+	conds := map[string]any{
 		model.DBComicGenericComicID: comicID,
 		model.DBComicGenericRID:     sid.RID,
-	}, v)
+	}
+	if v == nil {
+		v = new(model.ComicExternal)
+	}
+	return svc.audit(ctx, model.AuditActionUpdate, model.AuditEntityComicExternal, func(ctx context.Context, a *model.AddAudit) error {
+		before, err := svc.database.GetComicExternal(ctx, conds)
+		if err != nil {
+			return err
+		}
+		if err := svc.database.UpdateComicExternal(ctx, data, conds, v); err != nil {
+			return err
+		}
+		a.Key, a.Before, a.After = utila.Utoa(before.ComicID)+"/"+before.RID, before, v
+		return nil
+	})
 }
 
 func (svc Service) DeleteComicExternalBySID(ctx context.Context, sid model.ComicGenericSID) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to delete comic external")
+	if !svc.permitted(ctx, PermissionComicWrite) {
+		return permissionError(PermissionComicWrite, "delete comic external")
 	}
 
 	var comicID any
@@ -985,10 +983,17 @@ func (svc Service) DeleteComicExternalBySID(ctx context.Context, sid model.Comic
 	case sid.ComicCode != nil:
 		comicID = model.DBComicCodeToID(*sid.ComicCode)
 	}
-	return svc.database.DeleteComicExternal(ctx, map[string]any{
-		model.DBComicGenericComicID: comicID,
-		model.DBComicGenericRID:     sid.RID,
-	}, nil)
+	return svc.audit(ctx, model.AuditActionDelete, model.AuditEntityComicExternal, func(ctx context.Context, a *model.AddAudit) error {
+		before := new(model.ComicExternal)
+		if err := svc.database.DeleteComicExternal(ctx, map[string]any{
+			model.DBComicGenericComicID: comicID,
+			model.DBComicGenericRID:     sid.RID,
+		}, before); err != nil {
+			return err
+		}
+		a.Key, a.Before = utila.Utoa(before.ComicID)+"/"+before.RID, before
+		return nil
+	})
 }
 
 func (svc Service) ListComicExternal(ctx context.Context, params model.ListParams) ([]*model.ComicExternal, error) {
@@ -1022,15 +1027,24 @@ func (svc Service) CountComicExternal(ctx context.Context, conds any) (int, erro
 // Comic Category
 
 func (svc Service) AddComicCategory(ctx context.Context, data model.AddComicCategory, v *model.ComicCategory) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to add comic category")
+	if !svc.permitted(ctx, PermissionComicWrite) {
+		return permissionError(PermissionComicWrite, "add comic category")
 	}
 
 	if err := data.Validate(); err != nil {
 		return err
 	}
 
-	return svc.database.AddComicCategory(ctx, data, v)
+	if v == nil {
+		v = new(model.ComicCategory)
+	}
+	return svc.audit(ctx, model.AuditActionAdd, model.AuditEntityComicCategory, func(ctx context.Context, a *model.AddAudit) error {
+		if err := svc.database.AddComicCategory(ctx, data, v); err != nil {
+			return err
+		}
+		a.Key, a.After = utila.Utoa(v.ComicID)+"/"+utila.Utoa(v.CategoryID), v
+		return nil
+	})
 }
 
 func (svc Service) GetComicCategoryBySID(ctx context.Context, sid model.ComicCategorySID) (*model.ComicCategory, error) {
@@ -1055,8 +1069,8 @@ func (svc Service) GetComicCategoryBySID(ctx context.Context, sid model.ComicCat
 }
 
 func (svc Service) UpdateComicCategoryBySID(ctx context.Context, sid model.ComicCategorySID, data model.SetComicCategory, v *model.ComicCategory) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to update comic category")
+	if !svc.permitted(ctx, PermissionComicWrite) {
+		return permissionError(PermissionComicWrite, "update comic category")
 	}
 
 	if err := data.Validate(); err != nil {
@@ -1077,15 +1091,29 @@ func (svc Service) UpdateComicCategoryBySID(ctx context.Context, sid model.Comic
 	case sid.CategorySID != nil:
 		categoryID = model.DBCategorySIDToID(*sid.CategorySID)
 	}
-	return svc.database.UpdateComicCategory(ctx, data, map[string]any{
+	conds := map[string]any{
 		model.DBComicGenericComicID:       comicID,
 		model.DBCategoryGenericCategoryID: categoryID,
-	}, v)
+	}
+	if v == nil {
+		v = new(model.ComicCategory)
+	}
+	return svc.audit(ctx, model.AuditActionUpdate, model.AuditEntityComicCategory, func(ctx context.Context, a *model.AddAudit) error {
+		before, err := svc.database.GetComicCategory(ctx, conds)
+		if err != nil {
+			return err
+		}
+		if err := svc.database.UpdateComicCategory(ctx, data, conds, v); err != nil {
+			return err
+		}
+		a.Key, a.Before, a.After = utila.Utoa(before.ComicID)+"/"+utila.Utoa(before.CategoryID), before, v
+		return nil
+	})
 }
 
 func (svc Service) DeleteComicCategoryBySID(ctx context.Context, sid model.ComicCategorySID) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to delete comic category")
+	if !svc.permitted(ctx, PermissionComicWrite) {
+		return permissionError(PermissionComicWrite, "delete comic category")
 	}
 
 	var comicID any
@@ -1102,10 +1130,17 @@ func (svc Service) DeleteComicCategoryBySID(ctx context.Context, sid model.Comic
 	case sid.CategorySID != nil:
 		categoryID = model.DBCategorySIDToID(*sid.CategorySID)
 	}
-	return svc.database.DeleteComicCategory(ctx, map[string]any{
-		model.DBComicGenericComicID:       comicID,
-		model.DBCategoryGenericCategoryID: categoryID,
-	}, nil)
+	return svc.audit(ctx, model.AuditActionDelete, model.AuditEntityComicCategory, func(ctx context.Context, a *model.AddAudit) error {
+		before := new(model.ComicCategory)
+		if err := svc.database.DeleteComicCategory(ctx, map[string]any{
+			model.DBComicGenericComicID:       comicID,
+			model.DBCategoryGenericCategoryID: categoryID,
+		}, before); err != nil {
+			return err
+		}
+		a.Key, a.Before = utila.Utoa(before.ComicID)+"/"+utila.Utoa(before.CategoryID), before
+		return nil
+	})
 }
 
 func (svc Service) ListComicCategory(ctx context.Context, params model.ListParams) ([]*model.ComicCategory, error) {
@@ -1139,15 +1174,24 @@ func (svc Service) CountComicCategory(ctx context.Context, conds any) (int, erro
 // Comic Tag
 
 func (svc Service) AddComicTag(ctx context.Context, data model.AddComicTag, v *model.ComicTag) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to add comic tag")
+	if !svc.permitted(ctx, PermissionComicWrite) {
+		return permissionError(PermissionComicWrite, "add comic tag")
 	}
 
 	if err := data.Validate(); err != nil {
 		return err
 	}
 
-	return svc.database.AddComicTag(ctx, data, v)
+	if v == nil {
+		v = new(model.ComicTag)
+	}
+	return svc.audit(ctx, model.AuditActionAdd, model.AuditEntityComicTag, func(ctx context.Context, a *model.AddAudit) error {
+		if err := svc.database.AddComicTag(ctx, data, v); err != nil {
+			return err
+		}
+		a.Key, a.After = utila.Utoa(v.ComicID)+"/"+utila.Utoa(v.TagID), v
+		return nil
+	})
 }
 
 func (svc Service) GetComicTagBySID(ctx context.Context, sid model.ComicTagSID) (*model.ComicTag, error) {
@@ -1172,8 +1216,8 @@ func (svc Service) GetComicTagBySID(ctx context.Context, sid model.ComicTagSID)
 }
 
 func (svc Service) UpdateComicTagBySID(ctx context.Context, sid model.ComicTagSID, data model.SetComicTag, v *model.ComicTag) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to update comic tag")
+	if !svc.permitted(ctx, PermissionComicWrite) {
+		return permissionError(PermissionComicWrite, "update comic tag")
 	}
 
 	if err := data.Validate(); err != nil {
@@ -1194,15 +1238,29 @@ func (svc Service) UpdateComicTagBySID(ctx context.Context, sid model.ComicTagSI
 	case sid.TagSID != nil:
 		tagID = model.DBTagSIDToID(*sid.TagSID)
 	}
-	return svc.database.UpdateComicTag(ctx, data, map[string]any{
+	conds := map[string]any{
 		model.DBComicGenericComicID: comicID,
 		model.DBTagGenericTagID:     tagID,
-	}, v)
+	}
+	if v == nil {
+		v = new(model.ComicTag)
+	}
+	return svc.audit(ctx, model.AuditActionUpdate, model.AuditEntityComicTag, func(ctx context.Context, a *model.AddAudit) error {
+		before, err := svc.database.GetComicTag(ctx, conds)
+		if err != nil {
+			return err
+		}
+		if err := svc.database.UpdateComicTag(ctx, data, conds, v); err != nil {
+			return err
+		}
+		a.Key, a.Before, a.After = utila.Utoa(before.ComicID)+"/"+utila.Utoa(before.TagID), before, v
+		return nil
+	})
 }
 
 func (svc Service) DeleteComicTagBySID(ctx context.Context, sid model.ComicTagSID) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to delete comic tag")
+	if !svc.permitted(ctx, PermissionComicWrite) {
+		return permissionError(PermissionComicWrite, "delete comic tag")
 	}
 
 	var comicID any
@@ -1219,10 +1277,17 @@ func (svc Service) DeleteComicTagBySID(ctx context.Context, sid model.ComicTagSI
 	case sid.TagSID != nil:
 		tagID = model.DBTagSIDToID(*sid.TagSID)
 	}
-	return svc.database.DeleteComicTag(ctx, map[string]any{
-		model.DBComicGenericComicID: comicID,
-		model.DBTagGenericTagID:     tagID,
-	}, nil)
+	return svc.audit(ctx, model.AuditActionDelete, model.AuditEntityComicTag, func(ctx context.Context, a *model.AddAudit) error {
+		before := new(model.ComicTag)
+		if err := svc.database.DeleteComicTag(ctx, map[string]any{
+			model.DBComicGenericComicID: comicID,
+			model.DBTagGenericTagID:     tagID,
+		}, before); err != nil {
+			return err
+		}
+		a.Key, a.Before = utila.Utoa(before.ComicID)+"/"+utila.Utoa(before.TagID), before
+		return nil
+	})
 }
 
 func (svc Service) ListComicTag(ctx context.Context, params model.ListParams) ([]*model.ComicTag, error) {
@@ -1256,15 +1321,24 @@ func (svc Service) CountComicTag(ctx context.Context, conds any) (int, error) {
 // Comic Relation
 
 func (svc Service) AddComicRelationType(ctx context.Context, data model.AddComicRelationType, v *model.ComicRelationType) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to add comic relation type")
+	if !svc.permitted(ctx, PermissionTaxonomyWrite) {
+		return permissionError(PermissionTaxonomyWrite, "add comic relation type")
 	}
 
 	if err := data.Validate(); err != nil {
 		return err
 	}
 
-	return svc.database.AddComicRelationType(ctx, data, v)
+	if v == nil {
+		v = new(model.ComicRelationType)
+	}
+	return svc.audit(ctx, model.AuditActionAdd, model.AuditEntityComicRelationType, func(ctx context.Context, a *model.AddAudit) error {
+		if err := svc.database.AddComicRelationType(ctx, data, v); err != nil {
+			return err
+		}
+		a.Key, a.After = v.Code, v
+		return nil
+	})
 }
 
 func (svc Service) GetComicRelationTypeByCode(ctx context.Context, code string) (*model.ComicRelationType, error) {
@@ -1275,29 +1349,50 @@ func (svc Service) GetComicRelationTypeByCode(ctx context.Context, code string)
 }
 
 func (svc Service) UpdateComicRelationTypeByCode(ctx context.Context, code string, data model.SetComicRelationType, v *model.ComicRelationType) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to update comic relation type")
+	if !svc.permitted(ctx, PermissionTaxonomyWrite) {
+		return permissionError(PermissionTaxonomyWrite, "update comic relation type")
 	}
 
 	if err := data.Validate(); err != nil {
 		return err
 	}
 
-	return svc.database.UpdateComicRelationType(ctx, data, model.DBConditionalKV{
+	conds := model.DBConditionalKV{
 		Key:   model.DBComicRelationTypeCode,
 		Value: code,
-	}, v)
+	}
+	if v == nil {
+		v = new(model.ComicRelationType)
+	}
+	return svc.audit(ctx, model.AuditActionUpdate, model.AuditEntityComicRelationType, func(ctx context.Context, a *model.AddAudit) error {
+		before, err := svc.database.GetComicRelationType(ctx, conds)
+		if err != nil {
+			return err
+		}
+		if err := svc.database.UpdateComicRelationType(ctx, data, conds, v); err != nil {
+			return err
+		}
+		a.Key, a.Before, a.After = code, before, v
+		return nil
+	})
 }
 
 func (svc Service) DeleteComicRelationTypeByCode(ctx context.Context, code string) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to delete comic relation type")
+	if !svc.permitted(ctx, PermissionTaxonomyWrite) {
+		return permissionError(PermissionTaxonomyWrite, "delete comic relation type")
 	}
 
-	return svc.database.DeleteComicRelationType(ctx, model.DBConditionalKV{
-		Key:   model.DBComicRelationTypeCode,
-		Value: code,
-	}, nil)
+	return svc.audit(ctx, model.AuditActionDelete, model.AuditEntityComicRelationType, func(ctx context.Context, a *model.AddAudit) error {
+		before := new(model.ComicRelationType)
+		if err := svc.database.DeleteComicRelationType(ctx, model.DBConditionalKV{
+			Key:   model.DBComicRelationTypeCode,
+			Value: code,
+		}, before); err != nil {
+			return err
+		}
+		a.Key, a.Before = code, before
+		return nil
+	})
 }
 
 func (svc Service) ListComicRelationType(ctx context.Context, params model.ListParams) ([]*model.ComicRelationType, error) {
@@ -1329,15 +1424,24 @@ func (svc Service) CountComicRelationType(ctx context.Context, conds any) (int,
 }
 
 func (svc Service) AddComicRelation(ctx context.Context, data model.AddComicRelation, v *model.ComicRelation) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to add comic relation")
+	if !svc.permitted(ctx, PermissionComicWrite) {
+		return permissionError(PermissionComicWrite, "add comic relation")
 	}
 
 	if err := data.Validate(); err != nil {
 		return err
 	}
 
-	return svc.database.AddComicRelation(ctx, data, v)
+	if v == nil {
+		v = new(model.ComicRelation)
+	}
+	return svc.audit(ctx, model.AuditActionAdd, model.AuditEntityComicRelation, func(ctx context.Context, a *model.AddAudit) error {
+		if err := svc.database.AddComicRelation(ctx, data, v); err != nil {
+			return err
+		}
+		a.Key, a.After = utila.Utoa(v.ParentID)+"/"+utila.Utoa(v.TypeID)+"-"+utila.Utoa(v.ChildID), v
+		return nil
+	})
 }
 
 func (svc Service) GetComicRelationBySID(ctx context.Context, sid model.ComicRelationSID) (*model.ComicRelation, error) {
@@ -1370,8 +1474,8 @@ func (svc Service) GetComicRelationBySID(ctx context.Context, sid model.ComicRel
 }
 
 func (svc Service) UpdateComicRelationBySID(ctx context.Context, sid model.ComicRelationSID, data model.SetComicRelation, v *model.ComicRelation) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to update comic relation")
+	if !svc.permitted(ctx, PermissionComicWrite) {
+		return permissionError(PermissionComicWrite, "update comic relation")
 	}
 
 	if err := data.Validate(); err != nil {
@@ -1399,16 +1503,30 @@ func (svc Service) UpdateComicRelationBySID(ctx context.Context, sid model.Comic
 	case sid.ChildCode != nil:
 		childID = model.DBComicCodeToID(*sid.ChildCode)
 	}
-	return svc.database.UpdateComicRelation(ctx, data, map[string]any{
+	conds := map[string]any{
 		model.DBComicRelationParentID: parentID,
 		model.DBComicRelationTypeID:   typeID,
 		model.DBComicRelationChildID:  childID,
-	}, v)
+	}
+	if v == nil {
+		v = new(model.ComicRelation)
+	}
+	return svc.audit(ctx, model.AuditActionUpdate, model.AuditEntityComicRelation, func(ctx context.Context, a *model.AddAudit) error {
+		before, err := svc.database.GetComicRelation(ctx, conds)
+		if err != nil {
+			return err
+		}
+		if err := svc.database.UpdateComicRelation(ctx, data, conds, v); err != nil {
+			return err
+		}
+		a.Key, a.Before, a.After = utila.Utoa(before.ParentID)+"/"+utila.Utoa(before.TypeID)+"-"+utila.Utoa(before.ChildID), before, v
+		return nil
+	})
 }
 
 func (svc Service) DeleteComicRelationBySID(ctx context.Context, sid model.ComicRelationSID) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to delete comic relation")
+	if !svc.permitted(ctx, PermissionComicWrite) {
+		return permissionError(PermissionComicWrite, "delete comic relation")
 	}
 
 	var parentID any
@@ -1432,11 +1550,18 @@ func (svc Service) DeleteComicRelationBySID(ctx context.Context, sid model.Comic
 	case sid.ChildCode != nil:
 		childID = model.DBComicCodeToID(*sid.ChildCode)
 	}
-	return svc.database.DeleteComicRelation(ctx, map[string]any{
-		model.DBComicRelationParentID: parentID,
-		model.DBComicRelationTypeID:   typeID,
-		model.DBComicRelationChildID:  childID,
-	}, nil)
+	return svc.audit(ctx, model.AuditActionDelete, model.AuditEntityComicRelation, func(ctx context.Context, a *model.AddAudit) error {
+		before := new(model.ComicRelation)
+		if err := svc.database.DeleteComicRelation(ctx, map[string]any{
+			model.DBComicRelationParentID: parentID,
+			model.DBComicRelationTypeID:   typeID,
+			model.DBComicRelationChildID:  childID,
+		}, before); err != nil {
+			return err
+		}
+		a.Key, a.Before = utila.Utoa(before.ParentID)+"/"+utila.Utoa(before.TypeID)+"-"+utila.Utoa(before.ChildID), before
+		return nil
+	})
 }
 
 func (svc Service) ListComicRelation(ctx context.Context, params model.ListParams) ([]*model.ComicRelation, error) {
@@ -1472,15 +1597,24 @@ func (svc Service) CountComicRelation(ctx context.Context, conds any) (int, erro
 //
 
 func (svc Service) AddComicChapter(ctx context.Context, data model.AddComicChapter, v *model.ComicChapter) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to add comic chapter")
+	if !svc.permitted(ctx, PermissionChapterWrite) {
+		return permissionError(PermissionChapterWrite, "add comic chapter")
 	}
 
 	if err := data.Validate(); err != nil {
 		return err
 	}
 
-	return svc.database.AddComicChapter(ctx, data, v)
+	if v == nil {
+		v = new(model.ComicChapter)
+	}
+	return svc.audit(ctx, model.AuditActionAdd, model.AuditEntityComicChapter, func(ctx context.Context, a *model.AddAudit) error {
+		if err := svc.database.AddComicChapter(ctx, data, v); err != nil {
+			return err
+		}
+		a.Key, a.After = comicChapterKey(v), v
+		return nil
+	})
 }
 
 func (svc Service) GetComicChapterBySID(ctx context.Context, sid model.ComicChapterSID) (*model.ComicChapter, error) {
@@ -1506,15 +1640,34 @@ func (svc Service) GetComicChapterBySID(ctx context.Context, sid model.ComicChap
 }
 
 func (svc Service) updateComicChapter(ctx context.Context, data model.SetComicChapter, conds any, v *model.ComicChapter) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to update comic chapter")
+	if !svc.permitted(ctx, PermissionChapterWrite) {
+		return permissionError(PermissionChapterWrite, "update comic chapter")
 	}
 
 	if err := data.Validate(); err != nil {
 		return err
 	}
 
-	return svc.database.UpdateComicChapter(ctx, data, conds, v)
+	if v == nil {
+		v = new(model.ComicChapter)
+	}
+	return svc.audit(ctx, model.AuditActionUpdate, model.AuditEntityComicChapter, func(ctx context.Context, a *model.AddAudit) error {
+		before, err := svc.database.GetComicChapter(ctx, conds)
+		if err != nil {
+			return preconditionError(ctx, err)
+		}
+		conds, err := precondition(ctx, conds, func() (any, *time.Time, error) {
+			return before, before.UpdatedAt, nil
+		})
+		if err != nil {
+			return err
+		}
+		if err := svc.database.UpdateComicChapter(ctx, data, conds, v); err != nil {
+			return preconditionError(ctx, err)
+		}
+		a.Key, a.Before, a.After = comicChapterKey(before), before, v
+		return nil
+	})
 }
 
 func (svc Service) UpdateComicChapterBySID(ctx context.Context, sid model.ComicChapterSID, data model.SetComicChapter, v *model.ComicChapter) error {
@@ -1540,11 +1693,38 @@ func (svc Service) UpdateComicChapterBySID(ctx context.Context, sid model.ComicC
 }
 
 func (svc Service) deleteComicChapter(ctx context.Context, conds any, v *model.ComicChapter) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to delete comic chapter")
+	if !svc.permitted(ctx, PermissionChapterWrite) {
+		return permissionError(PermissionChapterWrite, "delete comic chapter")
 	}
 
-	return svc.database.DeleteComicChapter(ctx, conds, v)
+	if v == nil {
+		v = new(model.ComicChapter)
+	}
+	return svc.audit(ctx, model.AuditActionDelete, model.AuditEntityComicChapter, func(ctx context.Context, a *model.AddAudit) error {
+		conds, err := precondition(ctx, conds, func() (any, *time.Time, error) {
+			current, err := svc.database.GetComicChapter(ctx, conds)
+			if err != nil {
+				return nil, nil, err
+			}
+			return current, current.UpdatedAt, nil
+		})
+		if err != nil {
+			return err
+		}
+		if err := svc.database.DeleteComicChapter(ctx, conds, v); err != nil {
+			return preconditionError(ctx, err)
+		}
+		a.Key, a.Before = comicChapterKey(v), v
+		return nil
+	})
+}
+
+func comicChapterKey(m *model.ComicChapter) string {
+	key := utila.Utoa(m.ComicID) + "/" + m.Chapter
+	if m.Version != nil {
+		key += "-" + *m.Version
+	}
+	return key
 }
 
 func (svc Service) DeleteComicChapterBySID(ctx context.Context, sid model.ComicChapterSID) error {
@@ -1569,9 +1749,9 @@ func (svc Service) DeleteComicChapterBySID(ctx context.Context, sid model.ComicC
 	}, nil)
 }
 
-func (svc Service) ListComicChapter(ctx context.Context, params model.ListParams) ([]*model.ComicChapter, error) {
+func (svc Service) ListComicChapter(ctx context.Context, params model.ListParams) ([]*model.ComicChapter, []any, error) {
 	if err := params.Validate(); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	params.OrderBys = slices.DeleteFunc(params.OrderBys, func(ob model.OrderBy) bool {
@@ -0,0 +1,266 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"maps"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+const jsonSchemaCacheTTLDef = 1 * time.Minute
+
+type (
+	jsonSchemaCache struct {
+		mutex   sync.Mutex
+		entries map[string]jsonSchemaEntry
+	}
+
+	jsonSchemaEntry struct {
+		schema *jsonschema.Schema
+		expiry time.Time
+	}
+)
+
+func (svc Service) GetJSONSchema(ctx context.Context, name string) (*model.JSONSchema, error) {
+	return svc.database.GetJSONSchema(ctx, model.DBConditionalKV{
+		Key:   model.DBJSONSchemaName,
+		Value: name,
+	})
+}
+
+// SetJSONSchema stores the schema of name, the configured schema of the same
+// name still takes precedence.
+func (svc Service) SetJSONSchema(ctx context.Context, data model.AddJSONSchema, v *model.JSONSchema) error {
+	if !svc.permitted(ctx, PermissionAdmin) {
+		return permissionError(PermissionAdmin, "set json schema")
+	}
+
+	if err := data.Validate(); err != nil {
+		return err
+	}
+	if _, err := compileJSONSchema(data.Name, data.Schema); err != nil {
+		return model.GenericError("schema is not valid: " + err.Error())
+	}
+
+	conds := model.DBConditionalKV{
+		Key:   model.DBJSONSchemaName,
+		Value: data.Name,
+	}
+	if v == nil {
+		v = new(model.JSONSchema)
+	}
+	if err := svc.audit(ctx, model.AuditActionUpdate, model.AuditEntityJSONSchema, func(ctx context.Context, a *model.AddAudit) error {
+		before, err := svc.database.GetJSONSchema(ctx, conds)
+		switch {
+		case errors.As(err, &model.ErrNotFound):
+			if err := svc.database.AddJSONSchema(ctx, data, v); err != nil {
+				return err
+			}
+			a.Action, a.Key, a.After = model.AuditActionAdd, data.Name, v
+			return nil
+		case err != nil:
+			return err
+		}
+		if err := svc.database.UpdateJSONSchema(ctx, model.SetJSONSchema{Schema: data.Schema}, conds, v); err != nil {
+			return err
+		}
+		a.Key, a.Before, a.After = data.Name, before, v
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	svc.jsonSchemas.forget(data.Name)
+	return nil
+}
+
+func (svc Service) DeleteJSONSchema(ctx context.Context, name string) error {
+	if !svc.permitted(ctx, PermissionAdmin) {
+		return permissionError(PermissionAdmin, "delete json schema")
+	}
+
+	if err := svc.audit(ctx, model.AuditActionDelete, model.AuditEntityJSONSchema, func(ctx context.Context, a *model.AddAudit) error {
+		before := new(model.JSONSchema)
+		if err := svc.database.DeleteJSONSchema(ctx, model.DBConditionalKV{
+			Key:   model.DBJSONSchemaName,
+			Value: name,
+		}, before); err != nil {
+			return err
+		}
+		a.Key, a.Before = name, before
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	svc.jsonSchemas.forget(name)
+	return nil
+}
+
+// jsonSchema returns the compiled schema of name, nil when there is none. A
+// configured schema is kept for good, a stored one for the cache ttl.
+func (svc Service) jsonSchema(name string) (*jsonschema.Schema, error) {
+	if entry, ok := svc.jsonSchemas.get(name); ok {
+		return entry.schema, nil
+	}
+
+	var data map[string]any
+	switch name {
+	case model.JSONSchemaComicAdditionals:
+		data = svc.config.ComicAdditionalsSchema
+	}
+	var expiry time.Time
+	if len(data) < 1 {
+		ttl := svc.config.JSONSchemaCacheTTL
+		if ttl < 1 {
+			ttl = jsonSchemaCacheTTLDef
+		}
+		expiry = time.Now().Add(ttl)
+
+		// The cache is shared by every request, so only the committed schema
+		// is read, not the one of the caller transaction.
+		result, err := svc.database.GetJSONSchema(context.Background(), model.DBConditionalKV{
+			Key:   model.DBJSONSchemaName,
+			Value: name,
+		})
+		switch {
+		case errors.As(err, &model.ErrNotFound):
+		case err != nil:
+			return nil, err
+		default:
+			data = result.Schema
+		}
+	}
+
+	var schema *jsonschema.Schema
+	if data != nil {
+		var err error
+		if schema, err = compileJSONSchema(name, data); err != nil {
+			return nil, err
+		}
+	}
+	svc.jsonSchemas.set(name, jsonSchemaEntry{schema: schema, expiry: expiry})
+	return schema, nil
+}
+
+// validateJSONSchema validates value against the schema of name, a missing
+// value is validated as an empty object.
+func (svc Service) validateJSONSchema(name, field string, value map[string]any) error {
+	schema, err := svc.jsonSchema(name)
+	if err != nil {
+		return err
+	}
+	if schema == nil {
+		return nil
+	}
+
+	if value == nil {
+		value = map[string]any{}
+	}
+	// The validator works on decoded JSON values only.
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	var instance any
+	if err := json.Unmarshal(raw, &instance); err != nil {
+		return err
+	}
+
+	if err := schema.Validate(instance); err != nil {
+		var verr *jsonschema.ValidationError
+		if !errors.As(err, &verr) {
+			return model.GenericError(field + " is not valid")
+		}
+		for len(verr.Causes) > 0 {
+			verr = verr.Causes[0]
+		}
+		if path := strings.Trim(verr.InstanceLocation, "/"); path != "" {
+			field += " " + strings.ReplaceAll(path, "/", ".")
+		}
+		return model.GenericError(field + " " + verr.Message)
+	}
+
+	return nil
+}
+
+func compileJSONSchema(name string, data map[string]any) (*jsonschema.Schema, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	url := "schema:///" + name + ".json"
+	compiler := jsonschema.NewCompiler()
+	compiler.LoadURL = func(s string) (io.ReadCloser, error) {
+		return nil, errors.New("cannot load " + s)
+	}
+	if err := compiler.AddResource(url, bytes.NewReader(raw)); err != nil {
+		return nil, err
+	}
+	return compiler.Compile(url)
+}
+
+func (c *jsonSchemaCache) get(name string) (jsonSchemaEntry, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, ok := c.entries[name]
+	if ok && !entry.expiry.IsZero() && !time.Now().Before(entry.expiry) {
+		delete(c.entries, name)
+		return entry, false
+	}
+	return entry, ok
+}
+
+func (c *jsonSchemaCache) set(name string, entry jsonSchemaEntry) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.entries[name] = entry
+}
+
+func (c *jsonSchemaCache) forget(name string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	delete(c.entries, name)
+}
+
+func mergeJSON(dst, src map[string]any) map[string]any {
+	result := maps.Clone(dst)
+	if result == nil {
+		result = map[string]any{}
+	}
+	maps.Copy(result, src)
+	return stripJSONNulls(result).(map[string]any)
+}
+
+func stripJSONNulls(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for key, val := range v {
+			if val != nil {
+				result[key] = stripJSONNulls(val)
+			}
+		}
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, val := range v {
+			result[i] = stripJSONNulls(val)
+		}
+		return result
+	}
+	return v
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/datastore/memory"
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+type countingJSONSchema struct {
+	*memory.Memory
+	gets int
+}
+
+func (db *countingJSONSchema) GetJSONSchema(ctx context.Context, conds any) (*model.JSONSchema, error) {
+	db.gets++
+	return db.Memory.GetJSONSchema(ctx, conds)
+}
+
+func TestJSONSchemaCache(t *testing.T) {
+	db := &countingJSONSchema{Memory: memory.New()}
+	svc := New(db, nil, Config{JSONSchemaCacheTTL: time.Hour})
+
+	for i := 0; i < 3; i++ {
+		if err := svc.validateJSONSchema(model.JSONSchemaComicAdditionals, "additionals", nil); err != nil {
+			t.Fatalf("validate without schema: %v", err)
+		}
+	}
+	if db.gets != 1 {
+		t.Errorf("missing schema lookups: expected 1 got %d", db.gets)
+	}
+
+	if err := db.AddJSONSchema(context.Background(), model.AddJSONSchema{
+		Name:   model.JSONSchemaComicAdditionals,
+		Schema: map[string]any{"required": []any{"source"}},
+	}, nil); err != nil {
+		t.Fatalf("AddJSONSchema: %v", err)
+	}
+	if err := svc.validateJSONSchema(model.JSONSchemaComicAdditionals, "additionals", nil); err != nil {
+		t.Errorf("validate cached missing schema: %v", err)
+	}
+	svc.jsonSchemas.forget(model.JSONSchemaComicAdditionals)
+	if err := svc.validateJSONSchema(model.JSONSchemaComicAdditionals, "additionals", nil); err == nil {
+		t.Error("validate stored schema: expected error")
+	}
+	if err := svc.validateJSONSchema(model.JSONSchemaComicAdditionals, "additionals", map[string]any{"source": "web"}); err != nil {
+		t.Errorf("validate stored schema: %v", err)
+	}
+	if db.gets != 2 {
+		t.Errorf("stored schema lookups: expected 2 got %d", db.gets)
+	}
+
+	configured := New(db, nil, Config{ComicAdditionalsSchema: map[string]any{"type": "object"}})
+	for i := 0; i < 3; i++ {
+		if err := configured.validateJSONSchema(model.JSONSchemaComicAdditionals, "additionals", nil); err != nil {
+			t.Fatalf("validate configured schema: %v", err)
+		}
+	}
+	if db.gets != 2 {
+		t.Errorf("configured schema lookups: expected 2 got %d", db.gets)
+	}
+}
+
+func TestJSONSchemaCacheExpiry(t *testing.T) {
+	db := &countingJSONSchema{Memory: memory.New()}
+	svc := New(db, nil, Config{JSONSchemaCacheTTL: time.Millisecond})
+
+	for i := 0; i < 2; i++ {
+		if err := svc.validateJSONSchema(model.JSONSchemaComicAdditionals, "additionals", nil); err != nil {
+			t.Fatalf("validate without schema: %v", err)
+		}
+		time.Sleep(2 * time.Millisecond)
+	}
+	if db.gets != 2 {
+		t.Errorf("expired schema lookups: expected 2 got %d", db.gets)
+	}
+}
@@ -3,20 +3,30 @@ package service
 import (
 	"context"
 	"slices"
+	"time"
 
 	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
 )
 
 func (svc Service) AddLanguage(ctx context.Context, data model.AddLanguage, v *model.Language) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to add language")
+	if !svc.permitted(ctx, PermissionLanguageWrite) {
+		return permissionError(PermissionLanguageWrite, "add language")
 	}
 
 	if err := data.Validate(); err != nil {
 		return err
 	}
 
-	return svc.database.AddLanguage(ctx, data, v)
+	if v == nil {
+		v = new(model.Language)
+	}
+	return svc.audit(ctx, model.AuditActionAdd, model.AuditEntityLanguage, func(ctx context.Context, a *model.AddAudit) error {
+		if err := svc.database.AddLanguage(ctx, data, v); err != nil {
+			return err
+		}
+		a.Key, a.After = v.IETF, v
+		return nil
+	})
 }
 
 func (svc Service) GetLanguageByIETF(ctx context.Context, ietf string) (*model.Language, error) {
@@ -27,34 +37,72 @@ func (svc Service) GetLanguageByIETF(ctx context.Context, ietf string) (*model.L
 }
 
 func (svc Service) UpdateLanguageByIETF(ctx context.Context, ietf string, data model.SetLanguage, v *model.Language) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to update language")
+	if !svc.permitted(ctx, PermissionLanguageWrite) {
+		return permissionError(PermissionLanguageWrite, "update language")
 	}
 
 	if err := data.Validate(); err != nil {
 		return err
 	}
 
-	return svc.database.UpdateLanguage(ctx, data, model.DBConditionalKV{
+	conds := model.DBConditionalKV{
 		Key:   model.DBLanguageIETF,
 		Value: ietf,
-	}, v)
+	}
+	if v == nil {
+		v = new(model.Language)
+	}
+	return svc.audit(ctx, model.AuditActionUpdate, model.AuditEntityLanguage, func(ctx context.Context, a *model.AddAudit) error {
+		before, err := svc.database.GetLanguage(ctx, conds)
+		if err != nil {
+			return preconditionError(ctx, err)
+		}
+		conds, err := precondition(ctx, conds, func() (any, *time.Time, error) {
+			return before, before.UpdatedAt, nil
+		})
+		if err != nil {
+			return err
+		}
+		if err := svc.database.UpdateLanguage(ctx, data, conds, v); err != nil {
+			return preconditionError(ctx, err)
+		}
+		a.Key, a.Before, a.After = ietf, before, v
+		return nil
+	})
 }
 
 func (svc Service) DeleteLanguageByIETF(ctx context.Context, ietf string) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to delete language")
+	if !svc.permitted(ctx, PermissionLanguageWrite) {
+		return permissionError(PermissionLanguageWrite, "delete language")
 	}
 
-	return svc.database.DeleteLanguage(ctx, model.DBConditionalKV{
+	conds := model.DBConditionalKV{
 		Key:   model.DBLanguageIETF,
 		Value: ietf,
-	}, nil)
+	}
+	return svc.audit(ctx, model.AuditActionDelete, model.AuditEntityLanguage, func(ctx context.Context, a *model.AddAudit) error {
+		conds, err := precondition(ctx, conds, func() (any, *time.Time, error) {
+			current, err := svc.database.GetLanguage(ctx, conds)
+			if err != nil {
+				return nil, nil, err
+			}
+			return current, current.UpdatedAt, nil
+		})
+		if err != nil {
+			return err
+		}
+		before := new(model.Language)
+		if err := svc.database.DeleteLanguage(ctx, conds, before); err != nil {
+			return preconditionError(ctx, err)
+		}
+		a.Key, a.Before = ietf, before
+		return nil
+	})
 }
 
-func (svc Service) ListLanguage(ctx context.Context, params model.ListParams) ([]*model.Language, error) {
+func (svc Service) ListLanguage(ctx context.Context, params model.ListParams) ([]*model.Language, []any, error) {
 	if err := params.Validate(); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	params.OrderBys = slices.DeleteFunc(params.OrderBys, func(ob model.OrderBy) bool {
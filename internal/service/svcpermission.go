@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+type (
+	Permission string
+
+	// PermissionGrant lists the token permissions (without prefix) and scopes
+	// that grant a permission.
+	PermissionGrant struct {
+		Name        Permission `conf:"name"`
+		Permissions []string   `conf:"permissions"`
+		Scopes      []string   `conf:"scopes"`
+	}
+)
+
+const (
+	PermissionAdmin         Permission = "admin"
+	PermissionComicWrite    Permission = "comic.write"
+	PermissionComicDelete   Permission = "comic.delete"
+	PermissionChapterWrite  Permission = "chapter.write"
+	PermissionTaxonomyWrite Permission = "taxonomy.write"
+	PermissionLanguageWrite Permission = "language.write"
+	PermissionWebsiteWrite  Permission = "website.write"
+)
+
+var Permissions = []Permission{
+	PermissionAdmin,
+	PermissionComicWrite,
+	PermissionComicDelete,
+	PermissionChapterWrite,
+	PermissionTaxonomyWrite,
+	PermissionLanguageWrite,
+	PermissionWebsiteWrite,
+}
+
+// PermissionLegacyWrite is the token permission that granted every write
+// before the permissions were split, it still grants all but admin by default.
+const PermissionLegacyWrite = "write"
+
+// A permission without configured grants is granted by the token permission
+// of the same name.
+func permissionGrants(cfg []PermissionGrant) map[Permission]PermissionGrant {
+	grants := map[Permission]PermissionGrant{}
+	for _, p := range Permissions {
+		grant := PermissionGrant{Name: p, Permissions: []string{string(p)}}
+		if p != PermissionAdmin {
+			grant.Permissions = append(grant.Permissions, PermissionLegacyWrite)
+		}
+		grants[p] = grant
+	}
+	for _, grant := range cfg {
+		grants[grant.Name] = grant
+	}
+	return grants
+}
+
+// Admin implies every other permission. An API key only grants the
+// permissions it was created with.
+func (svc Service) permitted(ctx context.Context, p Permission) bool {
+	if key := svc.apiKeyContext(ctx); key != nil {
+		return slices.Contains(key.Permissions, string(p)) || slices.Contains(key.Permissions, string(PermissionAdmin))
+	}
+	for _, p := range []Permission{p, PermissionAdmin} {
+		grant := svc.permissions[p]
+		for _, key := range grant.Permissions {
+			if svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey(key)) {
+				return true
+			}
+		}
+		for _, scope := range grant.Scopes {
+			if svc.oauth.HasScopeContext(ctx, scope) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func permissionError(p Permission, action string) error {
+	return model.GenericError("missing " + string(p) + " permission to " + action)
+}
@@ -0,0 +1,27 @@
+package service
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPermissionGrants(t *testing.T) {
+	grants := permissionGrants(nil)
+	for _, p := range Permissions {
+		legacy := slices.Contains(grants[p].Permissions, PermissionLegacyWrite)
+		if !slices.Contains(grants[p].Permissions, string(p)) {
+			t.Errorf("%s: expected to be granted by itself got %v", p, grants[p].Permissions)
+		}
+		if legacy == (p == PermissionAdmin) {
+			t.Errorf("%s: legacy write granted %v", p, legacy)
+		}
+	}
+
+	grants = permissionGrants([]PermissionGrant{{Name: PermissionComicDelete, Permissions: []string{"comic.delete"}}})
+	if got := grants[PermissionComicDelete].Permissions; !slices.Equal(got, []string{"comic.delete"}) {
+		t.Errorf("configured comic.delete = %v", got)
+	}
+	if !slices.Contains(grants[PermissionComicWrite].Permissions, PermissionLegacyWrite) {
+		t.Errorf("unconfigured comic.write lost the legacy write: %v", grants[PermissionComicWrite].Permissions)
+	}
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+type ctxPrecondition struct{}
+
+// ContextPrecondition makes the update or delete under ctx check the current
+// entity first, inside its transaction.
+func (svc Service) ContextPrecondition(ctx context.Context, check func(current any) bool) context.Context {
+	return context.WithValue(ctx, ctxPrecondition{}, check)
+}
+
+func hasPrecondition(ctx context.Context) bool {
+	_, ok := ctx.Value(ctxPrecondition{}).(func(any) bool)
+	return ok
+}
+
+// precondition checks the entity from current and narrows conds to its
+// version, a write committed since the entity was read then matches no row.
+func precondition(ctx context.Context, conds any, current func() (any, *time.Time, error)) (any, error) {
+	check, ok := ctx.Value(ctxPrecondition{}).(func(any) bool)
+	if !ok {
+		return conds, nil
+	}
+	v, updatedAt, err := current()
+	if err != nil {
+		return nil, preconditionError(ctx, err)
+	}
+	if !check(v) {
+		return nil, model.PreconditionError()
+	}
+	var version any
+	if updatedAt != nil {
+		version = *updatedAt
+	}
+	return []any{
+		model.DBLogicalAND{},
+		conds,
+		model.DBConditionalKV{Key: model.DBGenericUpdatedAt, Value: model.DBIsNotDistinctFrom{Value: version}},
+	}, nil
+}
+
+// preconditionError fails the precondition of an entity that does not exist
+// or no longer has the checked version.
+func preconditionError(ctx context.Context, err error) error {
+	if hasPrecondition(ctx) && errors.As(err, &model.ErrNotFound) {
+		return model.PreconditionError()
+	}
+	return err
+}
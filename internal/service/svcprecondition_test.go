@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/datastore/memory"
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+func TestPrecondition(t *testing.T) {
+	ctx := context.Background()
+	db := memory.New()
+	if err := db.AddWebsite(ctx, model.AddWebsite{Domain: "example.com", Name: "Example"}, nil); err != nil {
+		t.Fatalf("AddWebsite: %v", err)
+	}
+	conds := model.DBConditionalKV{Key: model.DBWebsiteDomain, Value: "example.com"}
+	current := func() (any, *time.Time, error) {
+		v, err := db.GetWebsite(ctx, conds)
+		if err != nil {
+			return nil, nil, err
+		}
+		return v, v.UpdatedAt, nil
+	}
+	svc := Service{}
+	pass := svc.ContextPrecondition(ctx, func(v any) bool { return v.(*model.Website).Name == "Example" })
+	fail := svc.ContextPrecondition(ctx, func(v any) bool { return false })
+
+	if got, err := precondition(ctx, conds, current); err != nil || got != any(conds) {
+		t.Errorf("precondition without check = %v, %v", got, err)
+	}
+	if _, err := precondition(fail, conds, current); !errors.As(err, &model.ErrPrecondition) {
+		t.Errorf("precondition failed check: expected precondition error got %v", err)
+	}
+	missing := func() (any, *time.Time, error) {
+		_, err := db.GetWebsite(ctx, model.DBConditionalKV{Key: model.DBWebsiteDomain, Value: "missing.com"})
+		return nil, nil, err
+	}
+	if _, err := precondition(pass, conds, missing); !errors.As(err, &model.ErrPrecondition) {
+		t.Errorf("precondition missing entity: expected precondition error got %v", err)
+	}
+
+	checked, err := precondition(pass, conds, current)
+	if err != nil {
+		t.Fatalf("precondition: %v", err)
+	}
+	name := "Concurrent"
+	if err := db.UpdateWebsite(ctx, model.SetWebsite{Name: &name}, conds, new(model.Website)); err != nil {
+		t.Fatalf("UpdateWebsite concurrent: %v", err)
+	}
+	name = "Checked"
+	err = db.UpdateWebsite(pass, model.SetWebsite{Name: &name}, checked, new(model.Website))
+	if err := preconditionError(pass, err); !errors.As(err, &model.ErrPrecondition) {
+		t.Errorf("update after concurrent write: expected precondition error got %v", err)
+	}
+
+	pass = svc.ContextPrecondition(ctx, func(any) bool { return true })
+	if checked, err = precondition(pass, conds, current); err != nil {
+		t.Fatalf("precondition: %v", err)
+	}
+	if err := db.UpdateWebsite(pass, model.SetWebsite{Name: &name}, checked, new(model.Website)); err != nil {
+		t.Errorf("update checked version: %v", err)
+	}
+}
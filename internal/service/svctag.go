@@ -3,20 +3,31 @@ package service
 import (
 	"context"
 	"slices"
+	"time"
 
 	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+	"github.com/mahmudindes/orenocomic-donoengine/internal/utila"
 )
 
 func (svc Service) AddTagType(ctx context.Context, data model.AddTagType, v *model.TagType) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to add tag type")
+	if !svc.permitted(ctx, PermissionTaxonomyWrite) {
+		return permissionError(PermissionTaxonomyWrite, "add tag type")
 	}
 
 	if err := data.Validate(); err != nil {
 		return err
 	}
 
-	return svc.database.AddTagType(ctx, data, v)
+	if v == nil {
+		v = new(model.TagType)
+	}
+	return svc.audit(ctx, model.AuditActionAdd, model.AuditEntityTagType, func(ctx context.Context, a *model.AddAudit) error {
+		if err := svc.database.AddTagType(ctx, data, v); err != nil {
+			return err
+		}
+		a.Key, a.After = v.Code, v
+		return nil
+	})
 }
 
 func (svc Service) GetTagTypeByCode(ctx context.Context, code string) (*model.TagType, error) {
@@ -27,29 +38,50 @@ func (svc Service) GetTagTypeByCode(ctx context.Context, code string) (*model.Ta
 }
 
 func (svc Service) UpdateTagTypeByCode(ctx context.Context, code string, data model.SetTagType, v *model.TagType) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to update tag type")
+	if !svc.permitted(ctx, PermissionTaxonomyWrite) {
+		return permissionError(PermissionTaxonomyWrite, "update tag type")
 	}
 
 	if err := data.Validate(); err != nil {
 		return err
 	}
 
-	return svc.database.UpdateTagType(ctx, data, model.DBConditionalKV{
+	conds := model.DBConditionalKV{
 		Key:   model.DBTagTypeCode,
 		Value: code,
-	}, v)
+	}
+	if v == nil {
+		v = new(model.TagType)
+	}
+	return svc.audit(ctx, model.AuditActionUpdate, model.AuditEntityTagType, func(ctx context.Context, a *model.AddAudit) error {
+		before, err := svc.database.GetTagType(ctx, conds)
+		if err != nil {
+			return err
+		}
+		if err := svc.database.UpdateTagType(ctx, data, conds, v); err != nil {
+			return err
+		}
+		a.Key, a.Before, a.After = code, before, v
+		return nil
+	})
 }
 
 func (svc Service) DeleteTagTypeByCode(ctx context.Context, code string) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to delete tag type")
+	if !svc.permitted(ctx, PermissionTaxonomyWrite) {
+		return permissionError(PermissionTaxonomyWrite, "delete tag type")
 	}
 
-	return svc.database.DeleteTagType(ctx, model.DBConditionalKV{
-		Key:   model.DBTagTypeCode,
-		Value: code,
-	}, nil)
+	return svc.audit(ctx, model.AuditActionDelete, model.AuditEntityTagType, func(ctx context.Context, a *model.AddAudit) error {
+		before := new(model.TagType)
+		if err := svc.database.DeleteTagType(ctx, model.DBConditionalKV{
+			Key:   model.DBTagTypeCode,
+			Value: code,
+		}, before); err != nil {
+			return err
+		}
+		a.Key, a.Before = code, before
+		return nil
+	})
 }
 
 func (svc Service) ListTagType(ctx context.Context, params model.ListParams) ([]*model.TagType, error) {
@@ -81,15 +113,24 @@ func (svc Service) CountTagType(ctx context.Context, conds any) (int, error) {
 }
 
 func (svc Service) AddTag(ctx context.Context, data model.AddTag, v *model.Tag) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to add tag")
+	if !svc.permitted(ctx, PermissionTaxonomyWrite) {
+		return permissionError(PermissionTaxonomyWrite, "add tag")
 	}
 
 	if err := data.Validate(); err != nil {
 		return err
 	}
 
-	return svc.database.AddTag(ctx, data, v)
+	if v == nil {
+		v = new(model.Tag)
+	}
+	return svc.audit(ctx, model.AuditActionAdd, model.AuditEntityTag, func(ctx context.Context, a *model.AddAudit) error {
+		if err := svc.database.AddTag(ctx, data, v); err != nil {
+			return err
+		}
+		a.Key, a.After = utila.Utoa(v.TypeID)+"-"+v.Code, v
+		return nil
+	})
 }
 
 func (svc Service) GetTagBySID(ctx context.Context, sid model.TagSID) (*model.Tag, error) {
@@ -107,8 +148,8 @@ func (svc Service) GetTagBySID(ctx context.Context, sid model.TagSID) (*model.Ta
 }
 
 func (svc Service) UpdateTagBySID(ctx context.Context, sid model.TagSID, data model.SetTag, v *model.Tag) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to update tag")
+	if !svc.permitted(ctx, PermissionTaxonomyWrite) {
+		return permissionError(PermissionTaxonomyWrite, "update tag")
 	}
 
 	if err := data.Validate(); err != nil {
@@ -122,15 +163,35 @@ func (svc Service) UpdateTagBySID(ctx context.Context, sid model.TagSID, data mo
 	case sid.TypeCode != nil:
 		typeID = model.DBTagTypeCodeToID(*sid.TypeCode)
 	}
-	return svc.database.UpdateTag(ctx, data, map[string]any{
+	conds := map[string]any{
 		model.DBTagTypeID: typeID,
 		model.DBTagCode:   sid.Code,
-	}, v)
+	}
+	if v == nil {
+		v = new(model.Tag)
+	}
+	return svc.audit(ctx, model.AuditActionUpdate, model.AuditEntityTag, func(ctx context.Context, a *model.AddAudit) error {
+		before, err := svc.database.GetTag(ctx, conds)
+		if err != nil {
+			return preconditionError(ctx, err)
+		}
+		conds, err := precondition(ctx, conds, func() (any, *time.Time, error) {
+			return before, before.UpdatedAt, nil
+		})
+		if err != nil {
+			return err
+		}
+		if err := svc.database.UpdateTag(ctx, data, conds, v); err != nil {
+			return preconditionError(ctx, err)
+		}
+		a.Key, a.Before, a.After = utila.Utoa(before.TypeID)+"-"+before.Code, before, v
+		return nil
+	})
 }
 
 func (svc Service) DeleteTagBySID(ctx context.Context, sid model.TagSID) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to delete tag")
+	if !svc.permitted(ctx, PermissionTaxonomyWrite) {
+		return permissionError(PermissionTaxonomyWrite, "delete tag")
 	}
 
 	var typeID any
@@ -140,15 +201,60 @@ func (svc Service) DeleteTagBySID(ctx context.Context, sid model.TagSID) error {
 	case sid.TypeCode != nil:
 		typeID = model.DBTagTypeCodeToID(*sid.TypeCode)
 	}
-	return svc.database.DeleteTag(ctx, map[string]any{
+	conds := map[string]any{
 		model.DBTagTypeID: typeID,
 		model.DBTagCode:   sid.Code,
-	}, nil)
+	}
+	return svc.audit(ctx, model.AuditActionDelete, model.AuditEntityTag, func(ctx context.Context, a *model.AddAudit) error {
+		conds, err := precondition(ctx, conds, func() (any, *time.Time, error) {
+			current, err := svc.database.GetTag(ctx, conds)
+			if err != nil {
+				return nil, nil, err
+			}
+			return current, current.UpdatedAt, nil
+		})
+		if err != nil {
+			return err
+		}
+		before := new(model.Tag)
+		if err := svc.database.DeleteTag(ctx, conds, before); err != nil {
+			return preconditionError(ctx, err)
+		}
+		a.Key, a.Before = utila.Utoa(before.TypeID)+"-"+before.Code, before
+		return nil
+	})
+}
+
+func (svc Service) RestoreTagBySID(ctx context.Context, sid model.TagSID, v *model.Tag) error {
+	if !svc.permitted(ctx, PermissionTaxonomyWrite) {
+		return permissionError(PermissionTaxonomyWrite, "restore tag")
+	}
+
+	var typeID any
+	switch {
+	case sid.TypeID != nil:
+		typeID = sid.TypeID
+	case sid.TypeCode != nil:
+		typeID = model.DBTagTypeCodeToID(*sid.TypeCode)
+	}
+	if v == nil {
+		v = new(model.Tag)
+	}
+	return svc.audit(ctx, model.AuditActionRestore, model.AuditEntityTag, func(ctx context.Context, a *model.AddAudit) error {
+		if err := svc.database.RestoreTag(ctx, map[string]any{
+			model.DBTagTypeID: typeID,
+			model.DBTagCode:   sid.Code,
+		}, v); err != nil {
+			return err
+		}
+		a.Key, a.After = utila.Utoa(v.TypeID)+"-"+v.Code, v
+		return nil
+	})
 }
 
-func (svc Service) ListTag(ctx context.Context, params model.ListParams) ([]*model.Tag, error) {
+func (svc Service) ListTag(ctx context.Context, params model.ListParams) ([]*model.Tag, []any, error) {
 	if err := params.Validate(); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	params.OrderBys = slices.DeleteFunc(params.OrderBys, func(ob model.OrderBy) bool {
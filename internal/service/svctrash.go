@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
+)
+
+func (svc Service) ListTrash(ctx context.Context, params model.ListParams) ([]*model.Trash, error) {
+	if !svc.permitted(ctx, PermissionAdmin) {
+		return nil, permissionError(PermissionAdmin, "list trash")
+	}
+
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if pagination := params.Pagination; pagination != nil {
+		if pagination.Limit > model.TrashPaginationMax {
+			pagination.Limit = model.TrashPaginationMax
+		}
+	}
+
+	return svc.database.ListTrash(ctx, params)
+}
+
+func (svc Service) CountTrash(ctx context.Context, conds any) (int, error) {
+	if !svc.permitted(ctx, PermissionAdmin) {
+		return -1, permissionError(PermissionAdmin, "count trash")
+	}
+
+	return svc.database.CountTrash(ctx, conds)
+}
@@ -3,20 +3,30 @@ package service
 import (
 	"context"
 	"slices"
+	"time"
 
 	"github.com/mahmudindes/orenocomic-donoengine/internal/model"
 )
 
 func (svc Service) AddWebsite(ctx context.Context, data model.AddWebsite, v *model.Website) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to add website")
+	if !svc.permitted(ctx, PermissionWebsiteWrite) {
+		return permissionError(PermissionWebsiteWrite, "add website")
 	}
 
 	if err := data.Validate(); err != nil {
 		return err
 	}
 
-	return svc.database.AddWebsite(ctx, data, v)
+	if v == nil {
+		v = new(model.Website)
+	}
+	return svc.audit(ctx, model.AuditActionAdd, model.AuditEntityWebsite, func(ctx context.Context, a *model.AddAudit) error {
+		if err := svc.database.AddWebsite(ctx, data, v); err != nil {
+			return err
+		}
+		a.Key, a.After = v.Domain, v
+		return nil
+	})
 }
 
 func (svc Service) GetWebsiteByDomain(ctx context.Context, domain string) (*model.Website, error) {
@@ -27,34 +37,72 @@ func (svc Service) GetWebsiteByDomain(ctx context.Context, domain string) (*mode
 }
 
 func (svc Service) UpdateWebsiteByDomain(ctx context.Context, domain string, data model.SetWebsite, v *model.Website) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to update website")
+	if !svc.permitted(ctx, PermissionWebsiteWrite) {
+		return permissionError(PermissionWebsiteWrite, "update website")
 	}
 
 	if err := data.Validate(); err != nil {
 		return err
 	}
 
-	return svc.database.UpdateWebsite(ctx, data, model.DBConditionalKV{
+	conds := model.DBConditionalKV{
 		Key:   model.DBWebsiteDomain,
 		Value: domain,
-	}, v)
+	}
+	if v == nil {
+		v = new(model.Website)
+	}
+	return svc.audit(ctx, model.AuditActionUpdate, model.AuditEntityWebsite, func(ctx context.Context, a *model.AddAudit) error {
+		before, err := svc.database.GetWebsite(ctx, conds)
+		if err != nil {
+			return preconditionError(ctx, err)
+		}
+		conds, err := precondition(ctx, conds, func() (any, *time.Time, error) {
+			return before, before.UpdatedAt, nil
+		})
+		if err != nil {
+			return err
+		}
+		if err := svc.database.UpdateWebsite(ctx, data, conds, v); err != nil {
+			return preconditionError(ctx, err)
+		}
+		a.Key, a.Before, a.After = domain, before, v
+		return nil
+	})
 }
 
 func (svc Service) DeleteWebsiteByDomain(ctx context.Context, domain string) error {
-	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to delete website")
+	if !svc.permitted(ctx, PermissionWebsiteWrite) {
+		return permissionError(PermissionWebsiteWrite, "delete website")
 	}
 
-	return svc.database.DeleteWebsite(ctx, model.DBConditionalKV{
+	conds := model.DBConditionalKV{
 		Key:   model.DBWebsiteDomain,
 		Value: domain,
-	}, nil)
+	}
+	return svc.audit(ctx, model.AuditActionDelete, model.AuditEntityWebsite, func(ctx context.Context, a *model.AddAudit) error {
+		conds, err := precondition(ctx, conds, func() (any, *time.Time, error) {
+			current, err := svc.database.GetWebsite(ctx, conds)
+			if err != nil {
+				return nil, nil, err
+			}
+			return current, current.UpdatedAt, nil
+		})
+		if err != nil {
+			return err
+		}
+		before := new(model.Website)
+		if err := svc.database.DeleteWebsite(ctx, conds, before); err != nil {
+			return preconditionError(ctx, err)
+		}
+		a.Key, a.Before = domain, before
+		return nil
+	})
 }
 
-func (svc Service) ListWebsite(ctx context.Context, params model.ListParams) ([]*model.Website, error) {
+func (svc Service) ListWebsite(ctx context.Context, params model.ListParams) ([]*model.Website, []any, error) {
 	if err := params.Validate(); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	params.OrderBys = slices.DeleteFunc(params.OrderBys, func(ob model.OrderBy) bool {
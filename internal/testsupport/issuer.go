@@ -0,0 +1,146 @@
+package testsupport
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/auth/oauth"
+)
+
+const IssuerAudience = "donoengine"
+
+// Issuer is a local OAuth authorization server serving discovery metadata
+// and a JWKS, it signs tokens with a key generated at startup.
+type Issuer struct {
+	server *httptest.Server
+	mu     sync.RWMutex
+	key    jwk.Key
+	jwks   jwk.Set
+	keys   int
+}
+
+func NewIssuer() (*Issuer, error) {
+	iss := &Issuer{}
+	if err := iss.Rotate(); err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/oauth-authorization-server", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]any{
+			"issuer":   iss.URL(),
+			"jwks_uri": iss.URL() + ".well-known/jwks.json",
+		})
+	})
+	mux.HandleFunc("/.well-known/jwks.json", func(w http.ResponseWriter, r *http.Request) {
+		iss.mu.RLock()
+		defer iss.mu.RUnlock()
+		writeJSON(w, iss.jwks)
+	})
+	iss.server = httptest.NewServer(mux)
+
+	return iss, nil
+}
+
+// Rotate replaces the signing key with a new one under a new key ID, tokens
+// signed before no longer verify once the JWKS is refetched.
+func (iss *Issuer) Rotate() error {
+	raw, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return err
+	}
+	key, err := jwk.FromRaw(raw)
+	if err != nil {
+		return err
+	}
+	iss.mu.Lock()
+	defer iss.mu.Unlock()
+	iss.keys++
+	if err := key.Set(jwk.KeyIDKey, "testsupport-"+strconv.Itoa(iss.keys)); err != nil {
+		return err
+	}
+	if err := key.Set(jwk.AlgorithmKey, jwa.RS256); err != nil {
+		return err
+	}
+	set := jwk.NewSet()
+	if err := set.AddKey(key); err != nil {
+		return err
+	}
+	jwks, err := jwk.PublicSetOf(set)
+	if err != nil {
+		return err
+	}
+	iss.key, iss.jwks = key, jwks
+	return nil
+}
+
+// URL is the issuer identifier, it ends with a slash like the configured one.
+func (iss *Issuer) URL() string {
+	return iss.server.URL + "/"
+}
+
+func (iss *Issuer) Config(permissionPrefix string) oauth.Config {
+	return oauth.Config{
+		Issuer:           iss.URL(),
+		Audience:         IssuerAudience,
+		PermissionPrefix: permissionPrefix,
+	}
+}
+
+// Token mints a signed access token, claims override the registered ones so
+// expired or foreign tokens can be produced as well.
+func (iss *Issuer) Token(subject string, claims map[string]any) (string, error) {
+	now := time.Now()
+	tok, err := jwt.NewBuilder().
+		Issuer(iss.URL()).
+		Audience([]string{IssuerAudience}).
+		Subject(subject).
+		IssuedAt(now).
+		Expiration(now.Add(time.Hour)).
+		Build()
+	if err != nil {
+		return "", err
+	}
+	for key, val := range claims {
+		if err := tok.Set(key, val); err != nil {
+			return "", err
+		}
+	}
+	iss.mu.RLock()
+	defer iss.mu.RUnlock()
+	signed, err := jwt.Sign(tok, jwt.WithKey(jwa.RS256, iss.key))
+	if err != nil {
+		return "", err
+	}
+	return string(signed), nil
+}
+
+// PermissionToken mints a token carrying an Auth0 RBAC style permissions claim.
+func (iss *Issuer) PermissionToken(subject string, permissions ...string) (string, error) {
+	return iss.Token(subject, map[string]any{"permissions": permissions})
+}
+
+// ScopeToken mints a token carrying a space separated scope claim.
+func (iss *Issuer) ScopeToken(subject string, scopes ...string) (string, error) {
+	return iss.Token(subject, map[string]any{"scope": strings.Join(scopes, " ")})
+}
+
+func (iss *Issuer) Close() {
+	iss.server.Close()
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
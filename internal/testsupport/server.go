@@ -0,0 +1,191 @@
+package testsupport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/auth/oauth"
+	"github.com/mahmudindes/orenocomic-donoengine/internal/controller/chttp"
+	"github.com/mahmudindes/orenocomic-donoengine/internal/datastore/memory"
+	"github.com/mahmudindes/orenocomic-donoengine/internal/logger"
+	"github.com/mahmudindes/orenocomic-donoengine/internal/service"
+)
+
+type (
+	// Server runs the full HTTP stack against an in-memory database and a
+	// local issuer.
+	Server struct {
+		*httptest.Server
+		Issuer   *Issuer
+		Database *memory.Memory
+		OAuth    *oauth.OAuth
+		cancel   context.CancelFunc
+	}
+
+	Config struct {
+		PermissionPrefix string
+		Service          service.Config
+		HTTP             chttp.Config
+	}
+
+	// Case is one request of a table-driven API test.
+	Case struct {
+		Name   string
+		Method string
+		Path   string
+		Token  string
+		Header http.Header
+		Body   any
+		Status int
+		Check  func(t *testing.T, res *http.Response, body []byte)
+	}
+)
+
+// NewServer boots the stack, the context given is only for the startup, the
+// background work of the server lasts until Close.
+func NewServer(ctx context.Context, cfg Config) (*Server, error) {
+	if cfg.PermissionPrefix == "" {
+		cfg.PermissionPrefix = "orenocomic"
+	}
+	// Tests run inside their package directory, not the repository root.
+	_, file, _, _ := runtime.Caller(0)
+	root := filepath.Join(filepath.Dir(file), "..", "..")
+	if cfg.HTTP.TemplateDir == "" {
+		cfg.HTTP.TemplateDir = filepath.Join(root, "web", "template")
+	}
+	if cfg.HTTP.StaticDir == "" {
+		cfg.HTTP.StaticDir = filepath.Join(root, "web", "static")
+	}
+
+	ctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+
+	iss, err := NewIssuer()
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	log := logger.New()
+
+	oa, err := oauth.New(ctx, nil, iss.Config(cfg.PermissionPrefix), log.WithName("OAuth"))
+	if err != nil {
+		cancel()
+		iss.Close()
+		return nil, err
+	}
+
+	db := memory.New()
+
+	svc := service.New(db, oa, cfg.Service)
+
+	ctr, err := chttp.New(svc, oa, cfg.HTTP, log.WithName("HTTP"))
+	if err != nil {
+		cancel()
+		iss.Close()
+		return nil, err
+	}
+
+	return &Server{
+		Server:   httptest.NewServer(ctr),
+		Issuer:   iss,
+		Database: db,
+		OAuth:    oa,
+		cancel:   cancel,
+	}, nil
+}
+
+// StartServer is NewServer for tests, the server is closed on cleanup.
+func StartServer(t testing.TB, cfg Config) *Server {
+	t.Helper()
+	svr, err := NewServer(context.Background(), cfg)
+	if err != nil {
+		t.Fatalf("start server: %v", err)
+	}
+	t.Cleanup(svr.Close)
+	return svr
+}
+
+func (svr Server) Close() {
+	svr.cancel()
+	svr.Server.Close()
+	svr.Issuer.Close()
+	svr.Database.Close()
+}
+
+// PermissionToken mints a token granting the prefixed permissions, e.g.
+// PermissionToken(t, "comic.write") for "orenocomic.comic.write".
+func (svr Server) PermissionToken(t testing.TB, keys ...string) string {
+	t.Helper()
+	permissions := make([]string, 0, len(keys))
+	for _, key := range keys {
+		permissions = append(permissions, svr.OAuth.TokenPermissionKey(key))
+	}
+	token, err := svr.Issuer.PermissionToken("testsupport", permissions...)
+	if err != nil {
+		t.Fatalf("mint token: %v", err)
+	}
+	return token
+}
+
+func (svr Server) Do(method, path, token string, header http.Header, body any) (*http.Response, error) {
+	var rBody io.Reader
+	switch body := body.(type) {
+	case nil:
+	case []byte:
+		rBody = bytes.NewReader(body)
+	case string:
+		rBody = bytes.NewReader([]byte(body))
+	default:
+		raw, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		rBody = bytes.NewReader(raw)
+	}
+	req, err := http.NewRequest(method, svr.URL+path, rBody)
+	if err != nil {
+		return nil, err
+	}
+	for key, vals := range header {
+		req.Header[key] = vals
+	}
+	if rBody != nil && req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return svr.Client().Do(req)
+}
+
+// Run executes the cases in order as subtests, later cases see the writes of
+// earlier ones.
+func (svr Server) Run(t *testing.T, cases []Case) {
+	t.Helper()
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			res, err := svr.Do(c.Method, c.Path, c.Token, c.Header, c.Body)
+			if err != nil {
+				t.Fatalf("request: %v", err)
+			}
+			defer res.Body.Close()
+			body, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Fatalf("read body: %v", err)
+			}
+			if c.Status != 0 && res.StatusCode != c.Status {
+				t.Fatalf("status: expected %d got %d: %s", c.Status, res.StatusCode, body)
+			}
+			if c.Check != nil {
+				c.Check(t, res, body)
+			}
+		})
+	}
+}
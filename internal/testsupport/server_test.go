@@ -0,0 +1,86 @@
+package testsupport_test
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mahmudindes/orenocomic-donoengine/internal/testsupport"
+)
+
+func TestServer(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("getwd: %v", err)
+	}
+	svr := testsupport.StartServer(t, testsupport.Config{})
+	if wd0, _ := os.Getwd(); wd0 != wd {
+		t.Errorf("working directory changed to %s", wd0)
+	}
+
+	write := svr.PermissionToken(t, "language.write")
+	other := svr.PermissionToken(t, "website.write")
+	legacy := svr.PermissionToken(t, "write")
+	expired, err := svr.Issuer.Token("testsupport", map[string]any{
+		"permissions": []string{svr.OAuth.TokenPermissionKey("language.write")},
+		"exp":         time.Now().Add(-time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("mint expired token: %v", err)
+	}
+	foreign, err := testsupport.NewIssuer()
+	if err != nil {
+		t.Fatalf("new issuer: %v", err)
+	}
+	defer foreign.Close()
+	forged, err := foreign.PermissionToken("testsupport", svr.OAuth.TokenPermissionKey("language.write"))
+	if err != nil {
+		t.Fatalf("mint foreign token: %v", err)
+	}
+
+	language := map[string]any{"ietf": "en", "name": "English"}
+	svr.Run(t, []testsupport.Case{
+		{Name: "template", Method: http.MethodGet, Path: "/", Header: http.Header{"Accept": {"text/html"}},
+			Status: http.StatusOK, Check: func(t *testing.T, res *http.Response, body []byte) {
+				if !strings.HasPrefix(res.Header.Get("Content-Type"), "text/html") {
+					t.Errorf("content type: %s", res.Header.Get("Content-Type"))
+				}
+			}},
+		{Name: "static", Method: http.MethodGet, Path: "/robots.txt", Status: http.StatusOK},
+		{Name: "health", Method: http.MethodGet, Path: "/health", Status: http.StatusOK},
+		{Name: "anonymous", Method: http.MethodPost, Path: "/api/v0/languages",
+			Body: language, Status: http.StatusUnauthorized},
+		{Name: "expired", Method: http.MethodPost, Path: "/api/v0/languages", Token: expired,
+			Body: language, Status: http.StatusUnauthorized},
+		{Name: "foreign issuer", Method: http.MethodPost, Path: "/api/v0/languages", Token: forged,
+			Body: language, Status: http.StatusUnauthorized},
+		{Name: "missing permission", Method: http.MethodPost, Path: "/api/v0/languages", Token: other,
+			Body: language, Status: http.StatusBadRequest},
+		{Name: "permitted", Method: http.MethodPost, Path: "/api/v0/languages", Token: write,
+			Body: language, Status: http.StatusCreated},
+		{Name: "persisted", Method: http.MethodGet, Path: "/api/v0/languages/en", Status: http.StatusOK},
+		{Name: "legacy write", Method: http.MethodPost, Path: "/api/v0/languages", Token: legacy,
+			Body: map[string]any{"ietf": "id", "name": "Indonesian"}, Status: http.StatusCreated},
+	})
+}
+
+func TestServerClose(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	svr, err := testsupport.NewServer(ctx, testsupport.Config{})
+	if err != nil {
+		t.Fatalf("new server: %v", err)
+	}
+	// The startup context ending does not stop the server.
+	cancel()
+	svr.Run(t, []testsupport.Case{
+		{Name: "after startup", Method: http.MethodGet, Path: "/health", Status: http.StatusOK},
+	})
+
+	svr.Close()
+	if _, err := svr.Do(http.MethodGet, "/health", "", nil, nil); err == nil {
+		t.Error("request after close: expected error")
+	}
+}